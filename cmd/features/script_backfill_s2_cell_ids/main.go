@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"poroto.app/poroto/planner/internal/env"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb"
+)
+
+// 近くの場所・プランの検索に用いる S2 セルの ID が保存されていないレコードに、位置から求めた値を保存する
+func main() {
+	env.LoadEnv()
+
+	batchSize := flag.Int("batch-size", 500, "1回のトランザクションで更新する件数")
+
+	flag.Parse()
+
+	db, err := rdb.InitDB(false)
+	if err != nil {
+		log.Fatalf("error while initializing db: %v", err)
+	}
+
+	placeRepository, err := rdb.NewPlaceRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	planRepository, err := rdb.NewPlanRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing plan repository: %v", err)
+	}
+
+	ctx := context.Background()
+
+	googlePlaceCount, err := placeRepository.BackfillS2CellIds(ctx, *batchSize)
+	if err != nil {
+		log.Fatalf("error while backfilling s2 cell ids of google places (%d updated): %v", googlePlaceCount, err)
+	}
+	log.Printf("backfilled s2 cell ids of %d google places", googlePlaceCount)
+
+	planCount, err := planRepository.BackfillS2CellIds(ctx, *batchSize)
+	if err != nil {
+		log.Fatalf("error while backfilling s2 cell ids of plans (%d updated): %v", planCount, err)
+	}
+	log.Printf("backfilled s2 cell ids of %d plans", planCount)
+}
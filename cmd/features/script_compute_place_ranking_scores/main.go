@@ -0,0 +1,52 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placeranking"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/env"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb"
+)
+
+func main() {
+	env.LoadEnv()
+
+	defaultWeights := models.DefaultPlaceRankingWeights()
+	weightGoogleRating := flag.Float64("google-rating", defaultWeights.GoogleRating, "Google の評価の重み")
+	weightLike := flag.Float64("like", defaultWeights.Like, "いいね数の重み")
+	weightSavedPlan := flag.Float64("saved-plan", defaultWeights.SavedPlan, "保存されたプランに含まれる回数の重み")
+
+	flag.Parse()
+
+	db, err := rdb.InitDB(false)
+	if err != nil {
+		log.Fatalf("error while initializing db: %v", err)
+	}
+
+	placeRepository, err := rdb.NewPlaceRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	logger, err := utils.NewLogger(utils.LoggerOption{})
+	if err != nil {
+		log.Fatalf("error while initializing logger: %v", err)
+	}
+
+	service := placeranking.NewService(placeRepository, logger)
+
+	count, err := service.ComputeRankingScores(context.Background(), models.PlaceRankingWeights{
+		GoogleRating: *weightGoogleRating,
+		Like:         *weightLike,
+		SavedPlan:    *weightSavedPlan,
+	})
+	if err != nil {
+		log.Fatalf("error while computing place ranking scores: %v", err)
+	}
+
+	log.Printf("computed ranking scores of %d places", count)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"poroto.app/poroto/planner/internal/domain/services/plancandidatecleanup"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/env"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb"
+)
+
+// 期限切れのプラン候補を関連するデータとともに削除する
+// サーバーでは削除を行わないため、このスクリプトを定期実行のジョブとして実行する
+func main() {
+	env.LoadEnv()
+
+	defaultInput := plancandidatecleanup.DefaultDeleteExpiredInput()
+	gracePeriod := flag.Duration("grace-period", defaultInput.GracePeriod, "期限が切れてから削除するまでの猶予")
+	batchSize := flag.Int("batch-size", defaultInput.BatchSize, "1回のトランザクションで削除する件数")
+	maxBatches := flag.Int("max-batches", 0, "削除を繰り返す最大の回数（0 の場合は削除できるものがなくなるまで）")
+
+	flag.Parse()
+
+	db, err := rdb.InitDB(false)
+	if err != nil {
+		log.Fatalf("error while initializing db: %v", err)
+	}
+
+	planCandidateRepository, err := rdb.NewPlanCandidateRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing plan candidate repository: %v", err)
+	}
+
+	logger, err := utils.NewLogger(utils.LoggerOption{})
+	if err != nil {
+		log.Fatalf("error while initializing logger: %v", err)
+	}
+
+	service := plancandidatecleanup.NewService(planCandidateRepository, utils.NewSystemClock(), logger)
+
+	output, err := service.DeleteExpiredPlanCandidateSets(context.Background(), plancandidatecleanup.DeleteExpiredInput{
+		GracePeriod: *gracePeriod,
+		BatchSize:   *batchSize,
+		MaxBatches:  *maxBatches,
+	})
+	if err != nil {
+		log.Fatalf("error while deleting expired plan candidate sets (%d deleted): %v", output.DeletedCount, err)
+	}
+
+	log.Printf("deleted %d expired plan candidate sets (%d retained)", output.DeletedCount, output.RetainedCount)
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placesearch"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/env"
+	"poroto.app/poroto/planner/internal/infrastructure/api/google/places"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb"
+)
+
+// 保存されてから時間が経った場所の情報を Places API で取得し直す
+func main() {
+	env.LoadEnv()
+
+	defaultPolicy := models.DefaultGooglePlaceRefreshPolicy()
+	callBudget := flag.Int("call-budget", 100, "Places API を呼び出してよい最大の回数")
+	maxAge := flag.Duration("max-age", defaultPolicy.MaxAge, "評価・価格帯・種類を取得し直すまでの期間")
+	detailMaxAge := flag.Duration("detail-max-age", defaultPolicy.DetailMaxAge, "営業時間・レビューを取得し直すまでの期間")
+
+	flag.Parse()
+
+	db, err := rdb.InitDB(false)
+	if err != nil {
+		log.Fatalf("error while initializing db: %v", err)
+	}
+
+	placesApi, err := places.NewPlacesApi()
+	if err != nil {
+		log.Fatalf("error while initializing places api: %v", err)
+	}
+
+	placeRepository, err := rdb.NewPlaceRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	planCandidateRepository, err := rdb.NewPlanCandidateRepository(db)
+	if err != nil {
+		log.Fatalf("error while initializing plan candidate repository: %v", err)
+	}
+
+	logger, err := utils.NewLogger(utils.LoggerOption{})
+	if err != nil {
+		log.Fatalf("error while initializing logger: %v", err)
+	}
+
+	service := placesearch.NewService(placesApi, placeRepository, planCandidateRepository, utils.NewSystemClock(), logger)
+
+	output, err := service.RefreshStaleGooglePlaces(context.Background(), placesearch.RefreshStaleGooglePlacesInput{
+		Policy: models.GooglePlaceRefreshPolicy{
+			MaxAge:       *maxAge,
+			DetailMaxAge: *detailMaxAge,
+		},
+		CallBudget: *callBudget,
+	})
+	if err != nil {
+		log.Fatalf("error while refreshing stale google places: %v", err)
+	}
+
+	log.Printf("refreshed %d google places (%d failed)", output.RefreshedCount, output.FailedCount)
+}
@@ -5,6 +5,7 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"log"
 	"os"
+	"poroto.app/poroto/planner/internal/application"
 	"poroto.app/poroto/planner/internal/env"
 	"poroto.app/poroto/planner/internal/infrastructure/rdb"
 
@@ -21,12 +22,14 @@ func main() {
 		log.Fatalf("error while initializing db: %v", err)
 	}
 
-	s, err := rest.NewRestServer(context.Background(), db, os.Getenv("ENV"))
+	// サービスはリクエストごとに初期化せず、起動時に一度だけ組み立てる
+	container, err := application.NewContainer(context.Background(), db)
 	if err != nil {
-		log.Fatalf("error while initializing server: %v", err)
+		log.Fatalf("error while initializing container: %v", err)
 	}
 
-	if err := s.ServeHTTP(db); err != nil {
+	s := rest.NewRestServer(container, os.Getenv("ENV"))
+	if err := s.ServeHTTP(); err != nil {
 		log.Fatalf("error while starting server: %v", err)
 	}
 }
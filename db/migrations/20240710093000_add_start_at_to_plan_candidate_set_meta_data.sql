@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    ADD COLUMN start_at DATETIME DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    DROP COLUMN start_at;
+-- +goose StatementEnd
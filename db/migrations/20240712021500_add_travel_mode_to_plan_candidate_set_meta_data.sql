@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    ADD COLUMN travel_mode VARCHAR(20) NOT NULL DEFAULT 'WALKING';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    DROP COLUMN travel_mode;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    ADD COLUMN budget_max INT DEFAULT NULL,
+    ADD COLUMN number_of_people INT DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    DROP COLUMN budget_max,
+    DROP COLUMN number_of_people;
+-- +goose StatementEnd
@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS trips
+(
+    id          CHAR(36) PRIMARY KEY NOT NULL,
+    user_id     VARCHAR(36),
+    name        VARCHAR(2000)        NOT NULL,
+    start_at    DATETIME                      DEFAULT NULL,
+    travel_mode VARCHAR(20)          NOT NULL DEFAULT 'WALKING',
+    created_at  DATETIME             NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  DATETIME             NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES users (id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS trip_plans
+(
+    id         CHAR(36) PRIMARY KEY,
+    trip_id    CHAR(36)  NOT NULL,
+    plan_id    CHAR(36)  NOT NULL,
+    day_index  INT       NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (trip_id) REFERENCES trips (id),
+    FOREIGN KEY (plan_id) REFERENCES plans (id),
+    UNIQUE (trip_id, day_index),
+    UNIQUE (plan_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trip_plans;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS trips;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    ADD COLUMN weather_precipitation_probability INT DEFAULT NULL,
+    ADD COLUMN weather_precipitation_mm DOUBLE DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    DROP COLUMN weather_precipitation_probability,
+    DROP COLUMN weather_precipitation_mm;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    ADD COLUMN latitude_end DOUBLE DEFAULT NULL,
+    ADD COLUMN longitude_end DOUBLE DEFAULT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_set_meta_data
+    DROP COLUMN latitude_end,
+    DROP COLUMN longitude_end;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS place_ranking_scores
+(
+    place_id         CHAR(36) PRIMARY KEY,
+    score            DOUBLE    NOT NULL,
+    like_count       INT       NOT NULL DEFAULT 0,
+    saved_plan_count INT       NOT NULL DEFAULT 0,
+    created_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at       TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (place_id) REFERENCES places (id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS place_ranking_scores;
+-- +goose StatementEnd
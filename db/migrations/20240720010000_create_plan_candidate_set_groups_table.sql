@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS plan_candidate_set_groups
+(
+    plan_candidate_set_id CHAR(36) PRIMARY KEY NOT NULL,
+    share_token           CHAR(36)             NOT NULL,
+    created_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (plan_candidate_set_id) REFERENCES plan_candidate_sets (id),
+    UNIQUE (share_token)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS plan_candidate_set_participants
+(
+    id                    CHAR(36) PRIMARY KEY NOT NULL,
+    plan_candidate_set_id CHAR(36)             NOT NULL,
+    user_id               VARCHAR(36)                   DEFAULT NULL,
+    participant_token     CHAR(36)             NOT NULL,
+    display_name          VARCHAR(255)         NOT NULL,
+    created_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (plan_candidate_set_id) REFERENCES plan_candidate_set_groups (plan_candidate_set_id),
+    FOREIGN KEY (user_id) REFERENCES users (id),
+    UNIQUE (participant_token),
+    UNIQUE (plan_candidate_set_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS plan_candidate_set_plan_votes
+(
+    id                    CHAR(36) PRIMARY KEY NOT NULL,
+    plan_candidate_set_id CHAR(36)             NOT NULL,
+    participant_id        CHAR(36)             NOT NULL,
+    plan_candidate_id     CHAR(36)             NOT NULL,
+    created_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (plan_candidate_set_id) REFERENCES plan_candidate_set_groups (plan_candidate_set_id),
+    FOREIGN KEY (participant_id) REFERENCES plan_candidate_set_participants (id),
+    FOREIGN KEY (plan_candidate_id) REFERENCES plan_candidates (id),
+    UNIQUE (participant_id, plan_candidate_id)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS plan_candidate_set_place_votes
+(
+    id                    CHAR(36) PRIMARY KEY NOT NULL,
+    plan_candidate_set_id CHAR(36)             NOT NULL,
+    participant_id        CHAR(36)             NOT NULL,
+    place_id              CHAR(36)             NOT NULL,
+    created_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (plan_candidate_set_id) REFERENCES plan_candidate_set_groups (plan_candidate_set_id),
+    FOREIGN KEY (participant_id) REFERENCES plan_candidate_set_participants (id),
+    FOREIGN KEY (place_id) REFERENCES places (id),
+    UNIQUE (participant_id, place_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS plan_candidate_set_place_votes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS plan_candidate_set_plan_votes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS plan_candidate_set_participants;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE IF EXISTS plan_candidate_set_groups;
+-- +goose StatementEnd
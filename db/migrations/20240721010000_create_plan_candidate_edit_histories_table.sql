@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS plan_candidate_edit_histories
+(
+    id                    CHAR(36) PRIMARY KEY NOT NULL,
+    plan_candidate_set_id CHAR(36)             NOT NULL,
+    plan_candidate_id     CHAR(36)             NOT NULL,
+    sort_order            INT                  NOT NULL,
+    edit_type             VARCHAR(32)          NOT NULL,
+    place_ids_before      JSON                 NOT NULL,
+    place_ids_after       JSON                 NOT NULL,
+    is_undone             BOOLEAN              NOT NULL DEFAULT FALSE,
+    created_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at            TIMESTAMP            NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+    FOREIGN KEY (plan_candidate_set_id) REFERENCES plan_candidate_sets (id),
+    FOREIGN KEY (plan_candidate_id) REFERENCES plan_candidates (id),
+    UNIQUE (plan_candidate_set_id, sort_order)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS plan_candidate_edit_histories;
+-- +goose StatementEnd
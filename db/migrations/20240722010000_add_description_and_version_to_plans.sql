@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plans
+    ADD COLUMN description TEXT DEFAULT NULL,
+    -- 楽観的排他制御のため、プランを編集するたびに1ずつ増やす
+    ADD COLUMN version INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plans
+    DROP COLUMN description,
+    DROP COLUMN version;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE plans
+    -- PUBLIC: 一覧に表示される, UNLISTED: URLを知っているユーザーのみ閲覧できる, PRIVATE: 作者のみ閲覧できる
+    ADD COLUMN visibility VARCHAR(16) NOT NULL DEFAULT 'PUBLIC';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plans
+    DROP COLUMN visibility;
+-- +goose StatementEnd
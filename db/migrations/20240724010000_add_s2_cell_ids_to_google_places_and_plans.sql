@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 近くの場所・プランを検索するために、位置を含む S2 セルの ID をレベルごとに保存する
+-- 既存のレコードは cmd/features/script_backfill_s2_cell_ids で値を設定する
+ALTER TABLE google_places
+    ADD COLUMN s2_cell_id_level10 BIGINT UNSIGNED DEFAULT NULL,
+    ADD COLUMN s2_cell_id_level13 BIGINT UNSIGNED DEFAULT NULL,
+    ADD COLUMN s2_cell_id_level16 BIGINT UNSIGNED DEFAULT NULL,
+    ADD INDEX (s2_cell_id_level10),
+    ADD INDEX (s2_cell_id_level13),
+    ADD INDEX (s2_cell_id_level16);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE plans
+    ADD COLUMN s2_cell_id_level10 BIGINT UNSIGNED DEFAULT NULL,
+    ADD COLUMN s2_cell_id_level13 BIGINT UNSIGNED DEFAULT NULL,
+    ADD COLUMN s2_cell_id_level16 BIGINT UNSIGNED DEFAULT NULL,
+    ADD INDEX (s2_cell_id_level10),
+    ADD INDEX (s2_cell_id_level13),
+    ADD INDEX (s2_cell_id_level16);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE google_places
+    DROP INDEX s2_cell_id_level10,
+    DROP INDEX s2_cell_id_level13,
+    DROP INDEX s2_cell_id_level16,
+    DROP COLUMN s2_cell_id_level10,
+    DROP COLUMN s2_cell_id_level13,
+    DROP COLUMN s2_cell_id_level16;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE plans
+    DROP INDEX s2_cell_id_level10,
+    DROP INDEX s2_cell_id_level13,
+    DROP INDEX s2_cell_id_level16,
+    DROP COLUMN s2_cell_id_level10,
+    DROP COLUMN s2_cell_id_level13,
+    DROP COLUMN s2_cell_id_level16;
+-- +goose StatementEnd
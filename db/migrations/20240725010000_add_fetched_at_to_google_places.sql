@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Google Places API から情報を取得した日時を項目ごとに保存し、古くなった情報を取得し直せるようにする
+-- opening_periods_fetched_at と reviews_fetched_at は Place Detail API で取得していない場合は NULL になる
+ALTER TABLE google_places
+    ADD COLUMN fetched_at                 TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    ADD COLUMN opening_periods_fetched_at TIMESTAMP NULL     DEFAULT NULL,
+    ADD COLUMN reviews_fetched_at         TIMESTAMP NULL     DEFAULT NULL,
+    ADD INDEX (fetched_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE google_places
+SET fetched_at = COALESCE(created_at, CURRENT_TIMESTAMP);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE google_places
+SET opening_periods_fetched_at = COALESCE(created_at, CURRENT_TIMESTAMP)
+WHERE EXISTS (SELECT 1
+              FROM google_place_opening_periods
+              WHERE google_place_opening_periods.google_place_id = google_places.google_place_id);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+UPDATE google_places
+SET reviews_fetched_at = COALESCE(created_at, CURRENT_TIMESTAMP)
+WHERE EXISTS (SELECT 1
+              FROM google_place_reviews
+              WHERE google_place_reviews.google_place_id = google_places.google_place_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE google_places
+    DROP INDEX fetched_at,
+    DROP COLUMN fetched_at,
+    DROP COLUMN opening_periods_fetched_at,
+    DROP COLUMN reviews_fetched_at;
+-- +goose StatementEnd
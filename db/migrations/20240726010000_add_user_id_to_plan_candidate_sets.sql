@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+-- プラン候補をユーザーに紐付けたことを記録し、期限切れのプラン候補を削除するときに残せるようにする
+ALTER TABLE plan_candidate_sets
+    ADD COLUMN user_id VARCHAR(36) DEFAULT NULL,
+    ADD CONSTRAINT fk_plan_candidate_sets_user_id FOREIGN KEY (user_id) REFERENCES users (id),
+    ADD INDEX (expires_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- すでにユーザーに紐付けられたプラン候補（プランの作成者が設定されているもの）を、削除されないように紐付けておく
+UPDATE plan_candidate_sets
+    INNER JOIN (SELECT plan_candidates.plan_candidate_set_id, MIN(plans.user_id) AS user_id
+                FROM plan_candidates
+                         INNER JOIN plans ON plans.id = plan_candidates.id
+                WHERE plans.user_id IS NOT NULL
+                GROUP BY plan_candidates.plan_candidate_set_id) AS plan_authors
+    ON plan_authors.plan_candidate_set_id = plan_candidate_sets.id
+SET plan_candidate_sets.user_id = plan_authors.user_id
+WHERE plan_candidate_sets.user_id IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE plan_candidate_sets
+    DROP FOREIGN KEY fk_plan_candidate_sets_user_id,
+    DROP INDEX expires_at,
+    DROP COLUMN user_id;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+    -- TRUE の場合は、ユーザーの好みをプランの作成に反映しない
+    ADD COLUMN personalization_disabled BOOLEAN NOT NULL DEFAULT FALSE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users
+    DROP COLUMN personalization_disabled;
+-- +goose StatementEnd
@@ -4,4 +4,7 @@ import "errors"
 
 var (
 	ErrUnauthorized = errors.New("permission denied")
+
+	// ErrVersionConflict は編集しようとしたデータが、他のリクエストによってすでに更新されていることを表す
+	ErrVersionConflict = errors.New("version conflict")
 )
@@ -0,0 +1,173 @@
+package application
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/repository"
+	"poroto.app/poroto/planner/internal/domain/services/place"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
+	"poroto.app/poroto/planner/internal/domain/services/placesearch"
+	"poroto.app/poroto/planner/internal/domain/services/plan"
+	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
+	"poroto.app/poroto/planner/internal/domain/services/plangen"
+	"poroto.app/poroto/planner/internal/domain/services/user"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/api/google/places"
+	"poroto.app/poroto/planner/internal/infrastructure/api/openai"
+	"poroto.app/poroto/planner/internal/infrastructure/api/routing"
+	"poroto.app/poroto/planner/internal/infrastructure/api/weather"
+	"poroto.app/poroto/planner/internal/infrastructure/auth"
+	"poroto.app/poroto/planner/internal/infrastructure/pubsub"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb"
+)
+
+// Container サーバーの起動時に一度だけ組み立て、リクエスト間で共有する依存関係
+type Container struct {
+	Logger       *zap.Logger
+	FirebaseAuth *auth.FirebaseAuth
+
+	UserRepository          repository.UserRepository
+	PlaceRepository         repository.PlaceRepository
+	PlanRepository          repository.PlanRepository
+	PlanCandidateRepository repository.PlanCandidateRepository
+
+	RoutingProvider models.RoutingProvider
+	// PlanCandidateEventBroker はリクエスト間で購読者を共有するため、サーバー全体で一つのインスタンスを使う
+	PlanCandidateEventBroker models.PlanCandidateEventBroker
+
+	UserService          *user.Service
+	PlaceSearchService   *placesearch.Service
+	PlaceService         *place.Service
+	PlanService          *plan.Service
+	PlanCandidateService *plancandidate.Service
+	PlanGenService       *plangen.Service
+}
+
+// NewContainer 環境変数の設定をもとに外部サービスのクライアントとリポジトリを初期化し、各サービスに渡す
+func NewContainer(ctx context.Context, db *sql.DB) (*Container, error) {
+	logger, err := utils.NewLogger(utils.LoggerOption{})
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing logger: %v", err)
+	}
+
+	clock := utils.NewSystemClock()
+
+	userRepository, err := rdb.NewUserRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing user repository: %v", err)
+	}
+
+	placeRepository, err := rdb.NewPlaceRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing place repository: %v", err)
+	}
+
+	planRepository, err := rdb.NewPlanRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing plan repository: %v", err)
+	}
+
+	planCandidateRepository, err := rdb.NewPlanCandidateRepository(db)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing plan candidate repository: %v", err)
+	}
+
+	firebaseAuth, err := auth.NewFirebaseAuth(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing firebase auth: %v", err)
+	}
+
+	placesApi, err := places.NewPlacesApi()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing places api: %v", err)
+	}
+
+	openaiChatCompletionClient, err := openai.NewChatCompletionClient()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing openai chat completion client: %v", err)
+	}
+
+	routingProvider, err := routing.NewRoutingProvider()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing routing provider: %v", err)
+	}
+
+	weatherProvider, err := weather.NewWeatherProvider()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing weather provider: %v", err)
+	}
+
+	planCandidateEventBroker, err := pubsub.NewPlanCandidateEventBroker()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing plan candidate event broker: %v", err)
+	}
+
+	placeFilterPipelineConfig, err := placefilter.NewPipelineConfig()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing place filter pipeline config: %v", err)
+	}
+
+	userService := user.NewService(userRepository, placeRepository, planRepository, planCandidateRepository, firebaseAuth)
+	placeSearchService := placesearch.NewService(placesApi, placeRepository, planCandidateRepository, clock, logger)
+
+	return &Container{
+		Logger:       logger,
+		FirebaseAuth: firebaseAuth,
+
+		UserRepository:          userRepository,
+		PlaceRepository:         placeRepository,
+		PlanRepository:          planRepository,
+		PlanCandidateRepository: planCandidateRepository,
+
+		RoutingProvider:          routingProvider,
+		PlanCandidateEventBroker: planCandidateEventBroker,
+
+		UserService:        userService,
+		PlaceSearchService: placeSearchService,
+		PlaceService: place.NewService(
+			placeSearchService,
+			userService,
+			placeRepository,
+			planRepository,
+			planCandidateRepository,
+			*placeFilterPipelineConfig,
+			clock,
+			logger,
+		),
+		PlanService: plan.NewService(
+			userService,
+			placeRepository,
+			planRepository,
+			planCandidateRepository,
+			clock,
+			logger,
+		),
+		PlanCandidateService: plancandidate.NewService(
+			userService,
+			placeSearchService,
+			placeRepository,
+			planRepository,
+			planCandidateRepository,
+			*placeFilterPipelineConfig,
+			routingProvider,
+			clock,
+			logger,
+		),
+		PlanGenService: plangen.NewService(
+			placeSearchService,
+			placeRepository,
+			planCandidateRepository,
+			planRepository,
+			openaiChatCompletionClient,
+			routingProvider,
+			weatherProvider,
+			*placeFilterPipelineConfig,
+			clock,
+			logger,
+		),
+	}, nil
+}
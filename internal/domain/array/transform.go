@@ -59,3 +59,23 @@ func Take[T any](slice []T, n int) []T {
 	}
 	return slice[:n]
 }
+
+// Chunk はスライスを size 個ずつに分割する（size が 0 以下の場合は分割しない）
+func Chunk[T any](slice []T, size int) [][]T {
+	if len(slice) == 0 {
+		return [][]T{}
+	}
+	if size <= 0 || size >= len(slice) {
+		return [][]T{slice}
+	}
+
+	chunks := make([][]T, 0, (len(slice)+size-1)/size)
+	for start := 0; start < len(slice); start += size {
+		end := start + size
+		if end > len(slice) {
+			end = len(slice)
+		}
+		chunks = append(chunks, slice[start:end])
+	}
+	return chunks
+}
@@ -302,3 +302,49 @@ func TestTake(t *testing.T) {
 		})
 	}
 }
+
+func TestChunk(t *testing.T) {
+	cases := []struct {
+		name     string
+		slice    []int
+		size     int
+		expected [][]int
+	}{
+		{
+			name:     "empty slice",
+			slice:    []int{},
+			size:     2,
+			expected: [][]int{},
+		},
+		{
+			name:     "size is greater than length of slice",
+			slice:    []int{1, 2, 3},
+			size:     4,
+			expected: [][]int{{1, 2, 3}},
+		},
+		{
+			name:     "split into chunks with the remainder at the end",
+			slice:    []int{1, 2, 3, 4, 5},
+			size:     2,
+			expected: [][]int{{1, 2}, {3, 4}, {5}},
+		},
+		{
+			name:     "size is not positive",
+			slice:    []int{1, 2, 3},
+			size:     0,
+			expected: [][]int{{1, 2, 3}},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := Chunk(c.slice, c.size)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Chunk() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
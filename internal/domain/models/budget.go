@@ -0,0 +1,41 @@
+package models
+
+// Budget 予算（円）
+// Min, Max はそれぞれ推定される金額の下限と上限を表す
+type Budget struct {
+	Min int
+	Max int
+}
+
+// EstimatedBudgetOfPlaces 場所を巡るときにかかる一人あたりの金額を推定する
+// 価格帯が推定できない場所は金額に含めない
+func EstimatedBudgetOfPlaces(places []Place) Budget {
+	var budget Budget
+	for _, place := range places {
+		priceRange := place.EstimatedPriceRange()
+		if priceRange == nil {
+			continue
+		}
+
+		budget.Min += priceRange.Min
+		budget.Max += priceRange.Max
+	}
+	return budget
+}
+
+// ForPeople 人数分の金額を求める
+func (b Budget) ForPeople(numberOfPeople int) Budget {
+	if numberOfPeople <= 0 {
+		numberOfPeople = 1
+	}
+
+	return Budget{
+		Min: b.Min * numberOfPeople,
+		Max: b.Max * numberOfPeople,
+	}
+}
+
+// IsWithin 推定される金額の上限が budgetMax 以下であるかを判定する
+func (b Budget) IsWithin(budgetMax int) bool {
+	return b.Max <= budgetMax
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestEstimatedBudgetOfPlaces(t *testing.T) {
+	cases := []struct {
+		name     string
+		places   []Place
+		expected Budget
+	}{
+		{
+			name:     "no places",
+			places:   []Place{},
+			expected: Budget{Min: 0, Max: 0},
+		},
+		{
+			name: "sum of price ranges of places",
+			places: []Place{
+				{Id: "1", Google: GooglePlace{PriceLevel: 1}},
+				{Id: "2", Google: GooglePlace{PriceLevel: 2}},
+				{Id: "3", Google: GooglePlace{PriceLevel: 0}},
+			},
+			expected: Budget{Min: maxPriceOfLevel1, Max: maxPriceOfLevel1 + maxPriceOfLevel2},
+		},
+		{
+			name: "places whose price range cannot be estimated are ignored",
+			places: []Place{
+				{Id: "1", Google: GooglePlace{PriceLevel: 3}},
+				{Id: "2", Google: GooglePlace{PriceLevel: -1}},
+			},
+			expected: Budget{Min: maxPriceOfLevel2, Max: maxPriceOfLevel3},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := EstimatedBudgetOfPlaces(c.places)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBudget_ForPeople(t *testing.T) {
+	cases := []struct {
+		name           string
+		budget         Budget
+		numberOfPeople int
+		expected       Budget
+	}{
+		{
+			name:           "budget for one person",
+			budget:         Budget{Min: 1000, Max: 3000},
+			numberOfPeople: 1,
+			expected:       Budget{Min: 1000, Max: 3000},
+		},
+		{
+			name:           "budget for three people",
+			budget:         Budget{Min: 1000, Max: 3000},
+			numberOfPeople: 3,
+			expected:       Budget{Min: 3000, Max: 9000},
+		},
+		{
+			name:           "number of people is not specified",
+			budget:         Budget{Min: 1000, Max: 3000},
+			numberOfPeople: 0,
+			expected:       Budget{Min: 1000, Max: 3000},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.budget.ForPeople(c.numberOfPeople)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -11,9 +11,20 @@ type LocationCategory struct {
 	DisplayName           string
 	SubCategories         []string
 	DefaultPhoto          string
+	Environment           PlaceEnvironment
 	EstimatedStayDuration uint
 }
 
+// PlaceEnvironment 場所が屋内・屋外のどちらにあるかを示す
+type PlaceEnvironment string
+
+const (
+	PlaceEnvironmentIndoor  PlaceEnvironment = "INDOOR"
+	PlaceEnvironmentOutdoor PlaceEnvironment = "OUTDOOR"
+	// PlaceEnvironmentMixed 屋内・屋外のどちらの場合もある（動物園と水族館 など）
+	PlaceEnvironmentMixed PlaceEnvironment = "MIXED"
+)
+
 var (
 	// SEE: https://developers.google.com/maps/documentation/places/web-service/supported_types?hl=ja#table1
 	CategoryAmusements = LocationCategory{
@@ -27,6 +38,7 @@ var (
 			string(maps.PlaceTypeStadium),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_amusement_park_17oe.svg",
+		Environment:           PlaceEnvironmentMixed,
 		EstimatedStayDuration: 30,
 	}
 
@@ -37,6 +49,7 @@ var (
 			string(maps.PlaceTypeBakery),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_pancakes_238t.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 20,
 	}
 
@@ -47,6 +60,7 @@ var (
 			string(maps.PlaceTypeCafe),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_coffee_re_x35h.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 20,
 	}
 
@@ -58,6 +72,7 @@ var (
 			string(maps.PlaceTypeMuseum),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_art_lover_re_fn8g.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 30,
 	}
 
@@ -69,6 +84,7 @@ var (
 			string(maps.PlaceTypeZoo),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_fish_bowl_uu88.svg",
+		Environment:           PlaceEnvironmentMixed,
 		EstimatedStayDuration: 30,
 	}
 
@@ -79,6 +95,7 @@ var (
 			string(maps.PlaceTypePark),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_a_day_at_the_park_re_9kxj.svg",
+		Environment:           PlaceEnvironmentOutdoor,
 		EstimatedStayDuration: 10,
 	}
 
@@ -92,6 +109,7 @@ var (
 			string(maps.PlaceTypeMealTakeaway),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_breakfast_psiw.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 20,
 	}
 
@@ -111,6 +129,7 @@ var (
 			string(maps.PlaceTypeStore),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_shopping_bags_o6w5.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 20,
 	}
 
@@ -121,6 +140,7 @@ var (
 			string(maps.PlaceTypeSpa),
 		},
 		DefaultPhoto:          "https://storage.googleapis.com/planner-public-asset-bucket/undraw_mint_tea_-7-su0.svg",
+		Environment:           PlaceEnvironmentIndoor,
 		EstimatedStayDuration: 30,
 	}
 
@@ -128,6 +148,7 @@ var (
 		Name:                  "other",
 		DisplayName:           "その他",
 		SubCategories:         []string{},
+		Environment:           PlaceEnvironmentMixed,
 		EstimatedStayDuration: 0,
 	}
 
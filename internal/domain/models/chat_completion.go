@@ -0,0 +1,55 @@
+package models
+
+import "context"
+
+type ChatMessageRole string
+
+const (
+	ChatMessageRoleSystem    ChatMessageRole = "system"
+	ChatMessageRoleUser      ChatMessageRole = "user"
+	ChatMessageRoleAssistant ChatMessageRole = "assistant"
+)
+
+// ChatCompletionRequest 大規模言語モデルに文章を生成させるときの入力
+// N は生成する候補の数で、指定しない場合は1件のみ生成する
+// Tool が指定された場合は、文章を生成する代わりに必ずその関数を呼び出させる
+type ChatCompletionRequest struct {
+	Messages []ChatMessage
+	N        int
+	Tool     *ChatCompletionTool
+}
+
+type ChatMessage struct {
+	Role    ChatMessageRole
+	Content string
+}
+
+// ChatCompletionTool モデルが呼び出すことのできる関数
+// Parameters には引数の JSON Schema を指定する
+type ChatCompletionTool struct {
+	Name        string
+	Description string
+	Parameters  map[string]interface{}
+}
+
+// ChatCompletionResponse 生成された候補
+type ChatCompletionResponse struct {
+	Choices []ChatCompletionChoice
+}
+
+type ChatCompletionChoice struct {
+	Content   string
+	ToolCalls []ChatCompletionToolCall
+}
+
+// ChatCompletionToolCall モデルによる関数の呼び出し
+// Arguments には JSON 形式の引数が文字列として含まれる
+type ChatCompletionToolCall struct {
+	Name      string
+	Arguments string
+}
+
+// ChatCompletionClient 大規模言語モデルによる文章の生成
+type ChatCompletionClient interface {
+	Complete(ctx context.Context, request ChatCompletionRequest) (*ChatCompletionResponse, error)
+}
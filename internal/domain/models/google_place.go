@@ -42,28 +42,15 @@ func (g GooglePlace) IsOpening(at time.Time) (bool, error) {
 		return true, nil
 	}
 
+	// 営業時間は場所のタイムゾーンでの曜日・時刻で判定する
+	atInPlace := at.In(g.Location.TimeZone())
 	for _, openingPeriod := range g.PlaceDetail.OpeningHours.Periods {
-		weekday := at.Weekday()
-		isOpeningPeriodOfToday := openingPeriod.DayOfWeekOpen == weekday.String()
-		if !isOpeningPeriodOfToday {
-			continue
-		}
-
-		openingTime, err := openingPeriod.OpeningTimeHHMM()
-		if err != nil {
-			return false, fmt.Errorf("error while parsing opening time")
-		}
-
-		closingTime, err := openingPeriod.ClosingTimeHHMM()
+		isOpening, err := openingPeriod.IsOpeningAt(atInPlace)
 		if err != nil {
-			return false, fmt.Errorf("error while parsing closing time")
+			return false, fmt.Errorf("error while checking opening period: %v", err)
 		}
 
-		today := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
-		openingTimeAtToday := today.Add(time.Hour*time.Duration(openingTime.Hour) + time.Minute*time.Duration(openingTime.Minute))
-		closingTimeAtToday := today.Add(time.Hour*time.Duration(closingTime.Hour) + time.Minute*time.Duration(closingTime.Minute))
-
-		if at.After(openingTimeAtToday) && at.Before(closingTimeAtToday) {
+		if isOpening {
 			return true, nil
 		}
 	}
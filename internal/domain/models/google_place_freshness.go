@@ -0,0 +1,99 @@
+package models
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// GooglePlaceFreshness は Google Places API から取得した情報が、項目ごとにいつ取得されたかを表す
+// FetchedAt は名前・評価・価格帯・種類などの基本情報を取得した日時
+// OpeningPeriodsFetchedAt と ReviewsFetchedAt は Place Detail API で取得していない場合は nil になる
+type GooglePlaceFreshness struct {
+	GooglePlaceId           string
+	UserRatingsTotal        int
+	FetchedAt               time.Time
+	OpeningPeriodsFetchedAt *time.Time
+	ReviewsFetchedAt        *time.Time
+}
+
+// GooglePlaceRefreshPolicy は Google Places API から取得した情報を取得し直すまでの期間
+// 営業時間やレビューは基本情報よりも変わりやすいため、DetailMaxAge は MaxAge よりも短くする
+type GooglePlaceRefreshPolicy struct {
+	MaxAge       time.Duration
+	DetailMaxAge time.Duration
+}
+
+func DefaultGooglePlaceRefreshPolicy() GooglePlaceRefreshPolicy {
+	return GooglePlaceRefreshPolicy{
+		MaxAge:       90 * 24 * time.Hour,
+		DetailMaxAge: 30 * 24 * time.Hour,
+	}
+}
+
+// FetchedBefore はこの日時より前に取得された基本情報を古いとみなす
+func (p GooglePlaceRefreshPolicy) FetchedBefore(now time.Time) time.Time {
+	return now.Add(-p.MaxAge)
+}
+
+// DetailFetchedBefore はこの日時より前に取得された営業時間・レビューを古いとみなす
+func (p GooglePlaceRefreshPolicy) DetailFetchedBefore(now time.Time) time.Time {
+	return now.Add(-p.DetailMaxAge)
+}
+
+// Staleness は取得してからの経過時間を、取得し直すまでの期間で割った値のうち最も大きいものを返す
+// 1 以上の場合は取得し直す必要がある
+func (f GooglePlaceFreshness) Staleness(policy GooglePlaceRefreshPolicy, now time.Time) float64 {
+	staleness := stalenessOf(f.FetchedAt, policy.MaxAge, now)
+
+	for _, detailFetchedAt := range []*time.Time{f.OpeningPeriodsFetchedAt, f.ReviewsFetchedAt} {
+		if detailFetchedAt == nil {
+			continue
+		}
+		staleness = math.Max(staleness, stalenessOf(*detailFetchedAt, policy.DetailMaxAge, now))
+	}
+
+	return staleness
+}
+
+func (f GooglePlaceFreshness) IsStale(policy GooglePlaceRefreshPolicy, now time.Time) bool {
+	return f.Staleness(policy, now) >= 1
+}
+
+// RefreshPriority は取得し直す優先度を返す
+// 古い情報ほど、またレビュー数が多く人気のある場所ほど優先度が高くなる
+func (f GooglePlaceFreshness) RefreshPriority(policy GooglePlaceRefreshPolicy, now time.Time) float64 {
+	popularity := math.Log10(10 + float64(f.UserRatingsTotal))
+	return f.Staleness(policy, now) * popularity
+}
+
+// SelectGooglePlacesToRefresh は古い情報を持つ場所の中から、優先度の高い順に最大 budget 件を選ぶ
+func SelectGooglePlacesToRefresh(candidates []GooglePlaceFreshness, policy GooglePlaceRefreshPolicy, now time.Time, budget int) []GooglePlaceFreshness {
+	if budget <= 0 {
+		return nil
+	}
+
+	var stalePlaces []GooglePlaceFreshness
+	for _, candidate := range candidates {
+		if candidate.IsStale(policy, now) {
+			stalePlaces = append(stalePlaces, candidate)
+		}
+	}
+
+	sort.SliceStable(stalePlaces, func(i, j int) bool {
+		return stalePlaces[i].RefreshPriority(policy, now) > stalePlaces[j].RefreshPriority(policy, now)
+	})
+
+	if len(stalePlaces) > budget {
+		return stalePlaces[:budget]
+	}
+
+	return stalePlaces
+}
+
+func stalenessOf(fetchedAt time.Time, maxAge time.Duration, now time.Time) float64 {
+	if maxAge <= 0 {
+		return math.Inf(1)
+	}
+	return float64(now.Sub(fetchedAt)) / float64(maxAge)
+}
@@ -0,0 +1,125 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func TestGooglePlaceFreshness_IsStale(t *testing.T) {
+	now := time.Date(2024, 7, 25, 0, 0, 0, 0, time.UTC)
+	policy := GooglePlaceRefreshPolicy{
+		MaxAge:       90 * 24 * time.Hour,
+		DetailMaxAge: 30 * 24 * time.Hour,
+	}
+
+	cases := []struct {
+		name      string
+		freshness GooglePlaceFreshness
+		expected  bool
+	}{
+		{
+			name: "recently fetched",
+			freshness: GooglePlaceFreshness{
+				FetchedAt:               now.AddDate(0, 0, -10),
+				OpeningPeriodsFetchedAt: utils.ToPointer(now.AddDate(0, 0, -10)),
+				ReviewsFetchedAt:        utils.ToPointer(now.AddDate(0, 0, -10)),
+			},
+			expected: false,
+		},
+		{
+			name: "basic information is stale",
+			freshness: GooglePlaceFreshness{
+				FetchedAt: now.AddDate(0, 0, -100),
+			},
+			expected: true,
+		},
+		{
+			name: "opening periods are stale",
+			freshness: GooglePlaceFreshness{
+				FetchedAt:               now.AddDate(0, 0, -40),
+				OpeningPeriodsFetchedAt: utils.ToPointer(now.AddDate(0, 0, -40)),
+			},
+			expected: true,
+		},
+		{
+			name: "detail is not fetched",
+			freshness: GooglePlaceFreshness{
+				FetchedAt: now.AddDate(0, 0, -40),
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.freshness.IsStale(policy, now)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("IsStale() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectGooglePlacesToRefresh(t *testing.T) {
+	now := time.Date(2024, 7, 25, 0, 0, 0, 0, time.UTC)
+	policy := GooglePlaceRefreshPolicy{
+		MaxAge:       90 * 24 * time.Hour,
+		DetailMaxAge: 30 * 24 * time.Hour,
+	}
+
+	cases := []struct {
+		name       string
+		candidates []GooglePlaceFreshness
+		budget     int
+		expected   []string
+	}{
+		{
+			name: "fresh places are not selected",
+			candidates: []GooglePlaceFreshness{
+				{GooglePlaceId: "fresh", FetchedAt: now.AddDate(0, 0, -10)},
+				{GooglePlaceId: "stale", FetchedAt: now.AddDate(0, 0, -100)},
+			},
+			budget:   10,
+			expected: []string{"stale"},
+		},
+		{
+			name: "older places are selected first",
+			candidates: []GooglePlaceFreshness{
+				{GooglePlaceId: "stale", FetchedAt: now.AddDate(0, 0, -100)},
+				{GooglePlaceId: "very-stale", FetchedAt: now.AddDate(0, 0, -300)},
+			},
+			budget:   1,
+			expected: []string{"very-stale"},
+		},
+		{
+			name: "popular places are selected first",
+			candidates: []GooglePlaceFreshness{
+				{GooglePlaceId: "unpopular", FetchedAt: now.AddDate(0, 0, -120), UserRatingsTotal: 0},
+				{GooglePlaceId: "popular", FetchedAt: now.AddDate(0, 0, -100), UserRatingsTotal: 1000},
+			},
+			budget:   1,
+			expected: []string{"popular"},
+		},
+		{
+			name: "no budget",
+			candidates: []GooglePlaceFreshness{
+				{GooglePlaceId: "stale", FetchedAt: now.AddDate(0, 0, -100)},
+			},
+			budget:   0,
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := SelectGooglePlacesToRefresh(c.candidates, policy, now, c.budget)
+			if diff := cmp.Diff(c.expected, array.Map(actual, func(f GooglePlaceFreshness) string { return f.GooglePlaceId })); diff != "" {
+				t.Errorf("SelectGooglePlacesToRefresh() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"fmt"
 	"strconv"
+	"time"
 )
 
 type GooglePlaceOpeningHours struct {
@@ -48,3 +49,65 @@ func parseTimeString(timeStr string) (time *TimeHHMM, err error) {
 		Minute: minute,
 	}, nil
 }
+
+// IsOpeningAt は at の時点で営業期間内かどうかを判定する
+// 日付をまたぐ営業期間（例：金曜 18:00 ~ 土曜 02:00）にも対応する
+func (g GooglePlaceOpeningPeriod) IsOpeningAt(at time.Time) (bool, error) {
+	openingTime, err := g.OpeningTimeHHMM()
+	if err != nil {
+		return false, fmt.Errorf("error while parsing opening time: %v", err)
+	}
+
+	// 終了時刻がない場合は24時間営業を表す
+	// See: https://developers.google.com/maps/documentation/places/web-service/details?hl=ja#PlaceOpeningHoursPeriod
+	if g.ClosingTime == "" {
+		return true, nil
+	}
+
+	closingTime, err := g.ClosingTimeHHMM()
+	if err != nil {
+		return false, fmt.Errorf("error while parsing closing time: %v", err)
+	}
+
+	weekdayOpen, err := parseWeekdayString(g.DayOfWeekOpen)
+	if err != nil {
+		return false, err
+	}
+
+	weekdayClose, err := parseWeekdayString(g.DayOfWeekClose)
+	if err != nil {
+		return false, err
+	}
+
+	// at 以前で直近の営業開始日時を求める
+	today := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+	daysSinceOpen := (int(at.Weekday()) - int(weekdayOpen) + 7) % 7
+	openingAt := today.AddDate(0, 0, -daysSinceOpen).Add(openingTime.duration())
+	if openingAt.After(at) {
+		openingAt = openingAt.AddDate(0, 0, -7)
+	}
+
+	// 営業開始日時から営業終了日時を求める
+	openingDay := time.Date(openingAt.Year(), openingAt.Month(), openingAt.Day(), 0, 0, 0, 0, at.Location())
+	daysUntilClose := (int(weekdayClose) - int(weekdayOpen) + 7) % 7
+	closingAt := openingDay.AddDate(0, 0, daysUntilClose).Add(closingTime.duration())
+	if !closingAt.After(openingAt) {
+		closingAt = closingAt.AddDate(0, 0, 7)
+	}
+
+	return at.Before(closingAt), nil
+}
+
+func (t TimeHHMM) duration() time.Duration {
+	return time.Hour*time.Duration(t.Hour) + time.Minute*time.Duration(t.Minute)
+}
+
+// parseWeekdayString は time.Weekday.String() の形式で与えられる曜日をパースする
+func parseWeekdayString(weekdayStr string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if weekday.String() == weekdayStr {
+			return weekday, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday string: %s", weekdayStr)
+}
@@ -3,6 +3,7 @@ package models
 import (
 	"github.com/google/go-cmp/cmp"
 	"testing"
+	"time"
 )
 
 func TestParseTimeString(t *testing.T) {
@@ -42,3 +43,123 @@ func TestParseTimeString(t *testing.T) {
 		})
 	}
 }
+
+func TestGooglePlaceOpeningPeriod_IsOpeningAt(t *testing.T) {
+	cases := []struct {
+		name          string
+		openingPeriod GooglePlaceOpeningPeriod
+		at            time.Time
+		expected      bool
+	}{
+		{
+			name: "opening in the period of the same day",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Monday",
+				DayOfWeekClose: "Monday",
+				OpeningTime:    "1000",
+				ClosingTime:    "2000",
+			},
+			at:       time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "opening at the opening time",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Monday",
+				DayOfWeekClose: "Monday",
+				OpeningTime:    "1000",
+				ClosingTime:    "2000",
+			},
+			at:       time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "closed at the closing time",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Monday",
+				DayOfWeekClose: "Monday",
+				OpeningTime:    "1000",
+				ClosingTime:    "2000",
+			},
+			at:       time.Date(2024, 7, 1, 20, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "closed on another day",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Monday",
+				DayOfWeekClose: "Monday",
+				OpeningTime:    "1000",
+				ClosingTime:    "2000",
+			},
+			at:       time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "opening after midnight in the overnight period",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Friday",
+				DayOfWeekClose: "Saturday",
+				OpeningTime:    "1800",
+				ClosingTime:    "0200",
+			},
+			at:       time.Date(2024, 7, 6, 1, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "opening before midnight in the overnight period",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Friday",
+				DayOfWeekClose: "Saturday",
+				OpeningTime:    "1800",
+				ClosingTime:    "0200",
+			},
+			at:       time.Date(2024, 7, 5, 23, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "closed after the overnight period",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Friday",
+				DayOfWeekClose: "Saturday",
+				OpeningTime:    "1800",
+				ClosingTime:    "0200",
+			},
+			at:       time.Date(2024, 7, 6, 3, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name: "overnight period across the end of the week",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen:  "Saturday",
+				DayOfWeekClose: "Sunday",
+				OpeningTime:    "2000",
+				ClosingTime:    "0300",
+			},
+			at:       time.Date(2024, 7, 7, 2, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name: "always opening when closing time is empty",
+			openingPeriod: GooglePlaceOpeningPeriod{
+				DayOfWeekOpen: "Sunday",
+				OpeningTime:   "0000",
+			},
+			at:       time.Date(2024, 7, 3, 4, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := c.openingPeriod.IsOpeningAt(c.at)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("IsOpeningAt() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
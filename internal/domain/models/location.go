@@ -4,8 +4,12 @@ import (
 	"github.com/golang/geo/s1"
 	"github.com/golang/geo/s2"
 	"math"
+	"time"
 )
 
+// timeZoneJapan 日本標準時（夏時間はない）
+var timeZoneJapan = time.FixedZone("Asia/Tokyo", 9*60*60)
+
 type GeoLocation struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
@@ -16,6 +20,12 @@ func (g GeoLocation) IsZero() bool {
 	return g.Latitude == 0.0 && g.Longitude == 0.0
 }
 
+// TimeZone その地点で用いられるタイムゾーン
+// 現在は日本国内の場所のみを扱っているため、常に日本標準時を返す
+func (g GeoLocation) TimeZone() *time.Location {
+	return timeZoneJapan
+}
+
 // DistanceInMeter 2点間距離(メートル)
 // SEE: https://www.geodatasource.com/developers/go
 func (g GeoLocation) DistanceInMeter(another GeoLocation) float64 {
@@ -41,15 +51,7 @@ func (g GeoLocation) DistanceInMeter(another GeoLocation) float64 {
 
 // CalculateMBR 特定の位置からの距離を元に、緯度の差分を計算する
 func (g GeoLocation) CalculateMBR(distance float64) (minLocation GeoLocation, maxLocation GeoLocation) {
-	// 地球の半径（メートル単位）
-	const earthRadius = 6371e3
-
-	latLng := s2.LatLngFromDegrees(g.Latitude, g.Longitude)
-	point := s2.PointFromLatLng(latLng)
-
-	angle := s1.Angle(distance / earthRadius)
-	cap := s2.CapFromCenterAngle(point, angle)
-	rect := cap.RectBound()
+	rect := g.capWithRadius(distance).RectBound()
 
 	minLocation = GeoLocation{
 		Latitude:  rect.Lo().Lat.Degrees(),
@@ -64,6 +66,18 @@ func (g GeoLocation) CalculateMBR(distance float64) (minLocation GeoLocation, ma
 	return minLocation, maxLocation
 }
 
+// capWithRadius g を中心とする半径 distance メートルの球冠を求める
+func (g GeoLocation) capWithRadius(distance float64) s2.Cap {
+	// 地球の半径（メートル単位）
+	const earthRadius = 6371e3
+
+	latLng := s2.LatLngFromDegrees(g.Latitude, g.Longitude)
+	point := s2.PointFromLatLng(latLng)
+
+	angle := s1.Angle(distance / earthRadius)
+	return s2.CapFromCenterAngle(point, angle)
+}
+
 func (g GeoLocation) TravelTimeTo(
 	destination GeoLocation,
 	meterPerMinutes float64,
@@ -76,6 +90,19 @@ func (g GeoLocation) TravelTimeTo(
 	return timeInMinutes
 }
 
+// DetourDistanceInMeter from から to へ向かう途中で g に立ち寄るときに、遠回りになる距離を求める
+func (g GeoLocation) DetourDistanceInMeter(from GeoLocation, to GeoLocation) float64 {
+	return from.DistanceInMeter(g) + g.DistanceInMeter(to) - from.DistanceInMeter(to)
+}
+
+// MidpointTo g と another の中間地点を求める
+func (g GeoLocation) MidpointTo(another GeoLocation) GeoLocation {
+	return GeoLocation{
+		Latitude:  (g.Latitude + another.Latitude) / 2,
+		Longitude: (g.Longitude + another.Longitude) / 2,
+	}
+}
+
 func (g GeoLocation) Equal(other GeoLocation) bool {
 	return g.Latitude == other.Latitude && g.Longitude == other.Longitude
 }
@@ -171,3 +171,34 @@ func TestEqual(t *testing.T) {
 		})
 	}
 }
+
+func TestGeoLocation_DetourDistanceInMeter(t *testing.T) {
+	from := GeoLocation{Latitude: 0, Longitude: 0}
+	to := GeoLocation{Latitude: 0, Longitude: 0.02}
+
+	cases := []struct {
+		name     string
+		location GeoLocation
+		expected float64
+	}{
+		{
+			name:     "location on the way",
+			location: GeoLocation{Latitude: 0, Longitude: 0.01},
+			expected: 0,
+		},
+		{
+			name:     "location beyond destination",
+			location: GeoLocation{Latitude: 0, Longitude: 0.03},
+			expected: 2 * to.DistanceInMeter(GeoLocation{Latitude: 0, Longitude: 0.03}),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := c.location.DetourDistanceInMeter(from, to)
+			if math.Abs(result-c.expected) > 1 {
+				t.Errorf("expected: %v\nactual: %v", c.expected, result)
+			}
+		})
+	}
+}
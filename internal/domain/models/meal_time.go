@@ -0,0 +1,123 @@
+package models
+
+import "time"
+
+// MealTimeSlot 食事・休憩に適した時間帯
+// StartHour 時から EndHour 時までを表す
+type MealTimeSlot struct {
+	Name      string
+	StartHour int
+	EndHour   int
+}
+
+var (
+	MealTimeSlotLunch = MealTimeSlot{
+		Name:      "lunch",
+		StartHour: 11,
+		EndHour:   14,
+	}
+	MealTimeSlotDinner = MealTimeSlot{
+		Name:      "dinner",
+		StartHour: 17,
+		EndHour:   21,
+	}
+	MealTimeSlotAfternoonCafe = MealTimeSlot{
+		Name:      "afternoon_cafe",
+		StartHour: 14,
+		EndHour:   17,
+	}
+)
+
+// MealTimeSlots 食事をとる時間帯
+var MealTimeSlots = []MealTimeSlot{
+	MealTimeSlotLunch,
+	MealTimeSlotDinner,
+}
+
+// Contains t が時間帯に含まれるかを判定する
+func (m MealTimeSlot) Contains(t time.Time) bool {
+	return m.StartHour <= t.Hour() && t.Hour() < m.EndHour
+}
+
+// OverlapsWith startAt から endAt までの間に時間帯が含まれるかを判定する
+func (m MealTimeSlot) OverlapsWith(startAt time.Time, endAt time.Time) bool {
+	firstDay := time.Date(startAt.Year(), startAt.Month(), startAt.Day(), 0, 0, 0, 0, startAt.Location())
+	for day := firstDay; day.Before(endAt); day = day.AddDate(0, 0, 1) {
+		slotStart := time.Date(day.Year(), day.Month(), day.Day(), m.StartHour, 0, 0, 0, day.Location())
+		slotEnd := time.Date(day.Year(), day.Month(), day.Day(), m.EndHour, 0, 0, 0, day.Location())
+		if startAt.Before(slotEnd) && slotStart.Before(endAt) {
+			return true
+		}
+	}
+	return false
+}
+
+// MealTimeSlotsOfPlace 場所に訪れるのに適した時間帯を返す
+// 飲食店は昼食・夕食の時間帯、カフェは午後の時間帯に訪れるものとする
+// 時間帯の指定がない場所は nil を返す
+func MealTimeSlotsOfPlace(place Place) []MealTimeSlot {
+	mainCategory := place.MainCategory()
+	if mainCategory == nil {
+		return nil
+	}
+
+	switch {
+	case mainCategory.IsCategoryOf(CategoryRestaurant):
+		return MealTimeSlots
+	case mainCategory.IsCategoryOf(CategoryCafe):
+		return []MealTimeSlot{MealTimeSlotAfternoonCafe}
+	default:
+		return nil
+	}
+}
+
+// IsArrivingAtMealTime は飲食店・カフェに適した時間帯に到着するかどうかを判定する
+func IsArrivingAtMealTime(places []Place, schedules []PlaceSchedule) bool {
+	for _, schedule := range schedules {
+		place, ok := findPlaceById(places, schedule.PlaceId)
+		if !ok {
+			continue
+		}
+
+		slots := MealTimeSlotsOfPlace(*place)
+		if slots == nil {
+			continue
+		}
+
+		// 食事の時間帯は場所のタイムゾーンでの時刻で判定する
+		arrivalAt := schedule.ArrivalAt.In(place.Location.TimeZone())
+		isInSlot := false
+		for _, slot := range slots {
+			if slot.Contains(arrivalAt) {
+				isInSlot = true
+				break
+			}
+		}
+
+		if !isInSlot {
+			return false
+		}
+	}
+	return true
+}
+
+// MealTimeSlotsDuring startAt から endAt までの間に含まれる食事の時間帯を返す
+func MealTimeSlotsDuring(startAt time.Time, endAt time.Time) []MealTimeSlot {
+	slots := make([]MealTimeSlot, 0)
+	for _, slot := range MealTimeSlots {
+		if slot.OverlapsWith(startAt, endAt) {
+			slots = append(slots, slot)
+		}
+	}
+	return slots
+}
+
+// ContainsMeal 食事をとる場所が含まれているかを判定する
+func ContainsMeal(places []Place) bool {
+	for _, place := range places {
+		if place.MainCategory() != nil && place.MainCategory().IsCategoryOf(CategoryRestaurant) {
+			return true
+		}
+	}
+	return false
+}
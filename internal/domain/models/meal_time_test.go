@@ -0,0 +1,168 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+func TestMealTimeSlot_OverlapsWith(t *testing.T) {
+	cases := []struct {
+		name     string
+		slot     MealTimeSlot
+		startAt  time.Time
+		endAt    time.Time
+		expected bool
+	}{
+		{
+			name:     "should return true when plan contains whole slot",
+			slot:     MealTimeSlotLunch,
+			startAt:  time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 15, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "should return true when plan overlaps with the beginning of slot",
+			slot:     MealTimeSlotLunch,
+			startAt:  time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 11, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "should return false when plan ends at the beginning of slot",
+			slot:     MealTimeSlotLunch,
+			startAt:  time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 11, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "should return true when slot is on the next day",
+			slot:     MealTimeSlotLunch,
+			startAt:  time.Date(2024, 7, 1, 20, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 2, 12, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := c.slot.OverlapsWith(c.startAt, c.endAt)
+			if result != c.expected {
+				t.Errorf("expected: %v\nactual: %v", c.expected, result)
+			}
+		})
+	}
+}
+
+func TestMealTimeSlotsDuring(t *testing.T) {
+	cases := []struct {
+		name     string
+		startAt  time.Time
+		endAt    time.Time
+		expected []MealTimeSlot
+	}{
+		{
+			name:     "morning plan",
+			startAt:  time.Date(2024, 7, 1, 8, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			expected: []MealTimeSlot{},
+		},
+		{
+			name:     "plan during lunch time",
+			startAt:  time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC),
+			expected: []MealTimeSlot{MealTimeSlotLunch},
+		},
+		{
+			name:     "plan for whole day",
+			startAt:  time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 20, 0, 0, 0, time.UTC),
+			expected: []MealTimeSlot{MealTimeSlotLunch, MealTimeSlotDinner},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := MealTimeSlotsDuring(c.startAt, c.endAt)
+			if diff := cmp.Diff(c.expected, result); diff != "" {
+				t.Errorf("MealTimeSlotsDuring() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsArrivingAtMealTime(t *testing.T) {
+	restaurant := Place{
+		Id:     "restaurant",
+		Google: GooglePlace{Types: []string{CategoryRestaurant.SubCategories[0]}},
+	}
+	cafe := Place{
+		Id:     "cafe",
+		Google: GooglePlace{Types: []string{CategoryCafe.SubCategories[0]}},
+	}
+	park := Place{
+		Id:     "park",
+		Google: GooglePlace{Types: []string{CategoryPark.SubCategories[0]}},
+	}
+
+	scheduleAt := func(place Place, hour int) PlaceSchedule {
+		arrivalAt := time.Date(2024, 7, 1, hour, 0, 0, 0, timeZoneJapan)
+		return PlaceSchedule{PlaceId: place.Id, ArrivalAt: arrivalAt, DepartureAt: arrivalAt.Add(time.Hour)}
+	}
+
+	cases := []struct {
+		name      string
+		places    []Place
+		schedules []PlaceSchedule
+		expected  bool
+	}{
+		{
+			name:      "restaurant at lunch time and cafe in the afternoon",
+			places:    []Place{restaurant, cafe},
+			schedules: []PlaceSchedule{scheduleAt(restaurant, 12), scheduleAt(cafe, 15)},
+			expected:  true,
+		},
+		{
+			name:      "restaurant at dinner time",
+			places:    []Place{restaurant},
+			schedules: []PlaceSchedule{scheduleAt(restaurant, 18)},
+			expected:  true,
+		},
+		{
+			name:      "restaurant in the morning",
+			places:    []Place{park, restaurant},
+			schedules: []PlaceSchedule{scheduleAt(park, 8), scheduleAt(restaurant, 9)},
+			expected:  false,
+		},
+		{
+			name:      "cafe at lunch time",
+			places:    []Place{cafe},
+			schedules: []PlaceSchedule{scheduleAt(cafe, 12)},
+			expected:  false,
+		},
+		{
+			name:   "arrival time is evaluated in the time zone of the place",
+			places: []Place{restaurant},
+			schedules: []PlaceSchedule{
+				// 日本時間では 12:00
+				{PlaceId: restaurant.Id, ArrivalAt: time.Date(2024, 7, 1, 3, 0, 0, 0, time.UTC)},
+			},
+			expected: true,
+		},
+		{
+			name:      "places without time slot can be visited at any time",
+			places:    []Place{park},
+			schedules: []PlaceSchedule{scheduleAt(park, 8)},
+			expected:  true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := IsArrivingAtMealTime(c.places, c.schedules)
+			if result != c.expected {
+				t.Errorf("expected: %v\nactual: %v", c.expected, result)
+			}
+		})
+	}
+}
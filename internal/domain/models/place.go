@@ -8,6 +8,9 @@ import (
 	"sort"
 )
 
+// GooglePlaceTypeLodging 宿泊施設を表す Google Places API の Type
+const GooglePlaceTypeLodging = "lodging"
+
 // Place 場所の情報
 type Place struct {
 	Id          string       `json:"id"`
@@ -17,6 +20,10 @@ type Place struct {
 	Address     *string      `json:"address"`
 	LikeCount   int          `json:"like_count"`
 	PlacePhotos []PlacePhoto `json:"place_photos"`
+	// SavedPlanCount は保存されたプランにその場所が含まれている回数
+	SavedPlanCount int `json:"saved_plan_count"`
+	// RankingScore は定期的に計算される場所のスコア（計算されていない場合は nil）
+	RankingScore *float64 `json:"ranking_score,omitempty"`
 }
 
 func (p Place) Categories() []LocationCategory {
@@ -30,6 +37,25 @@ func (p Place) MainCategory() *LocationCategory {
 	return &p.Categories()[0]
 }
 
+// Environment 場所が屋内・屋外のどちらにあるかを、メインカテゴリから判定する
+// カテゴリが分からない場合は PlaceEnvironmentMixed とする
+func (p Place) Environment() PlaceEnvironment {
+	if p.MainCategory() == nil || p.MainCategory().Environment == "" {
+		return PlaceEnvironmentMixed
+	}
+	return p.MainCategory().Environment
+}
+
+// IsLodging 宿泊施設かどうかを判定する
+func (p Place) IsLodging() bool {
+	for _, placeType := range p.Google.Types {
+		if placeType == GooglePlaceTypeLodging {
+			return true
+		}
+	}
+	return false
+}
+
 // ShortenAddress 番地等の細かい情報のない住所を取得する
 func (p Place) ShortenAddress() *string {
 	if p.Address == nil {
@@ -69,11 +95,11 @@ func (p Place) EstimatedPriceRange() (priceRange *PriceRange) {
 	return PriceRangeFromGooglePriceLevel(p.Google.PriceLevel)
 }
 
-func (p Place) CreateTransition(destination Place) Transition {
+func (p Place) CreateTransition(destination Place, mode TravelMode) Transition {
 	return Transition{
 		FromPlaceId: &p.Id,
 		ToPlaceId:   destination.Id,
-		Duration:    p.Location.TravelTimeTo(destination.Location, 80.0),
+		Duration:    p.Location.TravelTimeTo(destination.Location, mode.MeterPerMinute()),
 	}
 }
 
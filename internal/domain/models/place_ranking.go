@@ -0,0 +1,97 @@
+package models
+
+import "sort"
+
+// placeRankingCountSaturation は、いいね数や保存されたプランに含まれる回数を 0〜1 の値に変換するときに
+// 値が 0.5 となる回数
+const placeRankingCountSaturation = 5
+
+// PlaceRankingWeights は場所の順位付けに用いる各指標の重み
+type PlaceRankingWeights struct {
+	GoogleRating float64 `json:"googleRating"`
+	Like         float64 `json:"like"`
+	SavedPlan    float64 `json:"savedPlan"`
+}
+
+func DefaultPlaceRankingWeights() PlaceRankingWeights {
+	return PlaceRankingWeights{
+		GoogleRating: 1.0,
+		Like:         0.3,
+		SavedPlan:    0.5,
+	}
+}
+
+// PlaceRankingSignals は場所の順位付けに用いる指標
+// LikeCount はプラン候補とユーザーによるいいねの合計
+// SavedPlanCount は保存されたプランにその場所が含まれている回数
+type PlaceRankingSignals struct {
+	PlaceId                string
+	GoogleRating           float32
+	GoogleUserRatingsTotal int
+	LikeCount              int
+	SavedPlanCount         int
+}
+
+// PlaceRankingScore は指標から計算された場所のスコア
+type PlaceRankingScore struct {
+	PlaceId        string
+	Score          float64
+	LikeCount      int
+	SavedPlanCount int
+}
+
+// Score は各指標を 0〜1 の値に変換し、重みをかけて足し合わせる
+// Google の評価は WilsonScoreLowerBound により、レビュー数が少ない場合は低く見積もる
+func (s PlaceRankingSignals) Score(weights PlaceRankingWeights) float64 {
+	googleRatingScore := WilsonScoreLowerBound(float64(s.GoogleRating), s.GoogleUserRatingsTotal, 0.95, 5)
+	likeScore := saturateCount(s.LikeCount)
+	savedPlanScore := saturateCount(s.SavedPlanCount)
+
+	return weights.GoogleRating*googleRatingScore +
+		weights.Like*likeScore +
+		weights.SavedPlan*savedPlanScore
+}
+
+func (s PlaceRankingSignals) RankingScore(weights PlaceRankingWeights) PlaceRankingScore {
+	return PlaceRankingScore{
+		PlaceId:        s.PlaceId,
+		Score:          s.Score(weights),
+		LikeCount:      s.LikeCount,
+		SavedPlanCount: s.SavedPlanCount,
+	}
+}
+
+func saturateCount(count int) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return float64(count) / float64(count+placeRankingCountSaturation)
+}
+
+// RankingScoreOrDefault は計算済みのスコアがあればそれを返す
+// 計算されていない場合は、Google の評価といいね数、保存されたプランに含まれている回数からデフォルトの重みでスコアを計算する
+func (p Place) RankingScoreOrDefault() float64 {
+	if p.RankingScore != nil {
+		return *p.RankingScore
+	}
+
+	return PlaceRankingSignals{
+		PlaceId:                p.Id,
+		GoogleRating:           p.Google.Rating,
+		GoogleUserRatingsTotal: p.Google.UserRatingsTotal,
+		LikeCount:              p.LikeCount,
+		SavedPlanCount:         p.SavedPlanCount,
+	}.Score(DefaultPlaceRankingWeights())
+}
+
+// SortPlacesByRanking 場所をスコアの高い順に並び替える
+func SortPlacesByRanking(places []Place) []Place {
+	placesCopy := make([]Place, len(places))
+	copy(placesCopy, places)
+
+	sort.SliceStable(placesCopy, func(i, j int) bool {
+		return placesCopy[i].RankingScoreOrDefault() > placesCopy[j].RankingScoreOrDefault()
+	})
+
+	return placesCopy
+}
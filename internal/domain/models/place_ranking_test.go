@@ -0,0 +1,105 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"testing"
+)
+
+func TestPlaceRankingSignals_Score(t *testing.T) {
+	cases := []struct {
+		name     string
+		signals  PlaceRankingSignals
+		weights  PlaceRankingWeights
+		expected float64
+	}{
+		{
+			name:     "no signals",
+			signals:  PlaceRankingSignals{},
+			weights:  DefaultPlaceRankingWeights(),
+			expected: 0,
+		},
+		{
+			name: "likes and saved plans are saturated",
+			signals: PlaceRankingSignals{
+				LikeCount:      5,
+				SavedPlanCount: 15,
+			},
+			weights: PlaceRankingWeights{
+				GoogleRating: 1.0,
+				Like:         1.0,
+				SavedPlan:    2.0,
+			},
+			expected: 0.5 + 2.0*0.75,
+		},
+		{
+			name: "zero weight ignores signal",
+			signals: PlaceRankingSignals{
+				GoogleRating:           4.5,
+				GoogleUserRatingsTotal: 100,
+				LikeCount:              5,
+			},
+			weights: PlaceRankingWeights{
+				Like: 1.0,
+			},
+			expected: 0.5,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.signals.Score(c.weights)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Score() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortPlacesByRanking(t *testing.T) {
+	cases := []struct {
+		name     string
+		places   []Place
+		expected []string
+	}{
+		{
+			name: "sort by ranking score",
+			places: []Place{
+				{Id: "place-1", RankingScore: utils.ToPointer(0.2)},
+				{Id: "place-2", RankingScore: utils.ToPointer(0.8)},
+				{Id: "place-3", RankingScore: utils.ToPointer(0.5)},
+			},
+			expected: []string{"place-2", "place-3", "place-1"},
+		},
+		{
+			name: "fallback to google rating and likes when ranking score is not computed",
+			places: []Place{
+				{Id: "place-1", Google: GooglePlace{Rating: 3.0, UserRatingsTotal: 10}},
+				{Id: "place-2", Google: GooglePlace{Rating: 4.5, UserRatingsTotal: 1000}},
+				{Id: "place-3", RankingScore: utils.ToPointer(0.0)},
+			},
+			expected: []string{"place-2", "place-1", "place-3"},
+		},
+		{
+			name: "fallback score includes saved plan count",
+			places: []Place{
+				{Id: "place-1", Google: GooglePlace{Rating: 4.0, UserRatingsTotal: 100}},
+				{Id: "place-2", Google: GooglePlace{Rating: 4.0, UserRatingsTotal: 100}, SavedPlanCount: 3},
+			},
+			expected: []string{"place-2", "place-1"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := SortPlacesByRanking(c.places)
+			actualIds := make([]string, len(actual))
+			for i, place := range actual {
+				actualIds[i] = place.Id
+			}
+			if diff := cmp.Diff(c.expected, actualIds); diff != "" {
+				t.Errorf("SortPlacesByRanking() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,16 +1,34 @@
 package models
 
-import (
-	"sort"
-)
+import "context"
 
+// Plan
+// Version は保存されたプランが編集されるたびに1ずつ増え、同時に編集されたことを検知するために用いる
+// Visibility は保存されたプランの公開範囲（保存されていないプランでは空文字）
 type Plan struct {
-	Id           string       `json:"id"`
-	Name         string       `json:"name"`
-	Places       []Place      `json:"places"`
-	Author       *User        `json:"author"`
-	ParentPlanId *string      `json:"parent_plan_id"`
-	Collage      *PlanCollage `json:"collage"`
+	Id           string         `json:"id"`
+	Name         string         `json:"name"`
+	Description  *string        `json:"description"`
+	Places       []Place        `json:"places"`
+	Author       *User          `json:"author"`
+	ParentPlanId *string        `json:"parent_plan_id"`
+	Collage      *PlanCollage   `json:"collage"`
+	Version      int            `json:"version"`
+	Visibility   PlanVisibility `json:"visibility"`
+}
+
+// IsAuthoredBy 指定したユーザーがプランの作者かどうかを判定する
+func (p Plan) IsAuthoredBy(userId string) bool {
+	return p.Author != nil && p.Author.Id == userId
+}
+
+// IsVisibleTo 指定したユーザーがプランを閲覧できるかどうかを判定する
+// 非公開のプランは作者のみが閲覧でき、userId が nil の場合は未ログインのユーザーを表す
+func (p Plan) IsVisibleTo(userId *string) bool {
+	if p.Visibility != PlanVisibilityPrivate {
+		return true
+	}
+	return userId != nil && p.IsAuthoredBy(*userId)
 }
 
 // GetPlace 指定したIDの場所情報を取得する
@@ -23,56 +41,31 @@ func (p Plan) GetPlace(placeId string) *Place {
 	return nil
 }
 
-// PlacesReorderedToMinimizeDistance は、スタート地点から移動が少なくなるように場所を並び替える
+// PlacesReorderedToMinimizeDistance は、最初の場所を固定したまま移動距離が最小になるように場所を並び替える
 func (p Plan) PlacesReorderedToMinimizeDistance() []Place {
 	if len(p.Places) == 0 {
 		panic("Plan has no places")
 	}
 
-	placesReordered := make([]Place, len(p.Places))
-
-	// 最初の場所をスタート地点とする
-	startPlace := p.Places[0]
-	placesReordered[0] = startPlace
-
-	for i := 1; i < len(p.Places); i++ {
-		// 一つ前の場所から近い順に並び替える
-		placesSortedByDistanceToPrevLocation := make([]Place, len(p.Places))
-		copy(placesSortedByDistanceToPrevLocation, p.Places)
-		sort.Slice(placesSortedByDistanceToPrevLocation, func(a, b int) bool {
-			prevLocation := placesReordered[i-1].Location
-			return placesSortedByDistanceToPrevLocation[a].Location.DistanceInMeter(prevLocation) < placesSortedByDistanceToPrevLocation[b].Location.DistanceInMeter(prevLocation)
-		})
-
-		//　一つ前の場所から最も近い場所を選択する
-		for _, place := range placesSortedByDistanceToPrevLocation {
-			// すでに並び替えた場所は除外する
-			var isAlreadyAdded bool
-			for _, placeReordered := range placesReordered {
-				if placeReordered.Id == place.Id {
-					isAlreadyAdded = true
-					break
-				}
-			}
-
-			if isAlreadyAdded {
-				continue
-			}
-
-			placesReordered[i] = place
-			break
-		}
-	}
+	placesReordered, _ := OptimizeRoute(OptimizeRouteInput{
+		Places:        p.Places,
+		FixFirstPlace: true,
+	})
 
 	return placesReordered
 }
 
-func (p Plan) Transitions(startLocation *GeoLocation) []Transition {
-	return CreateTransition(p.Places, startLocation)
+func (p Plan) Transitions(startLocation *GeoLocation, mode TravelMode) []Transition {
+	return CreateTransition(p.Places, startLocation, mode)
 }
 
-func (p Plan) TimeInMinutes(startLocation *GeoLocation) uint {
-	transitions := p.Transitions(startLocation)
+// TransitionsWithRouting は routingProvider を用いてプラン内の移動情報を求める
+func (p Plan) TransitionsWithRouting(ctx context.Context, routingProvider RoutingProvider, startLocation *GeoLocation, mode TravelMode) ([]Transition, error) {
+	return CreateTransitionWithRouting(ctx, routingProvider, p.Places, startLocation, mode)
+}
+
+// TimeInMinutesWithTransitions は移動情報と各場所の滞在時間からプランの所要時間を求める
+func (p Plan) TimeInMinutesWithTransitions(transitions []Transition) uint {
 	var timeInMinute uint
 	for _, t := range transitions {
 		timeInMinute += t.Duration
@@ -83,3 +76,8 @@ func (p Plan) TimeInMinutes(startLocation *GeoLocation) uint {
 	}
 	return timeInMinute
 }
+
+// EstimatedBudget プランの場所を巡るときにかかる一人あたりの金額を推定する
+func (p Plan) EstimatedBudget() Budget {
+	return EstimatedBudgetOfPlaces(p.Places)
+}
@@ -0,0 +1,50 @@
+package models
+
+import "time"
+
+type PlanCandidateEditType string
+
+const (
+	PlanCandidateEditTypeAddPlace      PlanCandidateEditType = "ADD_PLACE"
+	PlanCandidateEditTypeRemovePlace   PlanCandidateEditType = "REMOVE_PLACE"
+	PlanCandidateEditTypeReplacePlace  PlanCandidateEditType = "REPLACE_PLACE"
+	PlanCandidateEditTypeReorderPlaces PlanCandidateEditType = "REORDER_PLACES"
+)
+
+// PlanCandidateEditHistory はプラン候補に含まれるプランへの編集を表す
+// PlaceIdsBefore, PlaceIdsAfter は編集前後のプランに含まれる場所の ID（順番通り）
+// IsUndone は編集が取り消されているかどうか
+type PlanCandidateEditHistory struct {
+	Id                 string
+	PlanCandidateSetId string
+	PlanId             string
+	Type               PlanCandidateEditType
+	PlaceIdsBefore     []string
+	PlaceIdsAfter      []string
+	IsUndone           bool
+	CreatedAt          time.Time
+}
+
+// PlanCandidateEditHistories は編集された順に並んだ編集履歴
+// 取り消された編集は常に末尾に並ぶ（新しく編集すると、取り消された編集は破棄される）
+type PlanCandidateEditHistories []PlanCandidateEditHistory
+
+// NextToUndo は次に取り消す編集（取り消されていない最新の編集）を返す
+func (h PlanCandidateEditHistories) NextToUndo() *PlanCandidateEditHistory {
+	for i := len(h) - 1; i >= 0; i-- {
+		if !h[i].IsUndone {
+			return &h[i]
+		}
+	}
+	return nil
+}
+
+// NextToRedo は次にやり直す編集（取り消された最古の編集）を返す
+func (h PlanCandidateEditHistories) NextToRedo() *PlanCandidateEditHistory {
+	for i := range h {
+		if h[i].IsUndone {
+			return &h[i]
+		}
+	}
+	return nil
+}
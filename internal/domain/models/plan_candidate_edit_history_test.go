@@ -0,0 +1,87 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPlanCandidateEditHistories_NextToUndo(t *testing.T) {
+	cases := []struct {
+		name      string
+		histories PlanCandidateEditHistories
+		expected  *PlanCandidateEditHistory
+	}{
+		{
+			name:      "no history",
+			histories: PlanCandidateEditHistories{},
+			expected:  nil,
+		},
+		{
+			name: "latest edit which is not undone",
+			histories: PlanCandidateEditHistories{
+				{Id: "edit-1"},
+				{Id: "edit-2"},
+				{Id: "edit-3", IsUndone: true},
+			},
+			expected: &PlanCandidateEditHistory{Id: "edit-2"},
+		},
+		{
+			name: "all edits are undone",
+			histories: PlanCandidateEditHistories{
+				{Id: "edit-1", IsUndone: true},
+				{Id: "edit-2", IsUndone: true},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.histories.NextToUndo()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("NextToUndo() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanCandidateEditHistories_NextToRedo(t *testing.T) {
+	cases := []struct {
+		name      string
+		histories PlanCandidateEditHistories
+		expected  *PlanCandidateEditHistory
+	}{
+		{
+			name:      "no history",
+			histories: PlanCandidateEditHistories{},
+			expected:  nil,
+		},
+		{
+			name: "oldest edit which is undone",
+			histories: PlanCandidateEditHistories{
+				{Id: "edit-1"},
+				{Id: "edit-2", IsUndone: true},
+				{Id: "edit-3", IsUndone: true},
+			},
+			expected: &PlanCandidateEditHistory{Id: "edit-2", IsUndone: true},
+		},
+		{
+			name: "no edit is undone",
+			histories: PlanCandidateEditHistories{
+				{Id: "edit-1"},
+				{Id: "edit-2"},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.histories.NextToRedo()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("NextToRedo() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
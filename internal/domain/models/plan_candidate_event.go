@@ -0,0 +1,42 @@
+package models
+
+import (
+	"context"
+	"time"
+)
+
+type PlanCandidateEventType string
+
+const (
+	PlanCandidateEventTypePlaceAdded      PlanCandidateEventType = "PLACE_ADDED"
+	PlanCandidateEventTypePlaceDeleted    PlanCandidateEventType = "PLACE_DELETED"
+	PlanCandidateEventTypePlaceReplaced   PlanCandidateEventType = "PLACE_REPLACED"
+	PlanCandidateEventTypePlacesReordered PlanCandidateEventType = "PLACES_REORDERED"
+	PlanCandidateEventTypePlaceLiked      PlanCandidateEventType = "PLACE_LIKED"
+	PlanCandidateEventTypeGroupUpdated    PlanCandidateEventType = "GROUP_UPDATED"
+	PlanCandidateEventTypeEditUndone      PlanCandidateEventType = "EDIT_UNDONE"
+	PlanCandidateEventTypeEditRedone      PlanCandidateEventType = "EDIT_REDONE"
+)
+
+// PlanCandidateEvent はプラン候補が編集されたことを表す
+// Id はイベントごとに一意で、同じイベントを受け取った購読者の間で通知の内容を共有するために用いる
+// PlanId, PlaceId は編集の対象となったプランと場所で、対象がない場合は nil
+type PlanCandidateEvent struct {
+	Id                 string
+	PlanCandidateSetId string
+	Type               PlanCandidateEventType
+	PlanId             *string
+	PlaceId            *string
+	OccurredAt         time.Time
+}
+
+// PlanCandidateEventBroker はプラン候補の編集を、同じプラン候補を見ている他のクライアントに配信する
+type PlanCandidateEventBroker interface {
+	// Publish はイベントを配信する
+	// 受信が追いついていない購読者には、イベントが届かないことがある
+	Publish(ctx context.Context, event PlanCandidateEvent) error
+
+	// Subscribe は planCandidateSetId に関するイベントを受け取る
+	// ctx が終了すると購読を解除し、チャネルを閉じる
+	Subscribe(ctx context.Context, planCandidateSetId string) (<-chan PlanCandidateEvent, error)
+}
@@ -0,0 +1,99 @@
+package models
+
+// PlanCandidateGroup は複数人でプラン候補を選ぶときの情報
+// ShareToken を知っていれば、ログインしていなくても参加できる
+type PlanCandidateGroup struct {
+	PlanCandidateSetId string
+	ShareToken         string
+	Participants       []PlanCandidateGroupParticipant
+	PlanVotes          []PlanCandidateGroupPlanVote
+	PlaceVotes         []PlanCandidateGroupPlaceVote
+}
+
+// PlanCandidateGroupParticipant はグループの参加者
+// UserId はログインしている場合のみ設定される
+// Token は参加者本人だけが知っている値で、投票するときに参加者を識別するために用いる
+type PlanCandidateGroupParticipant struct {
+	Id          string
+	UserId      *string
+	DisplayName string
+	Token       string
+}
+
+type PlanCandidateGroupPlanVote struct {
+	ParticipantId string
+	PlanId        string
+}
+
+type PlanCandidateGroupPlaceVote struct {
+	ParticipantId string
+	PlaceId       string
+}
+
+// FindParticipantByToken は Token に対応する参加者を返す
+func (g PlanCandidateGroup) FindParticipantByToken(token string) *PlanCandidateGroupParticipant {
+	for _, participant := range g.Participants {
+		if participant.Token == token {
+			return &participant
+		}
+	}
+	return nil
+}
+
+// FindParticipantByUserId はユーザーとして参加している参加者を返す
+func (g PlanCandidateGroup) FindParticipantByUserId(userId string) *PlanCandidateGroupParticipant {
+	for _, participant := range g.Participants {
+		if participant.UserId != nil && *participant.UserId == userId {
+			return &participant
+		}
+	}
+	return nil
+}
+
+// PlanVoteCount はプランへの投票数を返す
+func (g PlanCandidateGroup) PlanVoteCount(planId string) int {
+	count := 0
+	for _, vote := range g.PlanVotes {
+		if vote.PlanId == planId {
+			count++
+		}
+	}
+	return count
+}
+
+// PlaceVoteCount は場所への投票数を返す
+func (g PlanCandidateGroup) PlaceVoteCount(placeId string) int {
+	count := 0
+	for _, vote := range g.PlaceVotes {
+		if vote.PlaceId == placeId {
+			count++
+		}
+	}
+	return count
+}
+
+// WinningPlan は最も投票数の多いプランを返す
+// プランへの投票数が同じ場合は、プランに含まれる場所への投票数の合計が多いものを選ぶ
+// どのプランにも投票されていない場合は nil を返す
+func (g PlanCandidateGroup) WinningPlan(plans []Plan) *Plan {
+	var winningPlan *Plan
+	var winningPlanVotes, winningPlaceVotes int
+	for i, plan := range plans {
+		planVotes := g.PlanVoteCount(plan.Id)
+		placeVotes := 0
+		for _, place := range plan.Places {
+			placeVotes += g.PlaceVoteCount(place.Id)
+		}
+
+		if planVotes == 0 && placeVotes == 0 {
+			continue
+		}
+
+		if winningPlan == nil || planVotes > winningPlanVotes || (planVotes == winningPlanVotes && placeVotes > winningPlaceVotes) {
+			winningPlan = &plans[i]
+			winningPlanVotes = planVotes
+			winningPlaceVotes = placeVotes
+		}
+	}
+	return winningPlan
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"testing"
+)
+
+func TestPlanCandidateGroup_WinningPlan(t *testing.T) {
+	plans := []Plan{
+		{Id: "plan-1", Places: []Place{{Id: "place-1"}, {Id: "place-2"}}},
+		{Id: "plan-2", Places: []Place{{Id: "place-3"}}},
+	}
+
+	cases := []struct {
+		name     string
+		group    PlanCandidateGroup
+		expected *string
+	}{
+		{
+			name:     "no votes",
+			group:    PlanCandidateGroup{},
+			expected: nil,
+		},
+		{
+			name: "plan with most votes wins",
+			group: PlanCandidateGroup{
+				PlanVotes: []PlanCandidateGroupPlanVote{
+					{ParticipantId: "participant-1", PlanId: "plan-1"},
+					{ParticipantId: "participant-1", PlanId: "plan-2"},
+					{ParticipantId: "participant-2", PlanId: "plan-2"},
+				},
+				PlaceVotes: []PlanCandidateGroupPlaceVote{
+					{ParticipantId: "participant-1", PlaceId: "place-1"},
+					{ParticipantId: "participant-2", PlaceId: "place-2"},
+				},
+			},
+			expected: utils.StrPointer("plan-2"),
+		},
+		{
+			name: "votes to places break ties",
+			group: PlanCandidateGroup{
+				PlanVotes: []PlanCandidateGroupPlanVote{
+					{ParticipantId: "participant-1", PlanId: "plan-1"},
+					{ParticipantId: "participant-2", PlanId: "plan-2"},
+				},
+				PlaceVotes: []PlanCandidateGroupPlaceVote{
+					{ParticipantId: "participant-1", PlaceId: "place-3"},
+				},
+			},
+			expected: utils.StrPointer("plan-2"),
+		},
+		{
+			name: "votes only to places",
+			group: PlanCandidateGroup{
+				PlaceVotes: []PlanCandidateGroupPlaceVote{
+					{ParticipantId: "participant-1", PlaceId: "place-1"},
+				},
+			},
+			expected: utils.StrPointer("plan-1"),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var actual *string
+			if winningPlan := c.group.WinningPlan(plans); winningPlan != nil {
+				actual = &winningPlan.Id
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("WinningPlan() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
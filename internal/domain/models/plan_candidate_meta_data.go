@@ -1,12 +1,23 @@
 package models
 
+import "time"
+
 // PlanCandidateMetaData は PlanCandidateSet を作成するにあたって、元になった情報
+// LocationEnd が指定されている場合は、プランの最後に到着地点へ移動する
+// PromptIntent は自然文の要望からプランを作成した場合に、抽出された条件を保持する
 type PlanCandidateMetaData struct {
 	CreatedBasedOnCurrentLocation bool
 	CategoriesPreferred           *[]LocationCategory
 	CategoriesRejected            *[]LocationCategory
 	LocationStart                 *GeoLocation
+	LocationEnd                   *GeoLocation
 	FreeTime                      *int
+	StartTime                     *time.Time
+	TravelMode                    TravelMode
+	BudgetMax                     *int
+	NumberOfPeople                *int
+	Weather                       *WeatherForecast
+	PromptIntent                  *PlanPromptIntent
 	CreateByCategoryMetaData      *CreateByCategoryMetaData
 }
 
@@ -20,7 +31,14 @@ func (p PlanCandidateMetaData) IsZero() bool {
 	return p.CategoriesPreferred == nil &&
 		p.CategoriesRejected == nil &&
 		p.LocationStart == nil &&
+		p.LocationEnd == nil &&
 		p.FreeTime == nil &&
+		p.StartTime == nil &&
+		p.TravelMode == "" &&
+		p.BudgetMax == nil &&
+		p.NumberOfPeople == nil &&
+		p.Weather == nil &&
+		p.PromptIntent == nil &&
 		p.CreateByCategoryMetaData == nil
 }
 
@@ -30,3 +48,19 @@ func (p PlanCandidateMetaData) GetLocationStart() *GeoLocation {
 	}
 	return nil
 }
+
+// GetTravelMode 移動手段が指定されていない場合は徒歩とする
+func (p PlanCandidateMetaData) GetTravelMode() TravelMode {
+	if !p.TravelMode.IsValid() {
+		return TravelModeWalking
+	}
+	return p.TravelMode
+}
+
+// GetNumberOfPeople 人数が指定されていない場合は1人とする
+func (p PlanCandidateMetaData) GetNumberOfPeople() int {
+	if p.NumberOfPeople == nil || *p.NumberOfPeople <= 0 {
+		return 1
+	}
+	return *p.NumberOfPeople
+}
@@ -0,0 +1,40 @@
+package models
+
+// PlanGenerationTrace はプランを作成する過程で、どの場所が検討され、どの場所がどの理由で除外されたかを記録したもの
+type PlanGenerationTrace struct {
+	BasePlaces []PlanGenerationTraceBasePlace
+}
+
+// PlanGenerationTraceBasePlace はプランの起点として検討された場所
+// SearchRadius は起点となる場所を選択したときの検索範囲（場所が指定された場合は nil）
+// Selected はこの場所を起点としたプランが最終的に採用されたかどうか
+// Error はプランを作成できなかった場合の理由
+type PlanGenerationTraceBasePlace struct {
+	PlaceId         string
+	PlaceName       string
+	SearchRadius    *int
+	PlanCreated     bool
+	Selected        bool
+	NumPlacesInPlan int
+	Error           *string
+	RejectedPlaces  []PlanGenerationTraceRejectedPlace
+}
+
+type PlanGenerationTraceRejectedPlace struct {
+	PlaceId   string
+	PlaceName string
+	Reason    PlanGenerationRejectReason
+}
+
+// PlanGenerationRejectReason はプランを作成するときに、場所がプランに含められなかった理由
+type PlanGenerationRejectReason string
+
+const (
+	PlanGenerationRejectReasonAlreadyInPlan    PlanGenerationRejectReason = "ALREADY_IN_PLAN"
+	PlanGenerationRejectReasonCategoryLimit    PlanGenerationRejectReason = "CATEGORY_LIMIT"
+	PlanGenerationRejectReasonOverBudget       PlanGenerationRejectReason = "OVER_BUDGET"
+	PlanGenerationRejectReasonClosedAtArrival  PlanGenerationRejectReason = "CLOSED_AT_ARRIVAL"
+	PlanGenerationRejectReasonOverTime         PlanGenerationRejectReason = "OVER_TIME"
+	PlanGenerationRejectReasonInOtherPlan      PlanGenerationRejectReason = "IN_OTHER_PLAN"
+	PlanGenerationRejectReasonDislikedCategory PlanGenerationRejectReason = "DISLIKED_CATEGORY"
+)
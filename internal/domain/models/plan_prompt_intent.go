@@ -0,0 +1,15 @@
+package models
+
+import "time"
+
+// PlanPromptIntent は自然文で入力されたプランの要望から抽出した条件
+// Prompt には入力された自然文をそのまま保持する
+// カテゴリは LocationCategory の Name で表す
+type PlanPromptIntent struct {
+	Prompt              string     `json:"prompt"`
+	CategoriesPreferred []string   `json:"categories_preferred"`
+	CategoriesDisliked  []string   `json:"categories_disliked"`
+	FreeTime            *int       `json:"free_time,omitempty"`
+	BudgetMax           *int       `json:"budget_max,omitempty"`
+	StartTime           *time.Time `json:"start_time,omitempty"`
+}
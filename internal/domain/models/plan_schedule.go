@@ -0,0 +1,73 @@
+package models
+
+import "time"
+
+// PlaceSchedule プラン内の場所への到着時刻と出発時刻
+type PlaceSchedule struct {
+	PlaceId     string    `json:"place_id"`
+	ArrivalAt   time.Time `json:"arrival_at"`
+	DepartureAt time.Time `json:"departure_at"`
+}
+
+// CreatePlaceSchedules は startAt に出発したときの各場所への到着時刻と出発時刻を求める
+// transitions に現在地からの移動が含まれない場合は，最初の場所に startAt に到着したものとする
+func CreatePlaceSchedules(places []Place, transitions []Transition, startAt time.Time) []PlaceSchedule {
+	schedules := make([]PlaceSchedule, 0, len(places))
+	if len(places) == 0 {
+		return schedules
+	}
+
+	// 最初の場所の前に現在地からの移動がある場合は、移動情報が一つずれる
+	transitionOffset := -1
+	if len(transitions) > 0 && transitions[0].FromPlaceId == nil {
+		transitionOffset = 0
+	}
+
+	current := startAt
+	for i, place := range places {
+		transitionIndex := i + transitionOffset
+		if transitionIndex >= 0 && transitionIndex < len(transitions) {
+			current = current.Add(time.Duration(transitions[transitionIndex].Duration) * time.Minute)
+		}
+
+		arrivalAt := current
+		current = current.Add(time.Duration(place.EstimatedStayDuration()) * time.Minute)
+		schedules = append(schedules, PlaceSchedule{
+			PlaceId:     place.Id,
+			ArrivalAt:   arrivalAt,
+			DepartureAt: current,
+		})
+	}
+
+	return schedules
+}
+
+// IsOpeningAtArrival は各場所が到着時刻に営業しているかどうかを判定する
+// 営業時間が取得されていない・解析できない場所は営業しているとみなす
+func IsOpeningAtArrival(places []Place, schedules []PlaceSchedule) bool {
+	for _, schedule := range schedules {
+		place, ok := findPlaceById(places, schedule.PlaceId)
+		if !ok || place.Google.PlaceDetail == nil {
+			continue
+		}
+
+		isOpening, err := place.Google.IsOpening(schedule.ArrivalAt)
+		if err != nil {
+			continue
+		}
+
+		if !isOpening {
+			return false
+		}
+	}
+	return true
+}
+
+func findPlaceById(places []Place, placeId string) (*Place, bool) {
+	for _, place := range places {
+		if place.Id == placeId {
+			return &place, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,144 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+func TestCreatePlaceSchedules(t *testing.T) {
+	startAt := time.Date(2024, 7, 1, 10, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name          string
+		startLocation *GeoLocation
+		places        []Place
+		expected      []PlaceSchedule
+	}{
+		{
+			name:     "no places",
+			places:   []Place{},
+			expected: []PlaceSchedule{},
+		},
+		{
+			name: "plan created from place",
+			places: []Place{
+				{
+					Id:       "01",
+					Location: GeoLocation{Latitude: 35.165077, Longitude: 136.899703},
+					Google:   GooglePlace{Types: []string{CategoryAmusements.SubCategories[0]}},
+				},
+				{
+					Id:       "02",
+					Location: GeoLocation{Latitude: 35.163926, Longitude: 136.901071},
+					Google:   GooglePlace{Types: []string{CategoryCafe.SubCategories[0]}},
+				},
+			},
+			expected: []PlaceSchedule{
+				{
+					PlaceId:     "01",
+					ArrivalAt:   startAt,
+					DepartureAt: startAt.Add(time.Duration(CategoryAmusements.EstimatedStayDuration) * time.Minute),
+				},
+				{
+					PlaceId:     "02",
+					ArrivalAt:   startAt.Add(time.Duration(CategoryAmusements.EstimatedStayDuration+2) * time.Minute),
+					DepartureAt: startAt.Add(time.Duration(CategoryAmusements.EstimatedStayDuration+2+CategoryCafe.EstimatedStayDuration) * time.Minute),
+				},
+			},
+		},
+		{
+			name:          "plan created from current location",
+			startLocation: &GeoLocation{Latitude: 35.1706431, Longitude: 136.8816945},
+			places: []Place{
+				{
+					Id:       "01",
+					Location: GeoLocation{Latitude: 35.165077, Longitude: 136.899703},
+					Google:   GooglePlace{Types: []string{CategoryAmusements.SubCategories[0]}},
+				},
+			},
+			expected: []PlaceSchedule{
+				{
+					PlaceId:     "01",
+					ArrivalAt:   startAt.Add(21 * time.Minute),
+					DepartureAt: startAt.Add(time.Duration(21+CategoryAmusements.EstimatedStayDuration) * time.Minute),
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := CreatePlaceSchedules(c.places, CreateTransition(c.places, c.startLocation, TravelModeWalking), startAt)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("CreatePlaceSchedules() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsOpeningAtArrival(t *testing.T) {
+	placeOpenAtDaytime := Place{
+		Id: "01",
+		Google: GooglePlace{
+			PlaceDetail: &GooglePlaceDetail{
+				OpeningHours: &GooglePlaceOpeningHours{
+					Periods: []GooglePlaceOpeningPeriod{
+						{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "1000", ClosingTime: "1800"},
+					},
+				},
+			},
+		},
+	}
+	placeWithoutDetail := Place{Id: "02"}
+
+	cases := []struct {
+		name      string
+		places    []Place
+		schedules []PlaceSchedule
+		expected  bool
+	}{
+		{
+			name:   "place is opening at arrival",
+			places: []Place{placeOpenAtDaytime},
+			schedules: []PlaceSchedule{
+				{PlaceId: "01", ArrivalAt: time.Date(2024, 7, 1, 12, 0, 0, 0, timeZoneJapan)},
+			},
+			expected: true,
+		},
+		{
+			name:   "place is closed at arrival",
+			places: []Place{placeOpenAtDaytime},
+			schedules: []PlaceSchedule{
+				{PlaceId: "01", ArrivalAt: time.Date(2024, 7, 1, 19, 0, 0, 0, timeZoneJapan)},
+			},
+			expected: false,
+		},
+		{
+			name:   "arrival time is evaluated in the time zone of the place",
+			places: []Place{placeOpenAtDaytime},
+			schedules: []PlaceSchedule{
+				// 日本時間では 2024/7/1(月) 12:00
+				{PlaceId: "01", ArrivalAt: time.Date(2024, 7, 1, 3, 0, 0, 0, time.UTC)},
+			},
+			expected: true,
+		},
+		{
+			name:   "place without detail is regarded as opening",
+			places: []Place{placeWithoutDetail},
+			schedules: []PlaceSchedule{
+				{PlaceId: "02", ArrivalAt: time.Date(2024, 7, 1, 3, 0, 0, 0, timeZoneJapan)},
+			},
+			expected: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := IsOpeningAtArrival(c.places, c.schedules)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("IsOpeningAtArrival() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/utils"
 	"testing"
 )
 
@@ -50,6 +51,92 @@ func TestGetPlace(t *testing.T) {
 	}
 }
 
+func TestPlan_IsAuthoredBy(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     Plan
+		userId   string
+		expected bool
+	}{
+		{
+			name:     "author of plan",
+			plan:     Plan{Author: &User{Id: "user-1"}},
+			userId:   "user-1",
+			expected: true,
+		},
+		{
+			name:     "not author of plan",
+			plan:     Plan{Author: &User{Id: "user-1"}},
+			userId:   "user-2",
+			expected: false,
+		},
+		{
+			name:     "plan without author",
+			plan:     Plan{},
+			userId:   "user-1",
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.plan.IsAuthoredBy(c.userId)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlan_IsVisibleTo(t *testing.T) {
+	cases := []struct {
+		name     string
+		plan     Plan
+		userId   *string
+		expected bool
+	}{
+		{
+			name:     "public plan is visible to anyone",
+			plan:     Plan{Author: &User{Id: "user-1"}, Visibility: PlanVisibilityPublic},
+			userId:   nil,
+			expected: true,
+		},
+		{
+			name:     "unlisted plan is visible to anyone",
+			plan:     Plan{Author: &User{Id: "user-1"}, Visibility: PlanVisibilityUnlisted},
+			userId:   nil,
+			expected: true,
+		},
+		{
+			name:     "private plan is visible to author",
+			plan:     Plan{Author: &User{Id: "user-1"}, Visibility: PlanVisibilityPrivate},
+			userId:   utils.StrPointer("user-1"),
+			expected: true,
+		},
+		{
+			name:     "private plan is not visible to other user",
+			plan:     Plan{Author: &User{Id: "user-1"}, Visibility: PlanVisibilityPrivate},
+			userId:   utils.StrPointer("user-2"),
+			expected: false,
+		},
+		{
+			name:     "private plan is not visible to guest",
+			plan:     Plan{Author: &User{Id: "user-1"}, Visibility: PlanVisibilityPrivate},
+			userId:   nil,
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.plan.IsVisibleTo(c.userId)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestPlan_PlacesReorderedToMinimizeDistance(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -0,0 +1,24 @@
+package models
+
+import "fmt"
+
+// PlanVisibility は保存されたプランの公開範囲
+type PlanVisibility string
+
+const (
+	// PlanVisibilityPublic は一覧や検索結果にも表示される
+	PlanVisibilityPublic PlanVisibility = "PUBLIC"
+	// PlanVisibilityUnlisted は一覧には表示されず、URLを知っているユーザーのみ閲覧できる
+	PlanVisibilityUnlisted PlanVisibility = "UNLISTED"
+	// PlanVisibilityPrivate は作者のみ閲覧できる
+	PlanVisibilityPrivate PlanVisibility = "PRIVATE"
+)
+
+func NewPlanVisibility(value string) (PlanVisibility, error) {
+	switch PlanVisibility(value) {
+	case PlanVisibilityPublic, PlanVisibilityUnlisted, PlanVisibilityPrivate:
+		return PlanVisibility(value), nil
+	default:
+		return "", fmt.Errorf("invalid plan visibility: %s", value)
+	}
+}
@@ -0,0 +1,121 @@
+package models
+
+import (
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/tsp"
+)
+
+// OptimizeRouteInput 場所を巡る順番を最適化するときの条件
+// StartLocation が指定された場合は、その地点から出発するものとして順番を求める
+// EndLocation が指定された場合は、最後にその地点へ向かうものとして順番を求める（出発地点に戻る・駅で解散する など）
+// FixFirstPlace, FixLastPlace が true の場合は、最初・最後の場所を入れ替えない
+// StartTime が指定された場合は、すべての場所に営業時間内に到着できる順番のみを解とする
+// ConsiderMealTime が true の場合は、StartTime をもとに飲食店・カフェに適した時間帯に到着する順番のみを解とする
+// （FixFirstPlace が true の場合、最初の場所は対象外とする）
+// CreateTransitions は営業時間の判定に用いる移動情報の求め方で、指定しない場合は直線距離から推定する
+type OptimizeRouteInput struct {
+	Places            []Place
+	StartLocation     *GeoLocation
+	EndLocation       *GeoLocation
+	FixFirstPlace     bool
+	FixLastPlace      bool
+	StartTime         *time.Time
+	TravelMode        TravelMode
+	ConsiderMealTime  bool
+	CreateTransitions func(places []Place) []Transition
+}
+
+// OptimizeRoute は移動距離が最小になるように場所を並び替える
+// 条件を満たす順番が存在しない場合は false を返す
+func OptimizeRoute(input OptimizeRouteInput) ([]Place, bool) {
+	if len(input.Places) == 0 {
+		return []Place{}, true
+	}
+
+	// 場所に加えて、出発地点・到着地点をノードとして扱う
+	locations := make([]GeoLocation, 0, len(input.Places)+2)
+	for _, place := range input.Places {
+		locations = append(locations, place.Location)
+	}
+
+	var start, end *int
+	if input.FixFirstPlace {
+		start = intPtr(0)
+	} else if input.StartLocation != nil {
+		locations = append(locations, *input.StartLocation)
+		start = intPtr(len(locations) - 1)
+	}
+
+	// 最後の場所を固定する場合は、到着地点へ向かう移動は順番によらず一定になるため、到着地点をノードに含めない
+	if input.FixLastPlace && len(input.Places) > 1 {
+		end = intPtr(len(input.Places) - 1)
+	} else if input.EndLocation != nil {
+		locations = append(locations, *input.EndLocation)
+		end = intPtr(len(locations) - 1)
+	}
+
+	// 巡る順番の評価には直線距離を用いる
+	// すべての地点の組み合わせについて経路探索を行うと routingProvider の呼び出しが地点数の2乗に比例して増えるため、
+	// 実際の移動時間は営業時間・食事の時間帯の判定（CreateTransitions）にのみ用いる
+	cost := make([][]float64, len(locations))
+	for i := range locations {
+		cost[i] = make([]float64, len(locations))
+		for j := range locations {
+			cost[i][j] = locations[i].DistanceInMeter(locations[j])
+		}
+	}
+
+	placesOfRoute := func(route []int) []Place {
+		places := make([]Place, 0, len(input.Places))
+		for _, node := range route {
+			if node < len(input.Places) {
+				places = append(places, input.Places[node])
+			}
+		}
+		return places
+	}
+
+	var isFeasible func(route []int) bool
+	if input.StartTime != nil {
+		isFeasible = func(route []int) bool {
+			places := placesOfRoute(route)
+			schedules := CreatePlaceSchedules(places, input.createTransitions(places), *input.StartTime)
+			if !IsOpeningAtArrival(places, schedules) {
+				return false
+			}
+
+			if input.ConsiderMealTime {
+				if input.FixFirstPlace && len(schedules) > 0 {
+					schedules = schedules[1:]
+				}
+				return IsArrivingAtMealTime(places, schedules)
+			}
+
+			return true
+		}
+	}
+
+	route, ok := tsp.Solve(tsp.Input{
+		Cost:       cost,
+		Start:      start,
+		End:        end,
+		IsFeasible: isFeasible,
+	})
+	if !ok {
+		return nil, false
+	}
+
+	return placesOfRoute(route), true
+}
+
+func (input OptimizeRouteInput) createTransitions(places []Place) []Transition {
+	if input.CreateTransitions != nil {
+		return input.CreateTransitions(places)
+	}
+	return CreateTransition(places, input.StartLocation, input.TravelMode)
+}
+
+func intPtr(i int) *int {
+	return &i
+}
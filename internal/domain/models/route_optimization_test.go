@@ -0,0 +1,157 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+func TestOptimizeRoute(t *testing.T) {
+	newPlace := func(id string, latitude float64) Place {
+		return Place{Id: id, Location: GeoLocation{Latitude: latitude, Longitude: 0}}
+	}
+
+	// 2024/07/01 は月曜日
+	startTime := time.Date(2024, 7, 1, 9, 0, 0, 0, timeZoneJapan)
+	placeOpenFrom0920 := newPlace("a", 0.01)
+	placeOpenFrom0920.Google.PlaceDetail = &GooglePlaceDetail{
+		OpeningHours: &GooglePlaceOpeningHours{
+			Periods: []GooglePlaceOpeningPeriod{
+				{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "0920", ClosingTime: "1800"},
+			},
+		},
+	}
+
+	startTimeBeforeLunch := time.Date(2024, 7, 1, 10, 50, 0, 0, timeZoneJapan)
+	restaurant := newPlace("restaurant", 0.001)
+	restaurant.Google.Types = []string{CategoryRestaurant.SubCategories[0]}
+	amusement := newPlace("amusement", 0.002)
+	amusement.Google.Types = []string{CategoryAmusements.SubCategories[0]}
+
+	cases := []struct {
+		name       string
+		input      OptimizeRouteInput
+		expected   []string
+		expectedOk bool
+	}{
+		{
+			name: "should avoid zig-zag from start location",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					newPlace("a", 0.001),
+					newPlace("b", -0.0015),
+					newPlace("c", 0.003),
+				},
+				StartLocation: &GeoLocation{Latitude: 0, Longitude: 0},
+			},
+			expected:   []string{"b", "a", "c"},
+			expectedOk: true,
+		},
+		{
+			name: "should keep first and last place",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					newPlace("a", 0.002),
+					newPlace("b", 0.003),
+					newPlace("c", 0.001),
+					newPlace("d", 0),
+				},
+				FixFirstPlace: true,
+				FixLastPlace:  true,
+			},
+			expected:   []string{"a", "b", "c", "d"},
+			expectedOk: true,
+		},
+		{
+			name: "should keep last place even if end location is specified",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					newPlace("a", 0.002),
+					newPlace("b", 0.001),
+					newPlace("c", 0.003),
+					newPlace("d", 0.004),
+				},
+				EndLocation:   &GeoLocation{Latitude: 0, Longitude: 0},
+				FixFirstPlace: true,
+				FixLastPlace:  true,
+			},
+			expected:   []string{"a", "b", "c", "d"},
+			expectedOk: true,
+		},
+		{
+			name: "should end near end location",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					newPlace("a", 0.001),
+					newPlace("b", 0.002),
+					newPlace("c", 0.003),
+				},
+				StartLocation: &GeoLocation{Latitude: 0.0025, Longitude: 0},
+				EndLocation:   &GeoLocation{Latitude: 0, Longitude: 0},
+			},
+			expected:   []string{"c", "b", "a"},
+			expectedOk: true,
+		},
+		{
+			name: "should visit places while they are open",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					placeOpenFrom0920,
+					newPlace("b", 0.02),
+				},
+				StartLocation: &GeoLocation{Latitude: 0, Longitude: 0},
+				StartTime:     &startTime,
+				TravelMode:    TravelModeWalking,
+			},
+			expected:   []string{"b", "a"},
+			expectedOk: true,
+		},
+		{
+			name: "should return false when no route visits places while they are open",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					placeOpenFrom0920,
+				},
+				StartLocation: &GeoLocation{Latitude: 0, Longitude: 0},
+				StartTime:     &startTime,
+				TravelMode:    TravelModeWalking,
+			},
+			expected:   nil,
+			expectedOk: false,
+		},
+		{
+			name: "should visit restaurant at meal time",
+			input: OptimizeRouteInput{
+				Places: []Place{
+					newPlace("start", 0),
+					restaurant,
+					amusement,
+				},
+				FixFirstPlace:    true,
+				StartTime:        &startTimeBeforeLunch,
+				TravelMode:       TravelModeWalking,
+				ConsiderMealTime: true,
+			},
+			expected:   []string{"start", "amusement", "restaurant"},
+			expectedOk: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, ok := OptimizeRoute(c.input)
+			if ok != c.expectedOk {
+				t.Fatalf("expected: %v, actual: %v", c.expectedOk, ok)
+			}
+
+			var placeIds []string
+			for _, place := range actual {
+				placeIds = append(placeIds, place.Id)
+			}
+
+			if diff := cmp.Diff(c.expected, placeIds); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
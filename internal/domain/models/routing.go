@@ -0,0 +1,60 @@
+package models
+
+import "context"
+
+// TravelMode 移動手段
+type TravelMode string
+
+const (
+	TravelModeWalking TravelMode = "WALKING"
+	TravelModeCycling TravelMode = "CYCLING"
+	TravelModeTransit TravelMode = "TRANSIT"
+	TravelModeDriving TravelMode = "DRIVING"
+)
+
+// MeterPerMinute は直線距離から移動時間を推定するときの移動速度（メートル/分）
+func (t TravelMode) MeterPerMinute() float64 {
+	switch t {
+	case TravelModeCycling:
+		return 250.0
+	case TravelModeTransit:
+		return 400.0
+	case TravelModeDriving:
+		return 500.0
+	default:
+		return 80.0
+	}
+}
+
+// IsValid は定義済みの移動手段かどうかを判定する
+func (t TravelMode) IsValid() bool {
+	switch t {
+	case TravelModeWalking, TravelModeCycling, TravelModeTransit, TravelModeDriving:
+		return true
+	default:
+		return false
+	}
+}
+
+// ScaleDistance 徒歩を基準に定めた距離を、同じ時間で移動できる距離に換算する
+// 自転車や車で移動する場合に、場所の検索範囲を広げるために用いる
+func (t TravelMode) ScaleDistance(distanceOnFoot float64) float64 {
+	return distanceOnFoot * t.MeterPerMinute() / TravelModeWalking.MeterPerMinute()
+}
+
+// RoutingProvider 2地点間の移動時間を求める
+type RoutingProvider interface {
+	// TravelTimeInMinutes from から to までの移動時間（分）を返す
+	TravelTimeInMinutes(ctx context.Context, from GeoLocation, to GeoLocation, mode TravelMode) (uint, error)
+}
+
+// HaversineRoutingProvider 2地点間の直線距離と移動手段ごとの速度から移動時間を推定する
+type HaversineRoutingProvider struct{}
+
+func NewHaversineRoutingProvider() HaversineRoutingProvider {
+	return HaversineRoutingProvider{}
+}
+
+func (h HaversineRoutingProvider) TravelTimeInMinutes(ctx context.Context, from GeoLocation, to GeoLocation, mode TravelMode) (uint, error) {
+	return from.TravelTimeTo(to, mode.MeterPerMinute()), nil
+}
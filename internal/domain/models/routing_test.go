@@ -0,0 +1,49 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+)
+
+func TestTravelMode_ScaleDistance(t *testing.T) {
+	cases := []struct {
+		name           string
+		mode           TravelMode
+		distanceOnFoot float64
+		expected       float64
+	}{
+		{
+			name:           "walking",
+			mode:           TravelModeWalking,
+			distanceOnFoot: 500,
+			expected:       500,
+		},
+		{
+			name:           "cycling",
+			mode:           TravelModeCycling,
+			distanceOnFoot: 500,
+			expected:       1562.5,
+		},
+		{
+			name:           "driving",
+			mode:           TravelModeDriving,
+			distanceOnFoot: 500,
+			expected:       3125,
+		},
+		{
+			name:           "unknown travel mode is regarded as walking",
+			mode:           TravelMode("UNKNOWN"),
+			distanceOnFoot: 500,
+			expected:       500,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.mode.ScaleDistance(c.distanceOnFoot)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ScaleDistance() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package models
+
+import (
+	"fmt"
+
+	"github.com/golang/geo/s2"
+)
+
+// 場所やプランには位置を含む S2 セルの ID を以下のレベルごとに保存する
+// SEE: https://s2geometry.io/resources/s2cell_statistics
+const (
+	// S2CellLevel10 一辺が約 10km のセル
+	S2CellLevel10 = 10
+	// S2CellLevel13 一辺が約 1.2km のセル
+	S2CellLevel13 = 13
+	// S2CellLevel16 一辺が約 150m のセル
+	S2CellLevel16 = 16
+)
+
+// S2CellLevels 保存している S2 セルのレベル（細かい順）
+var S2CellLevels = []int{S2CellLevel16, S2CellLevel13, S2CellLevel10}
+
+// maxS2CellsToCover 検索範囲を覆うセルの数の上限
+// これを超える場合は、より粗いレベルのセルで検索範囲を覆う
+const maxS2CellsToCover = 32
+
+// S2CellIds 位置を含む S2 セルの ID
+type S2CellIds struct {
+	Level10 uint64
+	Level13 uint64
+	Level16 uint64
+}
+
+// S2CellIds 位置を含む S2 セルの ID をレベルごとに求める
+func (g GeoLocation) S2CellIds() S2CellIds {
+	cellId := s2.CellIDFromLatLng(s2.LatLngFromDegrees(g.Latitude, g.Longitude))
+	return S2CellIds{
+		Level10: uint64(cellId.Parent(S2CellLevel10)),
+		Level13: uint64(cellId.Parent(S2CellLevel13)),
+		Level16: uint64(cellId.Parent(S2CellLevel16)),
+	}
+}
+
+// Level 指定したレベルのセルの ID を返す
+// 保存していないレベルが指定された場合はエラーを返す
+func (s S2CellIds) Level(level int) (uint64, error) {
+	switch level {
+	case S2CellLevel10:
+		return s.Level10, nil
+	case S2CellLevel13:
+		return s.Level13, nil
+	case S2CellLevel16:
+		return s.Level16, nil
+	default:
+		return 0, fmt.Errorf("unsupported s2 cell level: %d", level)
+	}
+}
+
+// S2CellCovering g を中心とする半径 radius メートルの範囲を覆う S2 セルの ID を求める
+// 保存しているレベルのうち、セルの数が maxS2CellsToCover 以下になる最も細かいレベルを用いる
+// セルは検索範囲より広い範囲を覆うため、呼び出し元で距離による絞り込みを行う必要がある
+func (g GeoLocation) S2CellCovering(radius float64) (level int, cellIds []uint64) {
+	region := g.capWithRadius(radius)
+
+	var covering s2.CellUnion
+	for _, level = range S2CellLevels {
+		// 明らかにセルの数が上限を超えるレベルでは、セルを求めずに次のレベルを試す
+		if level != S2CellLevels[len(S2CellLevels)-1] && region.Area()/s2.AvgAreaMetric.Value(level) > maxS2CellsToCover {
+			continue
+		}
+
+		coverer := s2.RegionCoverer{MinLevel: level, MaxLevel: level, MaxCells: maxS2CellsToCover}
+		covering = coverer.Covering(region)
+		if len(covering) <= maxS2CellsToCover {
+			break
+		}
+	}
+
+	cellIds = make([]uint64, len(covering))
+	for i, cellId := range covering {
+		cellIds[i] = uint64(cellId)
+	}
+
+	return level, cellIds
+}
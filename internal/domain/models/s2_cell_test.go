@@ -0,0 +1,136 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/golang/geo/s2"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/array"
+)
+
+func TestGeoLocation_S2CellIds(t *testing.T) {
+	cases := []struct {
+		name     string
+		location GeoLocation
+	}{
+		{
+			name:     "Shinjuku Station",
+			location: GeoLocation{Latitude: 35.690921, Longitude: 139.700258},
+		},
+		{
+			name:     "Sydney Opera House",
+			location: GeoLocation{Latitude: -33.856784, Longitude: 151.215297},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			cellIds := c.location.S2CellIds()
+			latLng := s2.LatLngFromDegrees(c.location.Latitude, c.location.Longitude)
+
+			for _, level := range S2CellLevels {
+				id, err := cellIds.Level(level)
+				if err != nil {
+					t.Fatalf("error while getting cell id: %v", err)
+				}
+
+				cellId := s2.CellID(id)
+				if diff := cmp.Diff(level, cellId.Level()); diff != "" {
+					t.Errorf("level mismatch (-want +got):\n%s", diff)
+				}
+
+				if !s2.CellFromCellID(cellId).ContainsPoint(s2.PointFromLatLng(latLng)) {
+					t.Errorf("cell of level %d should contain location", level)
+				}
+			}
+		})
+	}
+}
+
+func TestS2CellIds_Level_UnsupportedLevel(t *testing.T) {
+	cellIds := GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}.S2CellIds()
+	if _, err := cellIds.Level(12); err == nil {
+		t.Errorf("expected error for unsupported level")
+	}
+}
+
+func TestGeoLocation_S2CellCovering(t *testing.T) {
+	cases := []struct {
+		name             string
+		location         GeoLocation
+		radius           float64
+		locationsInRange []GeoLocation
+		expectedLevel    int
+	}{
+		{
+			name:     "small radius should be covered by fine cells",
+			location: GeoLocation{Latitude: 35.690921, Longitude: 139.700258},
+			radius:   100,
+			locationsInRange: []GeoLocation{
+				// 新宿駅東口
+				{Latitude: 35.691288, Longitude: 139.700839},
+			},
+			expectedLevel: S2CellLevel16,
+		},
+		{
+			name:     "radius to search plans should be covered by middle cells",
+			location: GeoLocation{Latitude: 35.690921, Longitude: 139.700258},
+			radius:   2000,
+			locationsInRange: []GeoLocation{
+				// 東京都庁
+				{Latitude: 35.689634, Longitude: 139.692101},
+				// 新宿御苑
+				{Latitude: 35.685175, Longitude: 139.710052},
+			},
+			expectedLevel: S2CellLevel13,
+		},
+		{
+			name:     "large radius should be covered by coarse cells",
+			location: GeoLocation{Latitude: 35.690921, Longitude: 139.700258},
+			radius:   5000,
+			locationsInRange: []GeoLocation{
+				// 渋谷駅
+				{Latitude: 35.658034, Longitude: 139.701636},
+			},
+			expectedLevel: S2CellLevel10,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			level, cellIds := c.location.S2CellCovering(c.radius)
+			if diff := cmp.Diff(c.expectedLevel, level); diff != "" {
+				t.Errorf("level mismatch (-want +got):\n%s", diff)
+			}
+
+			if len(cellIds) == 0 || len(cellIds) > maxS2CellsToCover {
+				t.Errorf("number of cells should be in (0, %d] but got %d", maxS2CellsToCover, len(cellIds))
+			}
+
+			for _, locationInRange := range c.locationsInRange {
+				cellIdOfLocation, err := locationInRange.S2CellIds().Level(level)
+				if err != nil {
+					t.Fatalf("error while getting cell id: %v", err)
+				}
+
+				if _, ok := array.Find(cellIds, func(cellId uint64) bool { return cellId == cellIdOfLocation }); !ok {
+					t.Errorf("cells should cover location %v", locationInRange)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGeoLocation_S2CellCovering(b *testing.B) {
+	location := GeoLocation{Latitude: 35.690921, Longitude: 139.700258}
+	for i := 0; i < b.N; i++ {
+		location.S2CellCovering(2000)
+	}
+}
+
+func BenchmarkGeoLocation_CalculateMBR(b *testing.B) {
+	location := GeoLocation{Latitude: 35.690921, Longitude: 139.700258}
+	for i := 0; i < b.N; i++ {
+		location.CalculateMBR(2000)
+	}
+}
@@ -1,5 +1,10 @@
 package models
 
+import (
+	"context"
+	"fmt"
+)
+
 // Transition 移動情報
 // FromPlaceId がnilの場合は，出発地点が現在地であることを表す
 // ToPlaceId は，移動先の場所ID
@@ -12,7 +17,8 @@ type Transition struct {
 
 // CreateTransition　は移動情報を更新する（プラン内の場所の順番入れ替えなどの後に用いる）
 // startLocation は現在地の座標を表す
-func CreateTransition(places []Place, startLocation *GeoLocation) []Transition {
+// mode は移動手段を表し、直線距離から移動時間を推定するときの速度に用いる
+func CreateTransition(places []Place, startLocation *GeoLocation, mode TravelMode) []Transition {
 	transitions := make([]Transition, 0)
 
 	// 現在位置から作成されたプラン or 場所指定で作成されたプラン
@@ -20,7 +26,7 @@ func CreateTransition(places []Place, startLocation *GeoLocation) []Transition {
 		transitions = append(transitions, Transition{
 			FromPlaceId: nil,
 			ToPlaceId:   places[0].Id,
-			Duration:    startLocation.TravelTimeTo(places[0].Location, 80.0),
+			Duration:    startLocation.TravelTimeTo(places[0].Location, mode.MeterPerMinute()),
 		})
 	}
 
@@ -28,8 +34,80 @@ func CreateTransition(places []Place, startLocation *GeoLocation) []Transition {
 		if i >= len(places)-1 {
 			break
 		}
-		transitions = append(transitions, places[i].CreateTransition(places[i+1]))
+		transitions = append(transitions, places[i].CreateTransition(places[i+1], mode))
 	}
 
 	return transitions
 }
+
+// CreateTransitionWithRouting は routingProvider を用いて移動情報を作成する
+// startLocation は現在地の座標を表す
+func CreateTransitionWithRouting(
+	ctx context.Context,
+	routingProvider RoutingProvider,
+	places []Place,
+	startLocation *GeoLocation,
+	mode TravelMode,
+) ([]Transition, error) {
+	transitions := make([]Transition, 0)
+
+	if startLocation != nil && len(places) > 0 {
+		duration, err := routingProvider.TravelTimeInMinutes(ctx, *startLocation, places[0].Location, mode)
+		if err != nil {
+			return nil, fmt.Errorf("error while calculating travel time from start location: %w", err)
+		}
+
+		transitions = append(transitions, Transition{
+			FromPlaceId: nil,
+			ToPlaceId:   places[0].Id,
+			Duration:    duration,
+		})
+	}
+
+	for i := 0; i < len(places)-1; i++ {
+		duration, err := routingProvider.TravelTimeInMinutes(ctx, places[i].Location, places[i+1].Location, mode)
+		if err != nil {
+			return nil, fmt.Errorf("error while calculating travel time from %s to %s: %w", places[i].Id, places[i+1].Id, err)
+		}
+
+		transitions = append(transitions, Transition{
+			FromPlaceId: &places[i].Id,
+			ToPlaceId:   places[i+1].Id,
+			Duration:    duration,
+		})
+	}
+
+	return transitions, nil
+}
+
+// TransitionToEndLocation プランの最後の場所から到着地点への移動情報
+// FromPlaceId は，プランの最後の場所ID
+// Duration は，移動時間（分）
+type TransitionToEndLocation struct {
+	FromPlaceId string      `json:"from_place_id"`
+	Location    GeoLocation `json:"location"`
+	Duration    uint        `json:"duration"`
+}
+
+// CreateTransitionToEndLocation は places の最後の場所から endLocation までの移動情報を作成する
+// routingProvider による経路探索に失敗した場合は、直線距離から移動時間を推定する
+func CreateTransitionToEndLocation(
+	ctx context.Context,
+	routingProvider RoutingProvider,
+	places []Place,
+	endLocation GeoLocation,
+	mode TravelMode,
+) TransitionToEndLocation {
+	lastPlace := places[len(places)-1]
+
+	duration, err := routingProvider.TravelTimeInMinutes(ctx, lastPlace.Location, endLocation, mode)
+	if err != nil {
+		duration = lastPlace.Location.TravelTimeTo(endLocation, mode.MeterPerMinute())
+	}
+
+	return TransitionToEndLocation{
+		FromPlaceId: lastPlace.Id,
+		Location:    endLocation,
+		Duration:    duration,
+	}
+}
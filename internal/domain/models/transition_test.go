@@ -1,6 +1,8 @@
 package models
 
 import (
+	"context"
+	"fmt"
 	"github.com/google/go-cmp/cmp"
 	"poroto.app/poroto/planner/internal/domain/utils"
 	"testing"
@@ -82,7 +84,7 @@ func TestCreateTransition(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			result := CreateTransition(c.places, c.start)
+			result := CreateTransition(c.places, c.start, TravelModeWalking)
 
 			if diff := cmp.Diff(c.expected, result); diff != "" {
 				t.Errorf("expected %v, but got %v", c.expected, result)
@@ -90,3 +92,142 @@ func TestCreateTransition(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateTransitionWithRouting(t *testing.T) {
+	places := []Place{
+		{
+			Id:       "01",
+			Name:     "東京タワー",
+			Location: GeoLocation{Latitude: 35.658581, Longitude: 139.745433},
+		},
+		{
+			Id:       "02",
+			Name:     "東京スカイツリー",
+			Location: GeoLocation{Latitude: 35.710063, Longitude: 139.8107},
+		},
+	}
+
+	cases := []struct {
+		name            string
+		routingProvider RoutingProvider
+		start           *GeoLocation
+		mode            TravelMode
+		expected        []Transition
+	}{
+		{
+			name:            "haversine routing provider with walking is same as CreateTransition",
+			routingProvider: NewHaversineRoutingProvider(),
+			mode:            TravelModeWalking,
+			expected:        CreateTransition(places, nil, TravelModeWalking),
+		},
+		{
+			name:            "haversine routing provider with driving",
+			routingProvider: NewHaversineRoutingProvider(),
+			mode:            TravelModeDriving,
+			expected: []Transition{
+				{
+					FromPlaceId: utils.StrPointer("01"),
+					ToPlaceId:   "02",
+					Duration:    16,
+				},
+			},
+		},
+		{
+			name:            "routing provider is used for transition from start location",
+			routingProvider: mockRoutingProvider{duration: 10},
+			start:           &GeoLocation{Latitude: 35.681236, Longitude: 139.767125},
+			mode:            TravelModeTransit,
+			expected: []Transition{
+				{
+					FromPlaceId: nil,
+					ToPlaceId:   "01",
+					Duration:    10,
+				},
+				{
+					FromPlaceId: utils.StrPointer("01"),
+					ToPlaceId:   "02",
+					Duration:    10,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, err := CreateTransitionWithRouting(context.Background(), c.routingProvider, places, c.start, c.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, result); diff != "" {
+				t.Errorf("CreateTransitionWithRouting() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCreateTransitionToEndLocation(t *testing.T) {
+	places := []Place{
+		{
+			Id:       "01",
+			Name:     "東京タワー",
+			Location: GeoLocation{Latitude: 35.658581, Longitude: 139.745433},
+		},
+		{
+			Id:       "02",
+			Name:     "東京スカイツリー",
+			Location: GeoLocation{Latitude: 35.710063, Longitude: 139.8107},
+		},
+	}
+
+	// 押上駅
+	endLocation := GeoLocation{Latitude: 35.710702, Longitude: 139.813193}
+
+	cases := []struct {
+		name            string
+		routingProvider RoutingProvider
+		expected        TransitionToEndLocation
+	}{
+		{
+			name:            "transition from last place to end location",
+			routingProvider: mockRoutingProvider{duration: 5},
+			expected: TransitionToEndLocation{
+				FromPlaceId: "02",
+				Location:    endLocation,
+				Duration:    5,
+			},
+		},
+		{
+			name:            "estimate duration from distance when routing provider fails",
+			routingProvider: failingRoutingProvider{},
+			expected: TransitionToEndLocation{
+				FromPlaceId: "02",
+				Location:    endLocation,
+				Duration:    places[1].Location.TravelTimeTo(endLocation, TravelModeWalking.MeterPerMinute()),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := CreateTransitionToEndLocation(context.Background(), c.routingProvider, places, endLocation, TravelModeWalking)
+			if diff := cmp.Diff(c.expected, result); diff != "" {
+				t.Errorf("CreateTransitionToEndLocation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type mockRoutingProvider struct {
+	duration uint
+}
+
+func (m mockRoutingProvider) TravelTimeInMinutes(ctx context.Context, from GeoLocation, to GeoLocation, mode TravelMode) (uint, error) {
+	return m.duration, nil
+}
+
+type failingRoutingProvider struct{}
+
+func (f failingRoutingProvider) TravelTimeInMinutes(ctx context.Context, from GeoLocation, to GeoLocation, mode TravelMode) (uint, error) {
+	return 0, fmt.Errorf("routing provider is not available")
+}
@@ -0,0 +1,86 @@
+package models
+
+import "time"
+
+const (
+	// tripDayStartHour 2日目以降に行動を開始する時刻
+	tripDayStartHour = 9
+	// tripDayEndHour 1日の行動を終えて宿泊施設に向かう時刻
+	tripDayEndHour = 21
+
+	// minTripDayDuration 1日分のプランとして扱う最小の時間（分）
+	minTripDayDuration = 60
+	// MaxTripDays 一度に作成できる旅行の最大日数
+	MaxTripDays = 7
+)
+
+// Trip 複数日にわたる旅行のプラン
+// Plans は1日ごとのプランを日付順に並べたもので、最終日以外のプランは宿泊施設で終わる
+type Trip struct {
+	Id         string     `json:"id"`
+	Name       string     `json:"name"`
+	Plans      []Plan     `json:"plans"`
+	Author     *User      `json:"author"`
+	StartTime  *time.Time `json:"start_time"`
+	TravelMode TravelMode `json:"travel_mode"`
+}
+
+// TripDaySegment 旅行の1日分の行動時間
+type TripDaySegment struct {
+	StartTime         time.Time
+	DurationInMinutes int
+}
+
+// SplitIntoTripDaySegments startTime から freeTimeInMinutes 分の時間を1日ごとの行動時間に分割する
+// 2日目以降は tripDayStartHour 時から、各日 tripDayEndHour 時までを行動時間とする
+// 行動時間が短すぎる日は含めない
+func SplitIntoTripDaySegments(startTime time.Time, freeTimeInMinutes int) []TripDaySegment {
+	endTime := startTime.Add(time.Duration(freeTimeInMinutes) * time.Minute)
+
+	segments := make([]TripDaySegment, 0)
+	dayStart := startTime
+	for len(segments) < MaxTripDays && dayStart.Before(endTime) {
+		dayEnd := time.Date(dayStart.Year(), dayStart.Month(), dayStart.Day(), tripDayEndHour, 0, 0, 0, dayStart.Location())
+		if endTime.Before(dayEnd) {
+			dayEnd = endTime
+		}
+
+		duration := int(dayEnd.Sub(dayStart).Minutes())
+		if duration >= minTripDayDuration {
+			segments = append(segments, TripDaySegment{
+				StartTime:         dayStart,
+				DurationInMinutes: duration,
+			})
+		}
+
+		nextDay := dayStart.AddDate(0, 0, 1)
+		dayStart = time.Date(nextDay.Year(), nextDay.Month(), nextDay.Day(), tripDayStartHour, 0, 0, 0, dayStart.Location())
+	}
+
+	return segments
+}
+
+// StartTimeOfDay day 日目（0始まり）のプランの開始時刻を求める
+// 2日目以降は、旅行の出発地点のタイムゾーンで tripDayStartHour 時に開始する
+func (t Trip) StartTimeOfDay(day int) *time.Time {
+	if t.StartTime == nil {
+		return nil
+	}
+
+	if day == 0 {
+		return t.StartTime
+	}
+
+	date := t.StartTime.In(t.timeZone()).AddDate(0, 0, day)
+	startTime := time.Date(date.Year(), date.Month(), date.Day(), tripDayStartHour, 0, 0, 0, date.Location())
+	return &startTime
+}
+
+// timeZone 旅行の出発地点のタイムゾーン
+// 場所が含まれていない場合は StartTime のタイムゾーンを用いる
+func (t Trip) timeZone() *time.Location {
+	if len(t.Plans) > 0 && len(t.Plans[0].Places) > 0 {
+		return t.Plans[0].Places[0].Location.TimeZone()
+	}
+	return t.StartTime.Location()
+}
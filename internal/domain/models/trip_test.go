@@ -0,0 +1,97 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+	"time"
+)
+
+func TestSplitIntoTripDaySegments(t *testing.T) {
+	cases := []struct {
+		name              string
+		startTime         time.Time
+		freeTimeInMinutes int
+		expected          []TripDaySegment
+	}{
+		{
+			name:              "within a day",
+			startTime:         time.Date(2024, 7, 6, 10, 0, 0, 0, time.UTC),
+			freeTimeInMinutes: 180,
+			expected: []TripDaySegment{
+				{StartTime: time.Date(2024, 7, 6, 10, 0, 0, 0, time.UTC), DurationInMinutes: 180},
+			},
+		},
+		{
+			name:              "weekend trip",
+			startTime:         time.Date(2024, 7, 6, 10, 0, 0, 0, time.UTC),
+			freeTimeInMinutes: (24+8)*60 - 30,
+			expected: []TripDaySegment{
+				{StartTime: time.Date(2024, 7, 6, 10, 0, 0, 0, time.UTC), DurationInMinutes: 11 * 60},
+				{StartTime: time.Date(2024, 7, 7, 9, 0, 0, 0, time.UTC), DurationInMinutes: 8*60 + 30},
+			},
+		},
+		{
+			name:              "first day is too short",
+			startTime:         time.Date(2024, 7, 6, 20, 30, 0, 0, time.UTC),
+			freeTimeInMinutes: 24 * 60,
+			expected: []TripDaySegment{
+				{StartTime: time.Date(2024, 7, 7, 9, 0, 0, 0, time.UTC), DurationInMinutes: 11*60 + 30},
+			},
+		},
+		{
+			name:              "trip is limited to max days",
+			startTime:         time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC),
+			freeTimeInMinutes: 30 * 24 * 60,
+			expected: func() []TripDaySegment {
+				var segments []TripDaySegment
+				for i := 0; i < MaxTripDays; i++ {
+					segments = append(segments, TripDaySegment{StartTime: time.Date(2024, 7, 1+i, 9, 0, 0, 0, time.UTC), DurationInMinutes: 12 * 60})
+				}
+				return segments
+			}(),
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := SplitIntoTripDaySegments(c.startTime, c.freeTimeInMinutes)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTrip_StartTimeOfDay(t *testing.T) {
+	startTime := time.Date(2024, 7, 6, 13, 0, 0, 0, time.UTC)
+	trip := Trip{StartTime: &startTime}
+
+	if diff := cmp.Diff(&startTime, trip.StartTimeOfDay(0)); diff != "" {
+		t.Errorf("(-want +got):\n%s", diff)
+	}
+
+	expected := time.Date(2024, 7, 7, 9, 0, 0, 0, time.UTC)
+	if diff := cmp.Diff(&expected, trip.StartTimeOfDay(1)); diff != "" {
+		t.Errorf("(-want +got):\n%s", diff)
+	}
+}
+
+func TestTrip_StartTimeOfDay_InTimeZoneOfPlaces(t *testing.T) {
+	// 日本時間では 2024/7/6 22:00
+	startTime := time.Date(2024, 7, 6, 13, 0, 0, 0, time.UTC)
+	trip := Trip{
+		StartTime: &startTime,
+		Plans: []Plan{
+			{Places: []Place{{Location: GeoLocation{Latitude: 35.681236, Longitude: 139.767125}}}},
+		},
+	}
+
+	expected := time.Date(2024, 7, 7, 9, 0, 0, 0, timeZoneJapan)
+	actual := trip.StartTimeOfDay(1)
+	if actual == nil {
+		t.Fatalf("expected %v but got nil", expected)
+	}
+	if !expected.Equal(*actual) {
+		t.Errorf("expected %v but got %v", expected, *actual)
+	}
+}
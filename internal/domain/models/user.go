@@ -6,4 +6,6 @@ type User struct {
 	Name        string
 	Email       *string
 	PhotoUrl    *string
+	// PersonalizationDisabled が true の場合は、ユーザーの好みをプランの作成に反映しない
+	PersonalizationDisabled bool
 }
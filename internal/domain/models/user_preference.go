@@ -0,0 +1,93 @@
+package models
+
+import "math"
+
+// ユーザーの好みを計算するときの、各行動の重み
+// いいねした場所は保存したプランに含まれる場所よりも強い好みとして扱う
+// 過去に拒否したカテゴリは好みを打ち消す方向に働く
+const (
+	userPreferenceWeightLike             = 1.0
+	userPreferenceWeightSavedPlan        = 0.5
+	userPreferenceWeightCategoryDisliked = 1.0
+
+	// userPreferenceScoreWeight はカテゴリとの親和度を場所のスコアに加えるときの重み
+	userPreferenceScoreWeight = 0.5
+)
+
+// UserPreferenceProfile はユーザーのこれまでの行動から推定した好み
+// CategoryAffinities はカテゴリ名ごとの親和度で、-1〜1 の値をとる（正の値ほど好む）
+type UserPreferenceProfile struct {
+	UserId             string
+	CategoryAffinities map[string]float64
+}
+
+// NewUserPreferenceProfile はいいねした場所・保存したプラン・過去に拒否したカテゴリから、ユーザーの好みを作成する
+// categoriesDisliked は拒否された回数だけ同じカテゴリを含む
+func NewUserPreferenceProfile(userId string, placesLiked []Place, plansSaved []Plan, categoriesDisliked []LocationCategory) UserPreferenceProfile {
+	scores := make(map[string]float64)
+	for _, place := range placesLiked {
+		for _, category := range place.Categories() {
+			scores[category.Name] += userPreferenceWeightLike
+		}
+	}
+
+	for _, plan := range plansSaved {
+		for _, place := range plan.Places {
+			for _, category := range place.Categories() {
+				scores[category.Name] += userPreferenceWeightSavedPlan
+			}
+		}
+	}
+
+	for _, category := range categoriesDisliked {
+		scores[category.Name] -= userPreferenceWeightCategoryDisliked
+	}
+
+	// 最も絶対値の大きいスコアが 1 となるように正規化する
+	var maxAbsScore float64
+	for _, score := range scores {
+		maxAbsScore = math.Max(maxAbsScore, math.Abs(score))
+	}
+
+	affinities := make(map[string]float64, len(scores))
+	for categoryName, score := range scores {
+		if score == 0 {
+			continue
+		}
+		affinities[categoryName] = score / maxAbsScore
+	}
+
+	return UserPreferenceProfile{
+		UserId:             userId,
+		CategoryAffinities: affinities,
+	}
+}
+
+func (p UserPreferenceProfile) IsZero() bool {
+	return len(p.CategoryAffinities) == 0
+}
+
+// AffinityOfPlace は場所のカテゴリとの親和度を返す
+// 複数のカテゴリに属する場合は、メインカテゴリの親和度を用いる
+func (p UserPreferenceProfile) AffinityOfPlace(place Place) float64 {
+	category := place.MainCategory()
+	if category == nil {
+		return 0
+	}
+	return p.CategoryAffinities[category.Name]
+}
+
+// PersonalizePlaces は場所のスコアに、カテゴリとの親和度を加える
+// SortPlacesByRanking で並び替えたときに、ユーザーの好みの場所が上位に来るようになる
+func (p UserPreferenceProfile) PersonalizePlaces(places []Place) []Place {
+	placesPersonalized := make([]Place, len(places))
+	for i, place := range places {
+		affinity := p.AffinityOfPlace(place)
+		if affinity != 0 {
+			score := place.RankingScoreOrDefault() + userPreferenceScoreWeight*affinity
+			place.RankingScore = &score
+		}
+		placesPersonalized[i] = place
+	}
+	return placesPersonalized
+}
@@ -0,0 +1,99 @@
+package models
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"googlemaps.github.io/maps"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"testing"
+)
+
+func TestNewUserPreferenceProfile(t *testing.T) {
+	cafe := Place{Id: "cafe", Google: GooglePlace{Types: []string{string(maps.PlaceTypeCafe)}}}
+	restaurant := Place{Id: "restaurant", Google: GooglePlace{Types: []string{string(maps.PlaceTypeRestaurant)}}}
+
+	cases := []struct {
+		name               string
+		placesLiked        []Place
+		plansSaved         []Plan
+		categoriesDisliked []LocationCategory
+		expected           UserPreferenceProfile
+	}{
+		{
+			name:     "no activity",
+			expected: UserPreferenceProfile{UserId: "user", CategoryAffinities: map[string]float64{}},
+		},
+		{
+			name:        "liked places are weighted more than places in saved plans",
+			placesLiked: []Place{cafe, cafe},
+			plansSaved: []Plan{
+				{Places: []Place{cafe, restaurant}},
+			},
+			expected: UserPreferenceProfile{
+				UserId: "user",
+				CategoryAffinities: map[string]float64{
+					CategoryCafe.Name:       1.0,
+					CategoryRestaurant.Name: 0.2,
+				},
+			},
+		},
+		{
+			name:               "categories disliked have negative affinity",
+			placesLiked:        []Place{cafe},
+			categoriesDisliked: []LocationCategory{CategoryRestaurant, CategoryRestaurant},
+			expected: UserPreferenceProfile{
+				UserId: "user",
+				CategoryAffinities: map[string]float64{
+					CategoryCafe.Name:       0.5,
+					CategoryRestaurant.Name: -1.0,
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := NewUserPreferenceProfile("user", c.placesLiked, c.plansSaved, c.categoriesDisliked)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("NewUserPreferenceProfile() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUserPreferenceProfile_PersonalizePlaces(t *testing.T) {
+	cases := []struct {
+		name     string
+		profile  UserPreferenceProfile
+		places   []Place
+		expected []string
+	}{
+		{
+			name: "places in preferred categories are ranked higher",
+			profile: UserPreferenceProfile{
+				CategoryAffinities: map[string]float64{
+					CategoryCafe.Name:       1.0,
+					CategoryRestaurant.Name: -1.0,
+				},
+			},
+			places: []Place{
+				{Id: "restaurant", RankingScore: utils.ToPointer(0.9), Google: GooglePlace{Types: []string{string(maps.PlaceTypeRestaurant)}}},
+				{Id: "museum", RankingScore: utils.ToPointer(0.7), Google: GooglePlace{Types: []string{string(maps.PlaceTypeMuseum)}}},
+				{Id: "cafe", RankingScore: utils.ToPointer(0.5), Google: GooglePlace{Types: []string{string(maps.PlaceTypeCafe)}}},
+			},
+			expected: []string{"cafe", "museum", "restaurant"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := SortPlacesByRanking(c.profile.PersonalizePlaces(c.places))
+			actualIds := make([]string, len(actual))
+			for i, place := range actual {
+				actualIds[i] = place.Id
+			}
+			if diff := cmp.Diff(c.expected, actualIds); diff != "" {
+				t.Errorf("PersonalizePlaces() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// rainyPrecipitationProbability 雨が降るとみなす降水確率（%）
+	rainyPrecipitationProbability = 50
+	// rainyPrecipitationInMm 雨が降るとみなす1時間あたりの降水量（mm）
+	rainyPrecipitationInMm = 1.0
+)
+
+// WeatherForecast プランを巡る時間帯の天気予報
+// PrecipitationProbability, PrecipitationInMm は時間帯のうち最も値が大きい時刻のもの
+type WeatherForecast struct {
+	PrecipitationProbability int
+	PrecipitationInMm        float64
+}
+
+// IsRainy 雨が降ると予報されているかを判定する
+func (w WeatherForecast) IsRainy() bool {
+	return w.PrecipitationProbability >= rainyPrecipitationProbability || w.PrecipitationInMm >= rainyPrecipitationInMm
+}
+
+// WeatherProvider 指定した地点・時間帯の天気予報を取得する
+type WeatherProvider interface {
+	// Forecast startAt から endAt までの天気予報を返す
+	// 天気予報が取得できない場合は nil を返す
+	Forecast(ctx context.Context, location GeoLocation, startAt time.Time, endAt time.Time) (*WeatherForecast, error)
+}
+
+// UnknownWeatherProvider 天気予報を取得しない場合に用いる
+type UnknownWeatherProvider struct{}
+
+func NewUnknownWeatherProvider() UnknownWeatherProvider {
+	return UnknownWeatherProvider{}
+}
+
+func (u UnknownWeatherProvider) Forecast(ctx context.Context, location GeoLocation, startAt time.Time, endAt time.Time) (*WeatherForecast, error) {
+	return nil, nil
+}
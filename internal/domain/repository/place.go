@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
@@ -30,10 +31,29 @@ type PlaceRepository interface {
 
 	SaveGooglePlaceDetail(ctx context.Context, googlePlaceId string, detail models.GooglePlaceDetail) error
 
+	// FindStaleGooglePlaces は policy のもとで古い情報を持つ場所を、取得し直す優先度（RefreshPriority）の高い順に最大 limit 件取得する
+	FindStaleGooglePlaces(ctx context.Context, policy models.GooglePlaceRefreshPolicy, now time.Time, limit int) ([]models.GooglePlaceFreshness, error)
+
+	// RefreshGooglePlace は Google Places API から取得し直した情報で、保存されている場所の情報を更新する
+	// 位置が変わった場合は、位置と S2 セルも更新する
+	RefreshGooglePlace(ctx context.Context, googlePlace models.GooglePlace, fetchedAt time.Time) error
+
 	SavePlacePhotos(ctx context.Context, photos []models.PlacePhoto) error
 
 	UpdateLikeByUserId(ctx context.Context, userId string, placeId string, like bool) error
 
 	// UpdateLikeByPlanCandidateSetToUser PlanCandidateSet によりLikeされたものを、UserによるLikeに変更する
 	UpdateLikeByPlanCandidateSetToUser(ctx context.Context, userId string, planCandidateSetIds []string) error
+
+	// FindRankingSignals はすべての場所について、順位付けに用いる指標を取得する
+	FindRankingSignals(ctx context.Context) ([]models.PlaceRankingSignals, error)
+
+	// SaveRankingScores は計算された場所のスコアを保存する（すでに保存されている場合は更新する）
+	SaveRankingScores(ctx context.Context, scores []models.PlaceRankingScore) error
+
+	// FindRankingScoresByPlaceIds は場所ごとのスコアを取得する（スコアが計算されていない場所は含まれない）
+	FindRankingScoresByPlaceIds(ctx context.Context, placeIds []string) (map[string]float64, error)
+
+	// CountSavedPlansByPlaceIds は場所ごとに保存されたプランに含まれている回数を取得する（どのプランにも含まれていない場所は含まれない）
+	CountSavedPlansByPlaceIds(ctx context.Context, placeIds []string) (map[string]int, error)
 }
@@ -11,13 +11,20 @@ type SortedByCreatedAtQueryCursor string
 type PlanRepository interface {
 	Save(ctx context.Context, plan *models.Plan) error
 
+	// SortedByCreatedAt 公開されているプランのみを作成日時の降順で返す
 	SortedByCreatedAt(ctx context.Context, queryCursor *SortedByCreatedAtQueryCursor, limit int) (*[]models.Plan, *SortedByCreatedAtQueryCursor, error)
 
-	Find(ctx context.Context, planId string) (*models.Plan, error)
+	// Find viewerId のユーザーが閲覧できるプランのみを返す（viewerId が nil の場合は未ログインのユーザー）
+	// 存在しないプランと閲覧できないプランは区別せず sql.ErrNoRows を返す
+	Find(ctx context.Context, planId string, viewerId *string) (*models.Plan, error)
 
-	FindByAuthorId(ctx context.Context, authorId string) (*[]models.Plan, error)
+	// FindByAuthorId publicOnly が true の場合は公開されているプランのみを返す
+	FindByAuthorId(ctx context.Context, authorId string, publicOnly bool) (*[]models.Plan, error)
 
-	// FindByLocation location で指定した地点に近いプランを返す
+	// FindRecentByAuthorId 作者のプランを公開設定にかかわらず、作成日時の降順で limit 件まで返す
+	FindRecentByAuthorId(ctx context.Context, authorId string, limit int) (*[]models.Plan, error)
+
+	// FindByLocation location で指定した地点に近い公開されているプランを返す
 	FindByLocation(ctx context.Context, location models.GeoLocation, limit int, searchRange int) (*[]models.Plan, *string, error)
 
 	// UpdatePlanAuthorUserByPlanCandidateSet プラン候補に紐づくプランの作者をユーザーに紐づける
@@ -26,4 +33,29 @@ type PlanRepository interface {
 	FindCollage(ctx context.Context, planId string) (*models.PlanCollage, error)
 
 	UpdateCollageImage(ctx context.Context, planId string, placeId string, placePhotoUrl string) error
+
+	// 以下のメソッドは保存されたプランを編集し、プランのバージョンを1つ増やす
+	// version が保存されているプランのバージョンと異なる場合は apperrors.ErrVersionConflict を返す
+
+	UpdateTitleAndDescription(ctx context.Context, planId string, version int, title string, description *string) error
+
+	UpdateVisibility(ctx context.Context, planId string, version int, visibility models.PlanVisibility) error
+
+	// UpdatePlacesOrder placeIdsOrdered はプランに含まれるすべての場所を過不足なく含む必要がある
+	UpdatePlacesOrder(ctx context.Context, planId string, version int, placeIdsOrdered []string) error
+
+	// AddPlaceToPlan previousPlaceId で指定した場所の後に場所を追加する
+	AddPlaceToPlan(ctx context.Context, planId string, version int, previousPlaceId string, place models.Place) error
+
+	RemovePlaceFromPlan(ctx context.Context, planId string, version int, placeId string) error
+
+	ReplacePlace(ctx context.Context, planId string, version int, placeIdToBeReplaced string, placeToReplace models.Place) error
+
+	// SaveTrip 旅行と1日ごとのプランを保存する
+	SaveTrip(ctx context.Context, trip models.Trip) error
+
+	// FindTrip 旅行と1日ごとのプランを日付順に取得する
+	// viewerId のユーザーが閲覧できないプランは旅行に含めない
+	// 旅行が存在しない場合は nil を返す
+	FindTrip(ctx context.Context, tripId string, viewerId *string) (*models.Trip, error)
 }
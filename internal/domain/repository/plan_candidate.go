@@ -26,12 +26,58 @@ type PlanCandidateRepository interface {
 
 	UpdatePlacesOrder(ctx context.Context, planId string, planCandidateSetId string, placeIdsOrdered []string) error
 
+	// FindEditHistories は AddPlaceToPlan, RemovePlaceFromPlan, ReplacePlace, UpdatePlacesOrder による編集の履歴を編集された順に取得する
+	FindEditHistories(ctx context.Context, planCandidateSetId string) (models.PlanCandidateEditHistories, error)
+
+	// UndoEdit は取り消されていない最新の編集を取り消し、取り消した編集を返す
+	// 取り消せる編集がない場合は nil を返す
+	UndoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error)
+
+	// RedoEdit は最後に取り消された編集をやり直し、やり直した編集を返す
+	// やり直せる編集がない場合は nil を返す
+	RedoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error)
+
 	UpdatePlanCandidateMetaData(ctx context.Context, planCandidateSetId string, meta models.PlanCandidateMetaData) error
 
 	UpdateIsPlaceSearched(ctx context.Context, planCandidateSetId string, isPlaceSearched bool) error
 
 	ReplacePlace(ctx context.Context, planCandidateSetId string, planId string, placeIdToBeReplaced string, placeToReplace models.Place) error
 
+	// FindCategoriesRejectedByUserId はユーザーが保存したプランを作成したときに、拒否されたカテゴリを取得する
+	// 複数のプラン候補で拒否されたカテゴリは、その回数だけ含まれる
+	FindCategoriesRejectedByUserId(ctx context.Context, userId string) ([]models.LocationCategory, error)
+
+	// CreateGroup はプラン候補を複数人で選べるようにする
+	// すでにグループが作成されている場合は何もしない
+	CreateGroup(ctx context.Context, planCandidateSetId string, shareToken string) error
+
+	// FindGroup は参加者と投票を含むグループを取得する
+	// グループが作成されていない場合は nil を返す
+	FindGroup(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateGroup, error)
+
+	// FindGroupByShareToken は共有用のトークンに対応するグループを取得する
+	// 対応するグループがない場合は nil を返す
+	FindGroupByShareToken(ctx context.Context, shareToken string) (*models.PlanCandidateGroup, error)
+
+	AddGroupParticipant(ctx context.Context, planCandidateSetId string, participant models.PlanCandidateGroupParticipant) error
+
+	// UpdatePlanVoteInGroup は参加者によるプランへの投票を更新する
+	UpdatePlanVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, planId string, vote bool) error
+
+	// UpdatePlaceVoteInGroup は参加者による場所への投票を更新する
+	UpdatePlaceVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, placeId string, vote bool) error
+
+	// BindToUser はプラン候補をユーザーに紐付ける（すでに紐付けられている場合は変更しない）
+	BindToUser(ctx context.Context, userId string, planCandidateSetIds []string) error
+
+	// DeleteExpired は expiredBefore より前に期限が切れたプラン候補を、関連するデータとともに期限が古い順に最大 limit 件削除し、削除した件数を返す
+	// ユーザーに紐付けられたもの・プランとして保存されたもの・ログインしたユーザーがグループに参加したものは削除しない
+	DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error)
+
+	// CountExpiredRetained は expiredBefore より前に期限が切れたプラン候補のうち、
+	// ユーザーに紐付けられている等の理由で DeleteExpired によって削除されないものの数を取得する
+	CountExpiredRetained(ctx context.Context, expiredBefore time.Time) (int, error)
+
 	// TODO: PlaceRepository に移動する
 	UpdateLikeToPlaceInPlanCandidateSet(ctx context.Context, planCandidateSetId string, placeId string, like bool) error
 }
@@ -0,0 +1,101 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+// baseLocation はテストで保存する場所の基準となる位置（東京駅）
+var baseLocation = models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125}
+
+// newGooglePlace は基準となる位置から北に distanceInMeter メートル離れた場所を作成する
+func newGooglePlace(googlePlaceId string, distanceInMeter float64, types ...string) models.GooglePlace {
+	// 緯度1度あたりの距離は約111km
+	location := models.GeoLocation{
+		Latitude:  baseLocation.Latitude + distanceInMeter/111_000,
+		Longitude: baseLocation.Longitude,
+	}
+
+	return models.GooglePlace{
+		PlaceId:          googlePlaceId,
+		Name:             "name of " + googlePlaceId,
+		Types:            types,
+		Location:         location,
+		Rating:           4.0,
+		UserRatingsTotal: 100,
+		Vicinity:         utils.StrPointer("vicinity of " + googlePlaceId),
+	}
+}
+
+// savePlaces は Google Places API から取得した場所を保存し、保存された場所を引数の順番で返す
+func savePlaces(t *testing.T, repositories Repositories, googlePlaces ...models.GooglePlace) []models.Place {
+	t.Helper()
+
+	places, err := repositories.Place.SavePlacesFromGooglePlaces(context.Background(), googlePlaces...)
+	if err != nil {
+		t.Fatalf("error while saving places: %v", err)
+	}
+
+	if len(*places) != len(googlePlaces) {
+		t.Fatalf("expected %d places to be saved but got %d", len(googlePlaces), len(*places))
+	}
+
+	return *places
+}
+
+func saveUser(t *testing.T, repositories Repositories, userId string) models.User {
+	t.Helper()
+
+	user := models.User{
+		Id:          userId,
+		FirebaseUID: "firebase-uid-of-" + userId,
+		Name:        "name of " + userId,
+	}
+	if err := repositories.User.Create(context.Background(), user); err != nil {
+		t.Fatalf("error while creating user: %v", err)
+	}
+
+	return user
+}
+
+// savePlanCandidateSet はプラン候補を作成し、plans を追加する
+func savePlanCandidateSet(t *testing.T, repositories Repositories, planCandidateSetId string, expiresAt time.Time, plans ...models.Plan) {
+	t.Helper()
+
+	if err := repositories.PlanCandidate.Create(context.Background(), planCandidateSetId, expiresAt); err != nil {
+		t.Fatalf("error while creating plan candidate set: %v", err)
+	}
+
+	if len(plans) == 0 {
+		return
+	}
+
+	if err := repositories.PlanCandidate.AddPlan(context.Background(), planCandidateSetId, plans...); err != nil {
+		t.Fatalf("error while adding plans to plan candidate set: %v", err)
+	}
+}
+
+func placeIdsOf(places []models.Place) []string {
+	return array.Map(places, func(place models.Place) string {
+		return place.Id
+	})
+}
+
+func planIdsOf(plans []models.Plan) []string {
+	return array.Map(plans, func(plan models.Plan) string {
+		return plan.Id
+	})
+}
+
+// sorted は並び順が定められていない結果を比較するために、ソートした複製を返す
+func sorted(values []string) []string {
+	valuesSorted := append([]string{}, values...)
+	sort.Strings(valuesSorted)
+	return valuesSorted
+}
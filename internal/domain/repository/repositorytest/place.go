@@ -0,0 +1,364 @@
+package repositorytest
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func testPlaceRepository(t *testing.T, newRepositories NewRepositories) {
+	t.Run("SavePlacesFromGooglePlaces returns saved place for saved google place", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		placesSaved := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		places := savePlaces(t, repositories, newGooglePlace("google-place-2", 0), newGooglePlace("google-place-1", 0))
+
+		if diff := cmp.Diff(placesSaved[0].Id, places[1].Id); diff != "" {
+			t.Errorf("place id mismatch (-want +got):\n%s", diff)
+		}
+
+		placeFound, err := repositories.Place.FindByGooglePlaceID(ctx, "google-place-2")
+		if err != nil {
+			t.Fatalf("error while finding place by google place id: %v", err)
+		}
+		if placeFound == nil {
+			t.Fatalf("expected place to be found but got nil")
+		}
+		if diff := cmp.Diff(places[0].Id, placeFound.Id); diff != "" {
+			t.Errorf("place id mismatch (-want +got):\n%s", diff)
+		}
+
+		placeFound, err = repositories.Place.Find(ctx, places[0].Id)
+		if err != nil {
+			t.Fatalf("error while finding place: %v", err)
+		}
+		if placeFound == nil {
+			t.Fatalf("expected place to be found but got nil")
+		}
+		if diff := cmp.Diff("name of google-place-2", placeFound.Name); diff != "" {
+			t.Errorf("place name mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Find returns nil when place is not found", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		placeFound, err := repositories.Place.Find(ctx, "not-found")
+		if err != nil {
+			t.Fatalf("error while finding place: %v", err)
+		}
+		if placeFound != nil {
+			t.Errorf("expected nil but got %v", placeFound)
+		}
+
+		placeFound, err = repositories.Place.FindByGooglePlaceID(ctx, "not-found")
+		if err != nil {
+			t.Fatalf("error while finding place by google place id: %v", err)
+		}
+		if placeFound != nil {
+			t.Errorf("expected nil but got %v", placeFound)
+		}
+	})
+
+	t.Run("FindByLocation and FindByGooglePlaceType", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(
+			t,
+			repositories,
+			newGooglePlace("google-place-near-cafe", 100, "cafe"),
+			newGooglePlace("google-place-near-restaurant", 200, "restaurant"),
+			newGooglePlace("google-place-far-cafe", 5000, "cafe"),
+		)
+
+		placesNearby, err := repositories.Place.FindByLocation(ctx, baseLocation, 1000)
+		if err != nil {
+			t.Fatalf("error while finding places by location: %v", err)
+		}
+		if diff := cmp.Diff(sorted([]string{places[0].Id, places[1].Id}), sorted(placeIdsOf(placesNearby))); diff != "" {
+			t.Errorf("FindByLocation() mismatch (-want +got):\n%s", diff)
+		}
+
+		cafesNearby, err := repositories.Place.FindByGooglePlaceType(ctx, "cafe", baseLocation, 1000)
+		if err != nil {
+			t.Fatalf("error while finding places by google place type: %v", err)
+		}
+		if diff := cmp.Diff([]string{places[0].Id}, placeIdsOf(*cafesNearby)); diff != "" {
+			t.Errorf("FindByGooglePlaceType() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("like counts include likes by plan candidate sets and users", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+		savePlanCandidateSet(t, repositories, "test-plan-candidate-set", time.Now().Add(time.Hour))
+
+		if err := repositories.PlanCandidate.UpdateLikeToPlaceInPlanCandidateSet(ctx, "test-plan-candidate-set", places[0].Id, true); err != nil {
+			t.Fatalf("error while liking place in plan candidate set: %v", err)
+		}
+
+		// 同じ場所に何度いいねしても、いいね数は増えない
+		if err := repositories.PlanCandidate.UpdateLikeToPlaceInPlanCandidateSet(ctx, "test-plan-candidate-set", places[0].Id, true); err != nil {
+			t.Fatalf("error while liking place in plan candidate set: %v", err)
+		}
+
+		if err := repositories.Place.UpdateLikeByUserId(ctx, user.Id, places[1].Id, true); err != nil {
+			t.Fatalf("error while liking place by user: %v", err)
+		}
+
+		expectedLikeCounts := map[string]int{places[0].Id: 1, places[1].Id: 1}
+		for placeId, expectedLikeCount := range expectedLikeCounts {
+			place, err := repositories.Place.Find(ctx, placeId)
+			if err != nil {
+				t.Fatalf("error while finding place: %v", err)
+			}
+			if diff := cmp.Diff(expectedLikeCount, place.LikeCount); diff != "" {
+				t.Errorf("like count of %s mismatch (-want +got):\n%s", placeId, diff)
+			}
+		}
+
+		likePlaces, err := repositories.Place.FindLikePlacesByUserId(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding like places: %v", err)
+		}
+		if diff := cmp.Diff([]string{places[1].Id}, placeIdsOf(*likePlaces)); diff != "" {
+			t.Errorf("FindLikePlacesByUserId() mismatch (-want +got):\n%s", diff)
+		}
+
+		if err := repositories.Place.UpdateLikeByUserId(ctx, user.Id, places[1].Id, false); err != nil {
+			t.Fatalf("error while unliking place by user: %v", err)
+		}
+
+		likePlaces, err = repositories.Place.FindLikePlacesByUserId(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding like places: %v", err)
+		}
+		if len(*likePlaces) != 0 {
+			t.Errorf("expected no like places but got %v", placeIdsOf(*likePlaces))
+		}
+	})
+
+	t.Run("UpdateLikeByPlanCandidateSetToUser moves likes to user", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+		savePlanCandidateSet(t, repositories, "test-plan-candidate-set", time.Now().Add(time.Hour))
+
+		if err := repositories.PlanCandidate.UpdateLikeToPlaceInPlanCandidateSet(ctx, "test-plan-candidate-set", places[0].Id, true); err != nil {
+			t.Fatalf("error while liking place in plan candidate set: %v", err)
+		}
+
+		if err := repositories.Place.UpdateLikeByUserId(ctx, user.Id, places[1].Id, true); err != nil {
+			t.Fatalf("error while liking place by user: %v", err)
+		}
+
+		if err := repositories.Place.UpdateLikeByPlanCandidateSetToUser(ctx, user.Id, []string{"test-plan-candidate-set"}); err != nil {
+			t.Fatalf("error while moving likes to user: %v", err)
+		}
+
+		likePlaces, err := repositories.Place.FindLikePlacesByUserId(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding like places: %v", err)
+		}
+		if diff := cmp.Diff(sorted(placeIdsOf(places)), sorted(placeIdsOf(*likePlaces))); diff != "" {
+			t.Errorf("FindLikePlacesByUserId() mismatch (-want +got):\n%s", diff)
+		}
+
+		place, err := repositories.Place.Find(ctx, places[0].Id)
+		if err != nil {
+			t.Fatalf("error while finding place: %v", err)
+		}
+		if diff := cmp.Diff(1, place.LikeCount); diff != "" {
+			t.Errorf("like count mismatch (-want +got):\n%s", diff)
+		}
+
+		planCandidateSet, err := repositories.PlanCandidate.Find(ctx, "test-plan-candidate-set", time.Now())
+		if err != nil {
+			t.Fatalf("error while finding plan candidate set: %v", err)
+		}
+		if len(planCandidateSet.LikedPlaceIds) != 0 {
+			t.Errorf("expected likes by plan candidate set to be removed but got %v", planCandidateSet.LikedPlaceIds)
+		}
+	})
+
+	t.Run("ranking signals and scores", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+
+		if err := repositories.Place.UpdateLikeByUserId(ctx, user.Id, places[0].Id, true); err != nil {
+			t.Fatalf("error while liking place by user: %v", err)
+		}
+
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "test-plan", Name: "plan", Places: places[:1]}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		signals, err := repositories.Place.FindRankingSignals(ctx)
+		if err != nil {
+			t.Fatalf("error while finding ranking signals: %v", err)
+		}
+		sort.Slice(signals, func(i, j int) bool {
+			return signals[i].PlaceId < signals[j].PlaceId
+		})
+
+		expectedSignals := []models.PlaceRankingSignals{
+			{PlaceId: places[0].Id, GoogleRating: 4.0, GoogleUserRatingsTotal: 100, LikeCount: 1, SavedPlanCount: 1},
+			{PlaceId: places[1].Id, GoogleRating: 4.0, GoogleUserRatingsTotal: 100},
+		}
+		sort.Slice(expectedSignals, func(i, j int) bool {
+			return expectedSignals[i].PlaceId < expectedSignals[j].PlaceId
+		})
+		if diff := cmp.Diff(expectedSignals, signals); diff != "" {
+			t.Errorf("FindRankingSignals() mismatch (-want +got):\n%s", diff)
+		}
+
+		for _, score := range []float64{0.5, 0.8} {
+			// 2回目の保存ではスコアを更新する
+			if err := repositories.Place.SaveRankingScores(ctx, []models.PlaceRankingScore{{PlaceId: places[0].Id, Score: score, LikeCount: 1, SavedPlanCount: 1}}); err != nil {
+				t.Fatalf("error while saving ranking scores: %v", err)
+			}
+		}
+
+		scores, err := repositories.Place.FindRankingScoresByPlaceIds(ctx, placeIdsOf(places))
+		if err != nil {
+			t.Fatalf("error while finding ranking scores: %v", err)
+		}
+		if diff := cmp.Diff(map[string]float64{places[0].Id: 0.8}, scores); diff != "" {
+			t.Errorf("FindRankingScoresByPlaceIds() mismatch (-want +got):\n%s", diff)
+		}
+
+		savedPlanCounts, err := repositories.Place.CountSavedPlansByPlaceIds(ctx, placeIdsOf(places))
+		if err != nil {
+			t.Fatalf("error while counting saved plans: %v", err)
+		}
+		if diff := cmp.Diff(map[string]int{places[0].Id: 1}, savedPlanCounts); diff != "" {
+			t.Errorf("CountSavedPlansByPlaceIds() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("FindStaleGooglePlaces and RefreshGooglePlace", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0), newGooglePlace("google-place-fresh", 0))
+
+		now := time.Now().Truncate(time.Second)
+		policy := models.GooglePlaceRefreshPolicy{MaxAge: 24 * time.Hour, DetailMaxAge: 24 * time.Hour}
+		fetchedAtOfGooglePlaces := map[string]time.Time{
+			"google-place-1":     now.Add(-48 * time.Hour),
+			"google-place-2":     now.Add(-72 * time.Hour),
+			"google-place-fresh": now,
+		}
+		for googlePlaceId, fetchedAt := range fetchedAtOfGooglePlaces {
+			googlePlace := newGooglePlace(googlePlaceId, 0)
+			if err := repositories.Place.RefreshGooglePlace(ctx, googlePlace, fetchedAt); err != nil {
+				t.Fatalf("error while refreshing google place: %v", err)
+			}
+		}
+
+		staleGooglePlaces, err := repositories.Place.FindStaleGooglePlaces(ctx, policy, now, 10)
+		if err != nil {
+			t.Fatalf("error while finding stale google places: %v", err)
+		}
+		staleGooglePlaceIds := array.Map(staleGooglePlaces, func(freshness models.GooglePlaceFreshness) string {
+			return freshness.GooglePlaceId
+		})
+		if diff := cmp.Diff([]string{"google-place-2", "google-place-1"}, staleGooglePlaceIds); diff != "" {
+			t.Errorf("FindStaleGooglePlaces() mismatch (-want +got):\n%s", diff)
+		}
+
+		googlePlaceRefreshed := newGooglePlace("google-place-2", 100, "cafe")
+		googlePlaceRefreshed.Name = "refreshed name"
+		googlePlaceRefreshed.UserRatingsTotal = 200
+		if err := repositories.Place.RefreshGooglePlace(ctx, googlePlaceRefreshed, now); err != nil {
+			t.Fatalf("error while refreshing google place: %v", err)
+		}
+
+		staleGooglePlaces, err = repositories.Place.FindStaleGooglePlaces(ctx, policy, now, 1)
+		if err != nil {
+			t.Fatalf("error while finding stale google places: %v", err)
+		}
+		staleGooglePlaceIds = array.Map(staleGooglePlaces, func(freshness models.GooglePlaceFreshness) string {
+			return freshness.GooglePlaceId
+		})
+		if diff := cmp.Diff([]string{"google-place-1"}, staleGooglePlaceIds); diff != "" {
+			t.Errorf("FindStaleGooglePlaces() mismatch (-want +got):\n%s", diff)
+		}
+
+		place, err := repositories.Place.FindByGooglePlaceID(ctx, "google-place-2")
+		if err != nil {
+			t.Fatalf("error while finding place by google place id: %v", err)
+		}
+		if diff := cmp.Diff("refreshed name", place.Google.Name); diff != "" {
+			t.Errorf("google place name mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(200, place.Google.UserRatingsTotal); diff != "" {
+			t.Errorf("google place user ratings total mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff([]string{"cafe"}, place.Google.Types); diff != "" {
+			t.Errorf("google place types mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(googlePlaceRefreshed.Location, place.Location); diff != "" {
+			t.Errorf("location mismatch (-want +got):\n%s", diff)
+		}
+
+		// 位置が変わった場合は、新しい位置の近くで見つかる
+		placesNearby, err := repositories.Place.FindByLocation(ctx, googlePlaceRefreshed.Location, 10)
+		if err != nil {
+			t.Fatalf("error while finding places by location: %v", err)
+		}
+		if diff := cmp.Diff([]string{place.Id}, placeIdsOf(placesNearby)); diff != "" {
+			t.Errorf("FindByLocation() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("FindStaleGooglePlaces ranks by refresh priority before limiting", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		googlePlacePopular := newGooglePlace("google-place-popular", 0)
+		googlePlacePopular.UserRatingsTotal = 100_000
+		googlePlaceOldest := newGooglePlace("google-place-oldest", 0)
+		googlePlaceOldest.UserRatingsTotal = 0
+		savePlaces(t, repositories, googlePlacePopular, googlePlaceOldest)
+
+		// 取得日時が最も古い場所よりも、レビュー数の多い人気の場所を優先する
+		now := time.Now().Truncate(time.Second)
+		policy := models.GooglePlaceRefreshPolicy{MaxAge: 24 * time.Hour, DetailMaxAge: 24 * time.Hour}
+		for googlePlace, fetchedAt := range map[*models.GooglePlace]time.Time{
+			&googlePlacePopular: now.Add(-48 * time.Hour),
+			&googlePlaceOldest:  now.Add(-72 * time.Hour),
+		} {
+			if err := repositories.Place.RefreshGooglePlace(ctx, *googlePlace, fetchedAt); err != nil {
+				t.Fatalf("error while refreshing google place: %v", err)
+			}
+		}
+
+		staleGooglePlaces, err := repositories.Place.FindStaleGooglePlaces(ctx, policy, now, 1)
+		if err != nil {
+			t.Fatalf("error while finding stale google places: %v", err)
+		}
+		staleGooglePlaceIds := array.Map(staleGooglePlaces, func(freshness models.GooglePlaceFreshness) string {
+			return freshness.GooglePlaceId
+		})
+		if diff := cmp.Diff([]string{"google-place-popular"}, staleGooglePlaceIds); diff != "" {
+			t.Errorf("FindStaleGooglePlaces() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
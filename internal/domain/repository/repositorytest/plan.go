@@ -0,0 +1,391 @@
+package repositorytest
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func testPlanRepository(t *testing.T, newRepositories NewRepositories) {
+	t.Run("Save and Find", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+
+		plan := models.Plan{
+			Id:          "test-plan",
+			Name:        "plan title",
+			Description: utils.StrPointer("plan description"),
+			Places:      []models.Place{places[1], places[0]},
+			Author:      &user,
+		}
+		if err := repositories.Plan.Save(ctx, &plan); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		planFound, err := repositories.Plan.Find(ctx, plan.Id, nil)
+		if err != nil {
+			t.Fatalf("error while finding plan: %v", err)
+		}
+
+		if diff := cmp.Diff(plan.Name, planFound.Name); diff != "" {
+			t.Errorf("name mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(plan.Description, planFound.Description); diff != "" {
+			t.Errorf("description mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(placeIdsOf(plan.Places), placeIdsOf(planFound.Places)); diff != "" {
+			t.Errorf("places mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(&user, planFound.Author); diff != "" {
+			t.Errorf("author mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(models.PlanVisibilityPublic, planFound.Visibility); diff != "" {
+			t.Errorf("visibility mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Find returns sql.ErrNoRows when plan is not found", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		if _, err := repositories.Plan.Find(context.Background(), "not-found", nil); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("expected %v but got %v", sql.ErrNoRows, err)
+		}
+	})
+
+	t.Run("Find returns private plan only to its author", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		user := saveUser(t, repositories, "test-user")
+		anotherUser := saveUser(t, repositories, "another-user")
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "plan-private", Name: "plan", Places: places, Author: &user, Visibility: models.PlanVisibilityPrivate}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		cases := []struct {
+			name     string
+			viewerId *string
+			expected error
+		}{
+			{name: "author", viewerId: &user.Id, expected: nil},
+			{name: "another user", viewerId: &anotherUser.Id, expected: sql.ErrNoRows},
+			{name: "not logged in", viewerId: nil, expected: sql.ErrNoRows},
+		}
+
+		for _, c := range cases {
+			t.Run(c.name, func(t *testing.T) {
+				_, err := repositories.Plan.Find(ctx, "plan-private", c.viewerId)
+				if c.expected == nil && err != nil {
+					t.Errorf("expected no error but got %v", err)
+				}
+				if c.expected != nil && !errors.Is(err, c.expected) {
+					t.Errorf("expected %v but got %v", c.expected, err)
+				}
+			})
+		}
+	})
+
+	t.Run("SortedByCreatedAt returns only public plans", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		plans := []models.Plan{
+			{Id: "plan-1", Name: "plan 1", Places: places},
+			{Id: "plan-2", Name: "plan 2", Places: places, Visibility: models.PlanVisibilityPrivate},
+			{Id: "plan-3", Name: "plan 3", Places: places, Visibility: models.PlanVisibilityUnlisted},
+			{Id: "plan-4", Name: "plan 4", Places: places, Visibility: models.PlanVisibilityPublic},
+		}
+		for _, plan := range plans {
+			if err := repositories.Plan.Save(ctx, &plan); err != nil {
+				t.Fatalf("error while saving plan: %v", err)
+			}
+		}
+
+		plansFound, _, err := repositories.Plan.SortedByCreatedAt(ctx, nil, 10)
+		if err != nil {
+			t.Fatalf("error while finding plans: %v", err)
+		}
+		if diff := cmp.Diff([]string{"plan-4", "plan-1"}, planIdsOf(*plansFound)); diff != "" {
+			t.Errorf("SortedByCreatedAt() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("FindByLocation limits after filtering by distance", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(
+			t,
+			repositories,
+			newGooglePlace("google-place-in-range-1", 0),
+			newGooglePlace("google-place-in-range-2", 300),
+			newGooglePlace("google-place-in-range-3", 600),
+			// 探索範囲を覆うセルには含まれうるが、探索範囲外の場所
+			newGooglePlace("google-place-out-of-range", 1100),
+		)
+
+		// 探索範囲外のプランを最も新しいプランとして保存する
+		plans := []models.Plan{
+			{Id: "plan-in-range-1", Name: "plan", Places: places[0:1]},
+			{Id: "plan-in-range-2", Name: "plan", Places: places[1:2]},
+			{Id: "plan-in-range-3", Name: "plan", Places: places[2:3]},
+			{Id: "plan-out-of-range", Name: "plan", Places: places[3:4]},
+		}
+		for _, plan := range plans {
+			if err := repositories.Plan.Save(ctx, &plan); err != nil {
+				t.Fatalf("error while saving plan: %v", err)
+			}
+		}
+
+		limit := 2
+		plansFound, _, err := repositories.Plan.FindByLocation(ctx, baseLocation, limit, 1000)
+		if err != nil {
+			t.Fatalf("error while finding plans by location: %v", err)
+		}
+
+		if len(*plansFound) != limit {
+			t.Fatalf("FindByLocation() returned %d plans, want %d: %v", len(*plansFound), limit, planIdsOf(*plansFound))
+		}
+		for _, plan := range *plansFound {
+			if plan.Id == "plan-out-of-range" {
+				t.Errorf("FindByLocation() returned plan out of range: %v", planIdsOf(*plansFound))
+			}
+		}
+	})
+
+	t.Run("FindByAuthorId", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		user := saveUser(t, repositories, "test-user")
+		anotherUser := saveUser(t, repositories, "another-user")
+		plans := []models.Plan{
+			{Id: "plan-public", Name: "plan", Places: places, Author: &user},
+			{Id: "plan-private", Name: "plan", Places: places, Author: &user, Visibility: models.PlanVisibilityPrivate},
+			{Id: "plan-of-another-user", Name: "plan", Places: places, Author: &anotherUser},
+		}
+		for _, plan := range plans {
+			if err := repositories.Plan.Save(ctx, &plan); err != nil {
+				t.Fatalf("error while saving plan: %v", err)
+			}
+		}
+
+		plansFound, err := repositories.Plan.FindByAuthorId(ctx, user.Id, false)
+		if err != nil {
+			t.Fatalf("error while finding plans by author: %v", err)
+		}
+		if diff := cmp.Diff(sorted([]string{"plan-public", "plan-private"}), sorted(planIdsOf(*plansFound))); diff != "" {
+			t.Errorf("FindByAuthorId() mismatch (-want +got):\n%s", diff)
+		}
+
+		plansFound, err = repositories.Plan.FindByAuthorId(ctx, user.Id, true)
+		if err != nil {
+			t.Fatalf("error while finding plans by author: %v", err)
+		}
+		if diff := cmp.Diff([]string{"plan-public"}, planIdsOf(*plansFound)); diff != "" {
+			t.Errorf("FindByAuthorId() with publicOnly mismatch (-want +got):\n%s", diff)
+		}
+
+		// 作成日時が同じ場合の順序は保証されないため、件数と作者のみを確認する
+		plansFound, err = repositories.Plan.FindRecentByAuthorId(ctx, user.Id, 1)
+		if err != nil {
+			t.Fatalf("error while finding recent plans by author: %v", err)
+		}
+		if len(*plansFound) != 1 {
+			t.Fatalf("FindRecentByAuthorId() returned %d plans, want 1", len(*plansFound))
+		}
+		if planId := (*plansFound)[0].Id; planId != "plan-public" && planId != "plan-private" {
+			t.Errorf("FindRecentByAuthorId() returned plan of another author: %s", planId)
+		}
+	})
+
+	t.Run("UpdatePlanAuthorUserByPlanCandidateSet binds only plans without author", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		user := saveUser(t, repositories, "test-user")
+		anotherUser := saveUser(t, repositories, "another-user")
+
+		planCandidates := []models.Plan{
+			{Id: "plan-without-author", Name: "plan", Places: places},
+			{Id: "plan-with-author", Name: "plan", Places: places},
+		}
+		savePlanCandidateSet(t, repositories, "test-plan-candidate-set", time.Now().Add(time.Hour), planCandidates...)
+
+		plans := []models.Plan{
+			{Id: "plan-without-author", Name: "plan", Places: places},
+			{Id: "plan-with-author", Name: "plan", Places: places, Author: &anotherUser},
+		}
+		for _, plan := range plans {
+			if err := repositories.Plan.Save(ctx, &plan); err != nil {
+				t.Fatalf("error while saving plan: %v", err)
+			}
+		}
+
+		if err := repositories.Plan.UpdatePlanAuthorUserByPlanCandidateSet(ctx, user.Id, []string{"test-plan-candidate-set"}); err != nil {
+			t.Fatalf("error while updating plan author: %v", err)
+		}
+
+		expectedAuthorIds := map[string]string{
+			"plan-without-author": user.Id,
+			"plan-with-author":    anotherUser.Id,
+		}
+		for planId, expectedAuthorId := range expectedAuthorIds {
+			plan, err := repositories.Plan.Find(ctx, planId, nil)
+			if err != nil {
+				t.Fatalf("error while finding plan: %v", err)
+			}
+			if plan.Author == nil {
+				t.Fatalf("expected author of %s to be set but got nil", planId)
+			}
+			if diff := cmp.Diff(expectedAuthorId, plan.Author.Id); diff != "" {
+				t.Errorf("author of %s mismatch (-want +got):\n%s", planId, diff)
+			}
+		}
+	})
+
+	t.Run("edits increment version and detect conflicts", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0), newGooglePlace("google-place-3", 0))
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "test-plan", Name: "plan", Places: places[:2]}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		if err := repositories.Plan.AddPlaceToPlan(ctx, "test-plan", 0, places[0].Id, places[2]); err != nil {
+			t.Fatalf("error while adding place to plan: %v", err)
+		}
+
+		if err := repositories.Plan.UpdateTitleAndDescription(ctx, "test-plan", 0, "new title", nil); !errors.Is(err, apperrors.ErrVersionConflict) {
+			t.Errorf("expected %v but got %v", apperrors.ErrVersionConflict, err)
+		}
+
+		if err := repositories.Plan.RemovePlaceFromPlan(ctx, "test-plan", 1, places[1].Id); err != nil {
+			t.Fatalf("error while removing place from plan: %v", err)
+		}
+
+		// 編集に失敗した場合はバージョンが変わらない
+		if err := repositories.Plan.RemovePlaceFromPlan(ctx, "test-plan", 2, "not-found"); err == nil {
+			t.Errorf("expected error when removing place which is not in plan but got nil")
+		}
+
+		plan, err := repositories.Plan.Find(ctx, "test-plan", nil)
+		if err != nil {
+			t.Fatalf("error while finding plan: %v", err)
+		}
+		if diff := cmp.Diff([]string{places[0].Id, places[2].Id}, placeIdsOf(plan.Places)); diff != "" {
+			t.Errorf("places mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(2, plan.Version); diff != "" {
+			t.Errorf("version mismatch (-want +got):\n%s", diff)
+		}
+
+		if err := repositories.Plan.UpdateVisibility(ctx, "not-found", 0, models.PlanVisibilityPrivate); !errors.Is(err, sql.ErrNoRows) {
+			t.Errorf("expected %v but got %v", sql.ErrNoRows, err)
+		}
+	})
+
+	t.Run("RemovePlaceFromPlan removes photo of the place from collage", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "test-plan", Name: "plan", Places: places}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		photos := []models.PlacePhoto{
+			{PlaceId: places[0].Id, UserId: user.Id, PhotoUrl: "https://example.com/photo-1.png"},
+			{PlaceId: places[1].Id, UserId: user.Id, PhotoUrl: "https://example.com/photo-2.png"},
+		}
+		if err := repositories.Place.SavePlacePhotos(ctx, photos); err != nil {
+			t.Fatalf("error while saving place photos: %v", err)
+		}
+		for _, photo := range photos {
+			if err := repositories.Plan.UpdateCollageImage(ctx, "test-plan", photo.PlaceId, photo.PhotoUrl); err != nil {
+				t.Fatalf("error while updating collage image: %v", err)
+			}
+		}
+
+		if err := repositories.Plan.RemovePlaceFromPlan(ctx, "test-plan", 0, places[1].Id); err != nil {
+			t.Fatalf("error while removing place from plan: %v", err)
+		}
+
+		collage, err := repositories.Plan.FindCollage(ctx, "test-plan")
+		if err != nil {
+			t.Fatalf("error while finding collage: %v", err)
+		}
+		var placeIdsOfCollage []string
+		for _, image := range collage.Images {
+			placeIdsOfCollage = append(placeIdsOfCollage, image.PlaceId)
+		}
+		if diff := cmp.Diff([]string{places[0].Id}, placeIdsOfCollage); diff != "" {
+			t.Errorf("places of collage mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("SaveTrip and FindTrip", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		user := saveUser(t, repositories, "test-user")
+
+		trip := models.Trip{
+			Id:     "test-trip",
+			Name:   "trip",
+			Author: &user,
+			Plans: []models.Plan{
+				{Id: "plan-day-1", Name: "day 1", Places: places[1:], Author: &user},
+				{Id: "plan-day-2", Name: "day 2", Places: places[:1], Author: &user, Visibility: models.PlanVisibilityPrivate},
+			},
+			TravelMode: models.TravelModeWalking,
+		}
+		if err := repositories.Plan.SaveTrip(ctx, trip); err != nil {
+			t.Fatalf("error while saving trip: %v", err)
+		}
+
+		tripFound, err := repositories.Plan.FindTrip(ctx, trip.Id, &user.Id)
+		if err != nil {
+			t.Fatalf("error while finding trip: %v", err)
+		}
+		if diff := cmp.Diff([]string{"plan-day-1", "plan-day-2"}, planIdsOf(tripFound.Plans)); diff != "" {
+			t.Errorf("plans of trip mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(&user, tripFound.Author); diff != "" {
+			t.Errorf("author mismatch (-want +got):\n%s", diff)
+		}
+
+		tripFoundByAnotherUser, err := repositories.Plan.FindTrip(ctx, trip.Id, utils.StrPointer("another-user"))
+		if err != nil {
+			t.Fatalf("error while finding trip: %v", err)
+		}
+		if diff := cmp.Diff([]string{"plan-day-1"}, planIdsOf(tripFoundByAnotherUser.Plans)); diff != "" {
+			t.Errorf("plans of trip visible to another user mismatch (-want +got):\n%s", diff)
+		}
+
+		tripNotFound, err := repositories.Plan.FindTrip(ctx, "not-found", nil)
+		if err != nil {
+			t.Fatalf("error while finding trip: %v", err)
+		}
+		if tripNotFound != nil {
+			t.Errorf("expected nil but got %v", tripNotFound)
+		}
+	})
+}
@@ -0,0 +1,313 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func testPlanCandidateRepository(t *testing.T, newRepositories NewRepositories) {
+	t.Run("Find returns nil when plan candidate set is expired", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0))
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+		savePlanCandidateSet(
+			t,
+			repositories,
+			"test-plan-candidate-set",
+			expiresAt,
+			models.Plan{Id: "plan-1", Name: "plan 1", Places: places},
+			models.Plan{Id: "plan-2", Name: "plan 2", Places: places[1:]},
+		)
+
+		planCandidateSet, err := repositories.PlanCandidate.Find(ctx, "test-plan-candidate-set", expiresAt.Add(-time.Minute))
+		if err != nil {
+			t.Fatalf("error while finding plan candidate set: %v", err)
+		}
+		if planCandidateSet == nil {
+			t.Fatalf("expected plan candidate set to be found but got nil")
+		}
+		if diff := cmp.Diff([]string{"plan-1", "plan-2"}, planIdsOf(planCandidateSet.Plans)); diff != "" {
+			t.Errorf("plans mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(placeIdsOf(places), placeIdsOf(planCandidateSet.Plans[0].Places)); diff != "" {
+			t.Errorf("places mismatch (-want +got):\n%s", diff)
+		}
+
+		planCandidateSet, err = repositories.PlanCandidate.Find(ctx, "test-plan-candidate-set", expiresAt.Add(time.Minute))
+		if err != nil {
+			t.Fatalf("error while finding plan candidate set: %v", err)
+		}
+		if planCandidateSet != nil {
+			t.Errorf("expected nil but got %v", planCandidateSet)
+		}
+	})
+
+	t.Run("edits can be undone and redone", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0), newGooglePlace("google-place-2", 0), newGooglePlace("google-place-3", 0))
+		savePlanCandidateSet(t, repositories, "test-plan-candidate-set", time.Now().Add(time.Hour), models.Plan{Id: "test-plan", Name: "plan", Places: places[:2]})
+
+		assertPlaceIds := func(t *testing.T, expected []string) {
+			t.Helper()
+
+			plan, err := repositories.PlanCandidate.FindPlan(ctx, "test-plan-candidate-set", "test-plan")
+			if err != nil {
+				t.Fatalf("error while finding plan: %v", err)
+			}
+			if diff := cmp.Diff(expected, placeIdsOf(plan.Places)); diff != "" {
+				t.Errorf("places mismatch (-want +got):\n%s", diff)
+			}
+		}
+
+		if err := repositories.PlanCandidate.AddPlaceToPlan(ctx, "test-plan-candidate-set", "test-plan", places[0].Id, places[2]); err != nil {
+			t.Fatalf("error while adding place to plan: %v", err)
+		}
+		assertPlaceIds(t, []string{places[0].Id, places[2].Id, places[1].Id})
+
+		if err := repositories.PlanCandidate.RemovePlaceFromPlan(ctx, "test-plan-candidate-set", "test-plan", places[1].Id); err != nil {
+			t.Fatalf("error while removing place from plan: %v", err)
+		}
+		assertPlaceIds(t, []string{places[0].Id, places[2].Id})
+
+		history, err := repositories.PlanCandidate.UndoEdit(ctx, "test-plan-candidate-set")
+		if err != nil {
+			t.Fatalf("error while undoing edit: %v", err)
+		}
+		if history == nil || history.Type != models.PlanCandidateEditTypeRemovePlace || !history.IsUndone {
+			t.Errorf("expected undone REMOVE_PLACE edit but got %v", history)
+		}
+		assertPlaceIds(t, []string{places[0].Id, places[2].Id, places[1].Id})
+
+		history, err = repositories.PlanCandidate.RedoEdit(ctx, "test-plan-candidate-set")
+		if err != nil {
+			t.Fatalf("error while redoing edit: %v", err)
+		}
+		if history == nil || history.Type != models.PlanCandidateEditTypeRemovePlace || history.IsUndone {
+			t.Errorf("expected redone REMOVE_PLACE edit but got %v", history)
+		}
+		assertPlaceIds(t, []string{places[0].Id, places[2].Id})
+
+		// 新しく編集すると、取り消された編集は破棄される
+		if _, err := repositories.PlanCandidate.UndoEdit(ctx, "test-plan-candidate-set"); err != nil {
+			t.Fatalf("error while undoing edit: %v", err)
+		}
+		if err := repositories.PlanCandidate.UpdatePlacesOrder(ctx, "test-plan", "test-plan-candidate-set", []string{places[1].Id, places[2].Id, places[0].Id}); err != nil {
+			t.Fatalf("error while updating places order: %v", err)
+		}
+		assertPlaceIds(t, []string{places[1].Id, places[2].Id, places[0].Id})
+
+		histories, err := repositories.PlanCandidate.FindEditHistories(ctx, "test-plan-candidate-set")
+		if err != nil {
+			t.Fatalf("error while finding edit histories: %v", err)
+		}
+		historyTypes := array.Map(histories, func(history models.PlanCandidateEditHistory) models.PlanCandidateEditType {
+			return history.Type
+		})
+		if diff := cmp.Diff([]models.PlanCandidateEditType{models.PlanCandidateEditTypeAddPlace, models.PlanCandidateEditTypeReorderPlaces}, historyTypes); diff != "" {
+			t.Errorf("edit histories mismatch (-want +got):\n%s", diff)
+		}
+
+		history, err = repositories.PlanCandidate.RedoEdit(ctx, "test-plan-candidate-set")
+		if err != nil {
+			t.Fatalf("error while redoing edit: %v", err)
+		}
+		if history != nil {
+			t.Errorf("expected nothing to redo but got %v", history)
+		}
+	})
+
+	t.Run("groups and votes", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		savePlanCandidateSet(t, repositories, "test-plan-candidate-set", time.Now().Add(time.Hour), models.Plan{Id: "test-plan", Name: "plan", Places: places})
+		savePlanCandidateSet(t, repositories, "plan-candidate-set-without-group", time.Now().Add(time.Hour))
+
+		if err := repositories.PlanCandidate.CreateGroup(ctx, "test-plan-candidate-set", "test-share-token"); err != nil {
+			t.Fatalf("error while creating group: %v", err)
+		}
+
+		// すでにグループがある場合は、作成済みのグループをそのまま残す
+		if err := repositories.PlanCandidate.CreateGroup(ctx, "test-plan-candidate-set", "another-share-token"); err != nil {
+			t.Fatalf("error while creating group again: %v", err)
+		}
+		if group, err := repositories.PlanCandidate.FindGroupByShareToken(ctx, "another-share-token"); err != nil || group != nil {
+			t.Errorf("expected group not to be created again but got %v, %v", group, err)
+		}
+
+		participant := models.PlanCandidateGroupParticipant{Id: "test-participant", DisplayName: "participant", Token: "test-participant-token"}
+		if err := repositories.PlanCandidate.AddGroupParticipant(ctx, "test-plan-candidate-set", participant); err != nil {
+			t.Fatalf("error while adding participant: %v", err)
+		}
+
+		// 同じ投票を繰り返しても、投票は1つだけ保存される
+		for i := 0; i < 2; i++ {
+			if err := repositories.PlanCandidate.UpdatePlanVoteInGroup(ctx, "test-plan-candidate-set", participant.Id, "test-plan", true); err != nil {
+				t.Fatalf("error while voting plan: %v", err)
+			}
+			if err := repositories.PlanCandidate.UpdatePlaceVoteInGroup(ctx, "test-plan-candidate-set", participant.Id, places[0].Id, true); err != nil {
+				t.Fatalf("error while voting place: %v", err)
+			}
+		}
+
+		if err := repositories.PlanCandidate.UpdatePlaceVoteInGroup(ctx, "test-plan-candidate-set", participant.Id, places[0].Id, false); err != nil {
+			t.Fatalf("error while unvoting place: %v", err)
+		}
+
+		group, err := repositories.PlanCandidate.FindGroupByShareToken(ctx, "test-share-token")
+		if err != nil {
+			t.Fatalf("error while finding group: %v", err)
+		}
+
+		expected := &models.PlanCandidateGroup{
+			PlanCandidateSetId: "test-plan-candidate-set",
+			ShareToken:         "test-share-token",
+			Participants:       []models.PlanCandidateGroupParticipant{participant},
+			PlanVotes:          []models.PlanCandidateGroupPlanVote{{ParticipantId: participant.Id, PlanId: "test-plan"}},
+			PlaceVotes:         []models.PlanCandidateGroupPlaceVote{},
+		}
+		if diff := cmp.Diff(expected, group); diff != "" {
+			t.Errorf("FindGroupByShareToken() mismatch (-want +got):\n%s", diff)
+		}
+
+		group, err = repositories.PlanCandidate.FindGroup(ctx, "plan-candidate-set-without-group")
+		if err != nil {
+			t.Fatalf("error while finding group: %v", err)
+		}
+		if group != nil {
+			t.Errorf("expected nil but got %v", group)
+		}
+	})
+
+	t.Run("DeleteExpired keeps plan candidate sets used by users", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		user := saveUser(t, repositories, "test-user")
+
+		now := time.Now().Truncate(time.Second)
+		expiresAtOfPlanCandidateSets := map[string]time.Time{
+			"expired-older":   now.Add(-3 * time.Hour),
+			"expired-newer":   now.Add(-2 * time.Hour),
+			"expired-bound":   now.Add(-4 * time.Hour),
+			"expired-saved":   now.Add(-4 * time.Hour),
+			"expired-grouped": now.Add(-4 * time.Hour),
+			"not-expired":     now.Add(time.Hour),
+		}
+		for planCandidateSetId, expiresAt := range expiresAtOfPlanCandidateSets {
+			savePlanCandidateSet(t, repositories, planCandidateSetId, expiresAt, models.Plan{Id: "plan-of-" + planCandidateSetId, Name: "plan", Places: places})
+		}
+
+		if err := repositories.PlanCandidate.BindToUser(ctx, user.Id, []string{"expired-bound"}); err != nil {
+			t.Fatalf("error while binding plan candidate set to user: %v", err)
+		}
+
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "plan-of-expired-saved", Name: "plan", Places: places}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		if err := repositories.PlanCandidate.CreateGroup(ctx, "expired-grouped", "test-share-token"); err != nil {
+			t.Fatalf("error while creating group: %v", err)
+		}
+		if err := repositories.PlanCandidate.AddGroupParticipant(ctx, "expired-grouped", models.PlanCandidateGroupParticipant{
+			Id:          "test-participant",
+			UserId:      utils.ToPointer(user.Id),
+			DisplayName: "participant",
+			Token:       "test-participant-token",
+		}); err != nil {
+			t.Fatalf("error while adding participant: %v", err)
+		}
+
+		if err := repositories.PlanCandidate.UpdateLikeToPlaceInPlanCandidateSet(ctx, "expired-older", places[0].Id, true); err != nil {
+			t.Fatalf("error while liking place: %v", err)
+		}
+
+		retainedCount, err := repositories.PlanCandidate.CountExpiredRetained(ctx, now)
+		if err != nil {
+			t.Fatalf("error while counting retained plan candidate sets: %v", err)
+		}
+		if diff := cmp.Diff(3, retainedCount); diff != "" {
+			t.Errorf("CountExpiredRetained() mismatch (-want +got):\n%s", diff)
+		}
+
+		// 期限が古いものから削除される
+		deletedCount, err := repositories.PlanCandidate.DeleteExpired(ctx, now, 1)
+		if err != nil {
+			t.Fatalf("error while deleting expired plan candidate sets: %v", err)
+		}
+		if diff := cmp.Diff(1, deletedCount); diff != "" {
+			t.Errorf("DeleteExpired() mismatch (-want +got):\n%s", diff)
+		}
+
+		// 削除されたプラン候補によるいいねは数えない
+		place, err := repositories.Place.Find(ctx, places[0].Id)
+		if err != nil {
+			t.Fatalf("error while finding place: %v", err)
+		}
+		if diff := cmp.Diff(0, place.LikeCount); diff != "" {
+			t.Errorf("like count mismatch (-want +got):\n%s", diff)
+		}
+
+		deletedCount, err = repositories.PlanCandidate.DeleteExpired(ctx, now, 10)
+		if err != nil {
+			t.Fatalf("error while deleting expired plan candidate sets: %v", err)
+		}
+		if diff := cmp.Diff(1, deletedCount); diff != "" {
+			t.Errorf("DeleteExpired() mismatch (-want +got):\n%s", diff)
+		}
+
+		retainedCount, err = repositories.PlanCandidate.CountExpiredRetained(ctx, now)
+		if err != nil {
+			t.Fatalf("error while counting retained plan candidate sets: %v", err)
+		}
+		if diff := cmp.Diff(3, retainedCount); diff != "" {
+			t.Errorf("CountExpiredRetained() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("FindCategoriesRejectedByUserId", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		places := savePlaces(t, repositories, newGooglePlace("google-place-1", 0))
+		user := saveUser(t, repositories, "test-user")
+
+		planCandidateSetIds := []string{"plan-candidate-set-saved", "plan-candidate-set-not-saved"}
+		for _, planCandidateSetId := range planCandidateSetIds {
+			savePlanCandidateSet(t, repositories, planCandidateSetId, time.Now().Add(time.Hour), models.Plan{Id: "plan-of-" + planCandidateSetId, Name: "plan", Places: places})
+			if err := repositories.PlanCandidate.UpdatePlanCandidateMetaData(ctx, planCandidateSetId, models.PlanCandidateMetaData{
+				LocationStart:      &baseLocation,
+				CategoriesRejected: &[]models.LocationCategory{models.CategoryCafe},
+			}); err != nil {
+				t.Fatalf("error while updating meta data: %v", err)
+			}
+		}
+
+		if err := repositories.Plan.Save(ctx, &models.Plan{Id: "plan-of-plan-candidate-set-saved", Name: "plan", Places: places, Author: &user}); err != nil {
+			t.Fatalf("error while saving plan: %v", err)
+		}
+
+		categories, err := repositories.PlanCandidate.FindCategoriesRejectedByUserId(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding rejected categories: %v", err)
+		}
+		categoryNames := array.Map(categories, func(category models.LocationCategory) string {
+			return category.Name
+		})
+		if diff := cmp.Diff([]string{models.CategoryCafe.Name}, categoryNames); diff != "" {
+			t.Errorf("FindCategoriesRejectedByUserId() mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
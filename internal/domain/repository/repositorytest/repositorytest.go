@@ -0,0 +1,37 @@
+// Package repositorytest はリポジトリの実装が共通して満たすべき振る舞いを検証するテストを提供する
+// rdb と inmemory のように複数の実装があるリポジトリで、振る舞いが食い違わないようにするために用いる
+package repositorytest
+
+import (
+	"testing"
+
+	"poroto.app/poroto/planner/internal/domain/repository"
+)
+
+// Repositories は同じデータを共有するリポジトリの組
+type Repositories struct {
+	Place         repository.PlaceRepository
+	Plan          repository.PlanRepository
+	PlanCandidate repository.PlanCandidateRepository
+	User          repository.UserRepository
+}
+
+// NewRepositories はサブテストごとに呼ばれ、何も保存されていない状態のリポジトリを返す
+// 保存したデータの削除が必要な場合は t.Cleanup で登録する
+type NewRepositories func(t *testing.T) Repositories
+
+// Run はすべてのリポジトリについて、共通の振る舞いを検証する
+func Run(t *testing.T, newRepositories NewRepositories) {
+	t.Run("UserRepository", func(t *testing.T) {
+		testUserRepository(t, newRepositories)
+	})
+	t.Run("PlaceRepository", func(t *testing.T) {
+		testPlaceRepository(t, newRepositories)
+	})
+	t.Run("PlanRepository", func(t *testing.T) {
+		testPlanRepository(t, newRepositories)
+	})
+	t.Run("PlanCandidateRepository", func(t *testing.T) {
+		testPlanCandidateRepository(t, newRepositories)
+	})
+}
@@ -0,0 +1,130 @@
+package repositorytest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func testUserRepository(t *testing.T, newRepositories NewRepositories) {
+	t.Run("Create and Find", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		user := models.User{
+			Id:          "test-user",
+			FirebaseUID: "test-firebase-uid",
+			Name:        "test user",
+			Email:       utils.StrPointer("test@example.com"),
+			PhotoUrl:    utils.StrPointer("https://example.com/photo.png"),
+		}
+		if err := repositories.User.Create(ctx, user); err != nil {
+			t.Fatalf("error while creating user: %v", err)
+		}
+
+		userFound, err := repositories.User.Find(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding user: %v", err)
+		}
+		if diff := cmp.Diff(&user, userFound); diff != "" {
+			t.Errorf("Find() mismatch (-want +got):\n%s", diff)
+		}
+
+		userFoundByFirebaseUID, err := repositories.User.FindByFirebaseUID(ctx, user.FirebaseUID)
+		if err != nil {
+			t.Fatalf("error while finding user by firebase uid: %v", err)
+		}
+		if diff := cmp.Diff(&user, userFoundByFirebaseUID); diff != "" {
+			t.Errorf("FindByFirebaseUID() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Find returns nil when user is not found", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		userFound, err := repositories.User.Find(ctx, "not-found")
+		if err != nil {
+			t.Fatalf("error while finding user: %v", err)
+		}
+		if userFound != nil {
+			t.Errorf("expected nil but got %v", userFound)
+		}
+
+		userFoundByFirebaseUID, err := repositories.User.FindByFirebaseUID(ctx, "not-found")
+		if err != nil {
+			t.Fatalf("error while finding user by firebase uid: %v", err)
+		}
+		if userFoundByFirebaseUID != nil {
+			t.Errorf("expected nil but got %v", userFoundByFirebaseUID)
+		}
+	})
+
+	t.Run("Create fails when firebase uid is already used", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		user := saveUser(t, repositories, "test-user")
+		if err := repositories.User.Create(ctx, models.User{
+			Id:          "another-user",
+			FirebaseUID: user.FirebaseUID,
+			Name:        "another user",
+		}); err == nil {
+			t.Errorf("expected error but got nil")
+		}
+	})
+
+	t.Run("UpdateProfile", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		user := saveUser(t, repositories, "test-user")
+		if err := repositories.User.UpdateProfile(ctx, user.Id, utils.StrPointer("updated name"), nil); err != nil {
+			t.Fatalf("error while updating profile: %v", err)
+		}
+
+		userFound, err := repositories.User.Find(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding user: %v", err)
+		}
+
+		user.Name = "updated name"
+		if diff := cmp.Diff(&user, userFound); diff != "" {
+			t.Errorf("Find() mismatch (-want +got):\n%s", diff)
+		}
+
+		if err := repositories.User.UpdateProfile(ctx, "not-found", utils.StrPointer("updated name"), nil); err == nil {
+			t.Errorf("expected error when user is not found but got nil")
+		}
+	})
+
+	t.Run("UpdatePersonalizationDisabled", func(t *testing.T) {
+		repositories := newRepositories(t)
+		ctx := context.Background()
+
+		user := saveUser(t, repositories, "test-user")
+		// 同じ値で更新してもエラーにならない
+		for _, disabled := range []bool{true, true} {
+			if err := repositories.User.UpdatePersonalizationDisabled(ctx, user.Id, disabled); err != nil {
+				t.Fatalf("error while updating personalization: %v", err)
+			}
+		}
+
+		userFound, err := repositories.User.Find(ctx, user.Id)
+		if err != nil {
+			t.Fatalf("error while finding user: %v", err)
+		}
+
+		user.PersonalizationDisabled = true
+		if diff := cmp.Diff(&user, userFound); diff != "" {
+			t.Errorf("Find() mismatch (-want +got):\n%s", diff)
+		}
+
+		if err := repositories.User.UpdatePersonalizationDisabled(ctx, "not-found", true); err == nil {
+			t.Errorf("expected error when user is not found but got nil")
+		}
+	})
+}
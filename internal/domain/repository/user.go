@@ -13,4 +13,7 @@ type UserRepository interface {
 	FindByFirebaseUID(ctx context.Context, firebaseUID string) (*models.User, error)
 
 	UpdateProfile(ctx context.Context, userId string, name *string, photoUrl *string) error
+
+	// UpdatePersonalizationDisabled ユーザーの好みをプランの作成に反映するかどうかを更新する
+	UpdatePersonalizationDisabled(ctx context.Context, userId string, disabled bool) error
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
-	"time"
 )
 
 const (
@@ -31,7 +30,7 @@ func (s Service) FetchDestinationPlacesForPlanCandidate(ctx context.Context, inp
 		input.Limit = defaultFetchDestinationPlacesForPlanCandidateLimit
 	}
 
-	planCandidate, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, time.Now())
+	planCandidate, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, err
 	}
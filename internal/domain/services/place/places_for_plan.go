@@ -7,7 +7,6 @@ import (
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
-	"time"
 )
 
 const (
@@ -32,7 +31,7 @@ func (s Service) FetchCandidatePlaces(
 		input.NLimit = defaultMaxPlacesToSuggest
 	}
 
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -56,11 +55,13 @@ func (s Service) FetchCandidatePlaces(
 	}
 
 	// 検索された場所を保存
-	placesFiltered := placesNearby
-	placesFiltered = placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:        placesFiltered,
-		StartLocation: *planCandidateSet.MetaData.LocationStart,
+	pipeline, err := s.placeFilterPipelineConfig.Build("place.places_for_plan", placefilter.PipelineOptions{
+		Location: *planCandidateSet.MetaData.LocationStart,
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error while building place filter pipeline: %v", err)
+	}
+	placesFiltered, _ := pipeline.Apply(ctx, placesNearby)
 
 	placesSortedByRating := models.SortPlacesByRating(placesFiltered)
 
@@ -2,6 +2,8 @@ package place
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
@@ -17,6 +19,8 @@ type PlacesNearPlanInput struct {
 	PlanID string
 	Limit  int
 	Radius float64
+	// Viewer は閲覧しているユーザー（未ログインの場合は nil）
+	Viewer *models.User
 }
 
 func (s Service) FetchPlacesNearPlan(ctx context.Context, input PlacesNearPlanInput) (*[]models.Place, error) {
@@ -36,7 +40,16 @@ func (s Service) FetchPlacesNearPlan(ctx context.Context, input PlacesNearPlanIn
 		input.Radius = defaultRadiusToSearchPlacesNearPlan
 	}
 
-	plan, err := s.planRepository.Find(ctx, input.PlanID)
+	// 閲覧できないプランの周辺の場所は返さない
+	var viewerId *string
+	if input.Viewer != nil {
+		viewerId = &input.Viewer.Id
+	}
+
+	plan, err := s.planRepository.Find(ctx, input.PlanID, viewerId)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &[]models.Place{}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -51,11 +64,14 @@ func (s Service) FetchPlacesNearPlan(ctx context.Context, input PlacesNearPlanIn
 		return nil, fmt.Errorf("error while fetching places near plan: %v", err)
 	}
 
-	placesFiltered := placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:              places,
-		StartLocation:       planLocation,
-		IgnoreDistanceRange: input.Radius,
+	pipeline, err := s.placeFilterPipelineConfig.Build("place.places_near_plan", placefilter.PipelineOptions{
+		Location:           planLocation,
+		MaxDistanceInMeter: input.Radius,
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error while building place filter pipeline: %v", err)
+	}
+	placesFiltered, _ := pipeline.Apply(ctx, places)
 
 	// プランに含まれている場所を除外する
 	placesFiltered = array.Filter(placesFiltered, func(place models.Place) bool {
@@ -3,11 +3,12 @@ package place
 import (
 	"context"
 	"fmt"
+	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
-	"time"
+	"poroto.app/poroto/planner/internal/domain/utils"
 )
 
 const (
@@ -48,7 +49,7 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 		return nil, fmt.Errorf("plan id is empty")
 	}
 
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate set: %v", err)
 	}
@@ -130,7 +131,8 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 	}
 
 	// おすすめの場所を取得する
-	placesRecommend := selectRecommendedPlaces(
+	placesRecommend, err := s.selectRecommendedPlaces(
+		ctx,
 		placesNearby,
 		nil,
 		*plan,
@@ -139,6 +141,9 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 		int(input.NLimit),
 		nil,
 	)
+	if err != nil {
+		return nil, fmt.Errorf("error while selecting recommended places: %v", err)
+	}
 
 	// カテゴリごとのおすすめの場所を取得する
 	var placesGrouped []categoryGroupedPlaces
@@ -157,7 +162,8 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 			break
 		}
 
-		placesRecommendedWithCategory := selectRecommendedPlaces(
+		placesRecommendedWithCategory, err := s.selectRecommendedPlaces(
+			ctx,
 			placesNearby,
 			placesAlreadyChosen,
 			*plan,
@@ -166,6 +172,9 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 			int(input.NLimit),
 			&category,
 		)
+		if err != nil {
+			return nil, fmt.Errorf("error while selecting recommended places of category %s: %v", category.Name, err)
+		}
 
 		// ひとつも場所が見つからなかった場合はスキップする
 		if len(placesRecommendedWithCategory) == 0 {
@@ -221,7 +230,7 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 		return place.Id
 	})
 	transitions := array.Map(placesAll, func(place models.Place) models.Transition {
-		return startPlace.CreateTransition(place)
+		return startPlace.CreateTransition(place, planCandidateSet.MetaData.GetTravelMode())
 	})
 
 	return &FetchPlacesToAddOutput{
@@ -232,7 +241,8 @@ func (s Service) FetchPlacesToAdd(ctx context.Context, input FetchPlacesToAddInp
 	}, nil
 }
 
-func selectRecommendedPlaces(
+func (s Service) selectRecommendedPlaces(
+	ctx context.Context,
 	places []models.Place,
 	placesAlreadyChosen []models.Place,
 	plan models.Plan,
@@ -240,50 +250,56 @@ func selectRecommendedPlaces(
 	planCandidateMetaData models.PlanCandidateMetaData,
 	nLimit int,
 	category *models.LocationCategory,
-) []models.Place {
-	placesFiltered := places
-	placesFiltered = placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:        placesFiltered,
-		StartLocation: startLocation,
+) ([]models.Place, error) {
+	pipeline, err := s.placeFilterPipelineConfig.Build("place.places_to_add", placefilter.PipelineOptions{
+		Location:           startLocation,
+		CategoriesDisliked: utils.FromPointerOrZero(planCandidateMetaData.CategoriesRejected),
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error while building place filter pipeline: %v", err)
+	}
 
 	// プランに含まれている場所から800m圏内の場所を選択する
 	if len(plan.Places) > 1 {
-		placesFiltered = placefilter.FilterPlaces(placesFiltered, func(place models.Place) bool {
+		pipeline = pipeline.Then(placefilter.NewStage("near_places_in_plan", func(place models.Place) bool {
 			for _, placeInPlan := range plan.Places {
 				if place.Location.DistanceInMeter(placeInPlan.Location) < 800 {
 					return true
 				}
 			}
 			return false
-		})
+		}))
 	}
 
-	// すでにプランに含まれている場所を除外する
-	placesFiltered = placefilter.FilterPlaces(placesFiltered, func(place models.Place) bool {
-		_, isAlreadyInPlan := array.Find(plan.Places, func(placeInPlan models.Place) bool {
-			return placeInPlan.Id == place.Id
-		})
-		return !isAlreadyInPlan
-	})
-
-	// すでに他のカテゴリで追加されている場所を除外する
-	placesFiltered = placefilter.FilterPlaces(placesFiltered, func(place models.Place) bool {
-		_, isAlreadyChosen := array.Find(placesAlreadyChosen, func(placeAlreadyChosen models.Place) bool {
-			return placeAlreadyChosen.Id == place.Id
-		})
-		return !isAlreadyChosen
-	})
+	pipeline = pipeline.Then(
+		// すでにプランに含まれている場所を除外する
+		placefilter.NewStage("not_in_plan", func(place models.Place) bool {
+			_, isAlreadyInPlan := array.Find(plan.Places, func(placeInPlan models.Place) bool {
+				return placeInPlan.Id == place.Id
+			})
+			return !isAlreadyInPlan
+		}),
+		// すでに他のカテゴリで追加されている場所を除外する
+		placefilter.NewStage("not_already_chosen", func(place models.Place) bool {
+			_, isAlreadyChosen := array.Find(placesAlreadyChosen, func(placeAlreadyChosen models.Place) bool {
+				return placeAlreadyChosen.Id == place.Id
+			})
+			return !isAlreadyChosen
+		}),
+	)
 
 	// カテゴリでフィルタリング
 	if category != nil {
-		placesFiltered = placefilter.FilterByCategory(placesFiltered, []models.LocationCategory{*category}, true)
+		pipeline = pipeline.Then(placefilter.Stage{
+			Name: "category",
+			Filter: func(places []models.Place) []models.Place {
+				return placefilter.FilterByCategory(places, []models.LocationCategory{*category}, true)
+			},
+		})
 	}
 
-	// 除外カテゴリでフィルタリング
-	if planCandidateMetaData.CategoriesRejected != nil {
-		placesFiltered = placefilter.FilterByCategory(placesFiltered, *planCandidateMetaData.CategoriesRejected, false)
-	}
+	placesFiltered, results := pipeline.Apply(ctx, places)
+	s.logger.Debug("places after filtering", zap.String("pipeline", pipeline.Name), zap.Any("stages", results))
 
 	// レビューの高い順でソート
 	placesFiltered = models.SortPlacesByRating(placesFiltered)
@@ -291,5 +307,5 @@ func selectRecommendedPlaces(
 	// TODO: 「カテゴリなし」の場合はすべてのカテゴリの場所が表示されるようにする
 	placesRecommended := array.Take(placesFiltered, nLimit)
 
-	return placesRecommended
+	return placesRecommended, nil
 }
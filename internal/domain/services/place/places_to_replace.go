@@ -6,7 +6,6 @@ import (
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
-	"time"
 )
 
 func (s Service) FetchPlacesToReplace(
@@ -16,7 +15,7 @@ func (s Service) FetchPlacesToReplace(
 	placeId string,
 	nLimit uint,
 ) ([]models.Place, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate set: %v", err)
 	}
@@ -57,10 +56,13 @@ func (s Service) FetchPlacesToReplace(
 		return nil, fmt.Errorf("error while fetching nearby places: %v\n", err)
 	}
 
-	placesFiltered := placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:        placesNearby,
-		StartLocation: startPlace.Location,
+	pipeline, err := s.placeFilterPipelineConfig.Build("place.places_to_replace", placefilter.PipelineOptions{
+		Location: startPlace.Location,
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error while building place filter pipeline: %v", err)
+	}
+	placesFiltered, _ := pipeline.Apply(ctx, placesNearby)
 
 	// 遠い場所を除外
 	placesFiltered = placefilter.FilterWithinDistanceRange(placesFiltered, startPlace.Location, 0, 1000)
@@ -0,0 +1,10 @@
+package placefilter
+
+import "poroto.app/poroto/planner/internal/domain/models"
+
+// FilterAlongRoute start から end へ向かう途中で立ち寄ったときに、遠回りになる距離が maxDetourInMeter 未満の場所のみを残す
+func FilterAlongRoute(placesToFilter []models.Place, start models.GeoLocation, end models.GeoLocation, maxDetourInMeter float64) []models.Place {
+	return FilterPlaces(placesToFilter, func(place models.Place) bool {
+		return place.Location.DetourDistanceInMeter(start, end) < maxDetourInMeter
+	})
+}
@@ -0,0 +1,53 @@
+package placefilter
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func TestFilterAlongRoute(t *testing.T) {
+	// 名古屋駅から栄駅へ向かう
+	start := models.GeoLocation{Latitude: 35.170915, Longitude: 136.881537}
+	end := models.GeoLocation{Latitude: 35.170694, Longitude: 136.908611}
+
+	cases := []struct {
+		name             string
+		placesToFilter   []models.Place
+		maxDetourInMeter float64
+		expected         []models.Place
+	}{
+		{
+			name: "should keep places between start and end",
+			placesToFilter: []models.Place{
+				// 伏見駅
+				{Id: "fushimi", Location: models.GeoLocation{Latitude: 35.169201, Longitude: 136.897583}},
+				// 名古屋城
+				{Id: "nagoya_castle", Location: models.GeoLocation{Latitude: 35.185371, Longitude: 136.899064}},
+			},
+			maxDetourInMeter: 500,
+			expected: []models.Place{
+				{Id: "fushimi", Location: models.GeoLocation{Latitude: 35.169201, Longitude: 136.897583}},
+			},
+		},
+		{
+			name: "should remove places beyond end",
+			placesToFilter: []models.Place{
+				// 今池駅
+				{Id: "imaike", Location: models.GeoLocation{Latitude: 35.169722, Longitude: 136.934167}},
+			},
+			maxDetourInMeter: 500,
+			expected:         []models.Place{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := FilterAlongRoute(c.placesToFilter, start, end, c.maxDetourInMeter)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("FilterAlongRoute() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package placefilter
+
+import "poroto.app/poroto/planner/internal/domain/models"
+
+// FilterByWeather 雨が予報されている場合は、屋外にある場所を取り除く
+// 天気予報が取得できていない場合や、屋内の場所が一つも残らない場合はフィルタリングしない
+func FilterByWeather(placesToFilter []models.Place, weather *models.WeatherForecast) []models.Place {
+	if weather == nil || !weather.IsRainy() {
+		return placesToFilter
+	}
+
+	placesFiltered := FilterPlaces(placesToFilter, func(place models.Place) bool {
+		return place.Environment() != models.PlaceEnvironmentOutdoor
+	})
+	if len(placesFiltered) == 0 {
+		return placesToFilter
+	}
+
+	return placesFiltered
+}
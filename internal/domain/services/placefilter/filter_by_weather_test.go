@@ -0,0 +1,64 @@
+package placefilter
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"testing"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func TestFilterByWeather(t *testing.T) {
+	park := models.Place{
+		Id:     "park",
+		Google: models.GooglePlace{Types: []string{models.CategoryPark.SubCategories[0]}},
+	}
+	museum := models.Place{
+		Id:     "museum",
+		Google: models.GooglePlace{Types: []string{models.CategoryCulture.SubCategories[0]}},
+	}
+	zoo := models.Place{
+		Id:     "zoo",
+		Google: models.GooglePlace{Types: []string{models.CategoryNatural.SubCategories[0]}},
+	}
+
+	cases := []struct {
+		name           string
+		placesToFilter []models.Place
+		weather        *models.WeatherForecast
+		expected       []models.Place
+	}{
+		{
+			name:           "should not filter places when weather is unknown",
+			placesToFilter: []models.Place{park, museum},
+			weather:        nil,
+			expected:       []models.Place{park, museum},
+		},
+		{
+			name:           "should not filter places when it is not rainy",
+			placesToFilter: []models.Place{park, museum},
+			weather:        &models.WeatherForecast{PrecipitationProbability: 10},
+			expected:       []models.Place{park, museum},
+		},
+		{
+			name:           "should remove outdoor places when it is rainy",
+			placesToFilter: []models.Place{park, museum, zoo},
+			weather:        &models.WeatherForecast{PrecipitationProbability: 80, PrecipitationInMm: 3.0},
+			expected:       []models.Place{museum, zoo},
+		},
+		{
+			name:           "should keep places when there are only outdoor places",
+			placesToFilter: []models.Place{park},
+			weather:        &models.WeatherForecast{PrecipitationProbability: 80},
+			expected:       []models.Place{park},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := FilterByWeather(c.placesToFilter, c.weather)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("FilterByWeather() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
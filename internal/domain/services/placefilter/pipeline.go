@@ -0,0 +1,115 @@
+package placefilter
+
+import (
+	"context"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// Stage はパイプラインを構成する、名前のついたフィルタ
+type Stage struct {
+	Name   string
+	Filter func(places []models.Place) []models.Place
+}
+
+type placesDroppedKey struct{}
+
+// StageResult はステージを適用したときに取り除かれた場所の数
+// PlacesDropped は取り除かれた場所（重複により取り除かれた場所は含まない）で、WithPlacesDropped が設定されている場合のみ含まれる
+type StageResult struct {
+	Name             string         `json:"name"`
+	NumPlacesInput   int            `json:"numPlacesInput"`
+	NumPlacesDropped int            `json:"numPlacesDropped"`
+	PlacesDropped    []models.Place `json:"-"`
+}
+
+// Pipeline は複数のステージを順番に適用する
+type Pipeline struct {
+	Name   string
+	stages []Stage
+}
+
+func NewPipeline(name string, stages ...Stage) Pipeline {
+	return Pipeline{
+		Name:   name,
+		stages: stages,
+	}
+}
+
+// Then は末尾にステージを追加したパイプラインを返す
+// 元のパイプラインは変更されない
+func (p Pipeline) Then(stages ...Stage) Pipeline {
+	newStages := make([]Stage, 0, len(p.stages)+len(stages))
+	newStages = append(newStages, p.stages...)
+	newStages = append(newStages, stages...)
+	return Pipeline{
+		Name:   p.Name,
+		stages: newStages,
+	}
+}
+
+func (p Pipeline) StageNames() []string {
+	names := make([]string, len(p.stages))
+	for i, stage := range p.stages {
+		names[i] = stage.Name
+	}
+	return names
+}
+
+// WithPlacesDropped は ctx を引き継いで適用されたパイプラインの結果に、取り除かれた場所を含めるようにする
+// 取り除かれた場所を求めるのはデバッグ時のみでよいため、通常は含めない
+func WithPlacesDropped(ctx context.Context) context.Context {
+	return context.WithValue(ctx, placesDroppedKey{}, true)
+}
+
+func isPlacesDroppedRequested(ctx context.Context) bool {
+	if ctx == nil {
+		return false
+	}
+
+	requested, _ := ctx.Value(placesDroppedKey{}).(bool)
+	return requested
+}
+
+// Apply はステージを順番に適用し、各ステージで取り除かれた場所の数を返す
+// ctx に StageResultRecorder が設定されている場合は、結果を記録する
+func (p Pipeline) Apply(ctx context.Context, places []models.Place) ([]models.Place, []StageResult) {
+	withPlacesDropped := isPlacesDroppedRequested(ctx)
+	results := make([]StageResult, 0, len(p.stages))
+	placesFiltered := places
+	for _, stage := range p.stages {
+		placesInput := placesFiltered
+		placesFiltered = stage.Filter(placesInput)
+
+		result := StageResult{
+			Name:             stage.Name,
+			NumPlacesInput:   len(placesInput),
+			NumPlacesDropped: len(placesInput) - len(placesFiltered),
+		}
+		if withPlacesDropped {
+			result.PlacesDropped = placesDropped(placesInput, placesFiltered)
+		}
+		results = append(results, result)
+	}
+
+	if recorder := stageResultRecorderFromContext(ctx); recorder != nil {
+		recorder.record(p.Name, results)
+	}
+
+	return placesFiltered, results
+}
+
+func placesDropped(placesInput []models.Place, placesOutput []models.Place) []models.Place {
+	placeIdsOutput := make(map[string]bool, len(placesOutput))
+	for _, place := range placesOutput {
+		placeIdsOutput[place.Id] = true
+	}
+
+	var dropped []models.Place
+	for _, place := range placesInput {
+		if !placeIdsOutput[place.Id] {
+			dropped = append(dropped, place)
+		}
+	}
+	return dropped
+}
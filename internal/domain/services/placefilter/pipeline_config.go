@@ -0,0 +1,185 @@
+package placefilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+const (
+	ignorePlaceDistanceRange = 1500
+)
+
+// PipelineConfig はパイプラインを構成するステージを、適用する順番に並べたもの
+type PipelineConfig struct {
+	Stages []StageConfig `json:"stages" yaml:"stages"`
+}
+
+// StageConfig はステージごとの設定
+// Disabled が true の場合は、そのステージを適用しない
+// MaxDistanceInMeter は within_distance_range ステージで、リクエストごとに範囲が指定されていない場合に利用する
+// LowestRating と LowestUserRatingsTotal は rating ステージで利用する
+type StageConfig struct {
+	Name                   string  `json:"name" yaml:"name"`
+	Disabled               bool    `json:"disabled" yaml:"disabled"`
+	MaxDistanceInMeter     float64 `json:"maxDistanceInMeter" yaml:"maxDistanceInMeter"`
+	LowestRating           float32 `json:"lowestRating" yaml:"lowestRating"`
+	LowestUserRatingsTotal int     `json:"lowestUserRatingsTotal" yaml:"lowestUserRatingsTotal"`
+}
+
+// PipelineOptions はリクエストごとに指定するパイプラインの条件
+// Location を中心に MaxDistanceInMeter 圏内の場所を残す（指定しない場合は設定の値を利用する）
+// CategoriesDisliked, Weather, AlongRoute は設定によらず、指定された場合は必ず対応するステージを適用する
+// Stages は呼び出し元ごとのステージで、設定されたステージの後、ユーザーの条件によるステージの前に適用する
+type PipelineOptions struct {
+	Location           models.GeoLocation
+	MaxDistanceInMeter float64
+	CategoriesDisliked []models.LocationCategory
+	Weather            *models.WeatherForecast
+	AlongRoute         *AlongRouteOptions
+	Stages             []Stage
+}
+
+type AlongRouteOptions struct {
+	Start            models.GeoLocation
+	End              models.GeoLocation
+	MaxDetourInMeter float64
+}
+
+// DefaultPipelineConfig プラン作成時に共通して無視する場所を取り除くパイプラインの設定
+func DefaultPipelineConfig() PipelineConfig {
+	return PipelineConfig{
+		Stages: []StageConfig{
+			// 重複した場所を削除
+			{Name: StageNameDuplicated},
+			// 特定のカテゴリは無視する
+			{Name: StageNameIgnoreCategory},
+			{Name: StageNameCategoryToFilter},
+			// 会社は無視する
+			{Name: StageNameCompany},
+			// 1.5km圏外の場所は無視する
+			{Name: StageNameWithinDistanceRange, MaxDistanceInMeter: ignorePlaceDistanceRange},
+			// 画像がない場所は無視する
+			{Name: StageNameHasPhoto},
+		},
+	}
+}
+
+// NewPipelineConfig は環境変数 PLACE_FILTER_PIPELINE_CONFIG_FILE で指定された設定を読み込む
+// 指定されていない場合は DefaultPipelineConfig を利用する
+func NewPipelineConfig() (*PipelineConfig, error) {
+	path := os.Getenv("PLACE_FILTER_PIPELINE_CONFIG_FILE")
+	if path == "" {
+		config := DefaultPipelineConfig()
+		return &config, nil
+	}
+	return LoadPipelineConfig(path)
+}
+
+// LoadPipelineConfig は YAML または JSON で書かれた設定を読み込む
+func LoadPipelineConfig(path string) (*PipelineConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading pipeline config: %v", err)
+	}
+
+	var config PipelineConfig
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &config)
+	default:
+		err = json.Unmarshal(data, &config)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error while unmarshalling pipeline config: %v", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+// Validate は設定できないステージが含まれていないかを確認する
+// ユーザーの条件によるステージ（拒否したカテゴリ・天気・到着地点までの経路）は常に適用されるため、設定できない
+func (c PipelineConfig) Validate() error {
+	for _, stage := range c.Stages {
+		switch stage.Name {
+		case StageNameDuplicated,
+			StageNameIgnoreCategory,
+			StageNameCategoryToFilter,
+			StageNameCompany,
+			StageNameWithinDistanceRange,
+			StageNameHasPhoto,
+			StageNameRating:
+		case StageNameCategoriesDisliked,
+			StageNameWeather,
+			StageNameAlongRoute:
+			return fmt.Errorf("stage %s is always applied and cannot be configured", stage.Name)
+		default:
+			return fmt.Errorf("unknown stage: %s", stage.Name)
+		}
+	}
+	return nil
+}
+
+// Build は設定とリクエストごとの条件からパイプラインを作成する
+// 設定されたステージ、options.Stages、ユーザーの条件によるステージの順に適用する
+// 天気のステージは屋内の場所が残らない場合にフィルタリングしないため、選ばれうる場所のみが残った後に適用する
+func (c PipelineConfig) Build(name string, options PipelineOptions) (Pipeline, error) {
+	if options.Location.IsZero() {
+		return Pipeline{}, fmt.Errorf("location is empty")
+	}
+
+	stages := make([]Stage, 0, len(c.Stages)+len(options.Stages)+3)
+	for _, stageConfig := range c.Stages {
+		if stageConfig.Disabled {
+			continue
+		}
+
+		switch stageConfig.Name {
+		case StageNameDuplicated:
+			stages = append(stages, NewDuplicatedStage())
+		case StageNameIgnoreCategory:
+			stages = append(stages, NewIgnoreCategoryStage())
+		case StageNameCategoryToFilter:
+			stages = append(stages, NewCategoryToFilterStage())
+		case StageNameCompany:
+			stages = append(stages, NewCompanyStage())
+		case StageNameWithinDistanceRange:
+			maxDistance := options.MaxDistanceInMeter
+			if maxDistance == 0 {
+				maxDistance = stageConfig.MaxDistanceInMeter
+			}
+			if maxDistance == 0 {
+				maxDistance = ignorePlaceDistanceRange
+			}
+			stages = append(stages, NewWithinDistanceRangeStage(options.Location, maxDistance))
+		case StageNameHasPhoto:
+			stages = append(stages, NewHasPhotoStage())
+		case StageNameRating:
+			stages = append(stages, NewRatingStage(stageConfig.LowestRating, stageConfig.LowestUserRatingsTotal))
+		}
+	}
+
+	stages = append(stages, options.Stages...)
+
+	if len(options.CategoriesDisliked) > 0 {
+		stages = append(stages, NewCategoriesDislikedStage(options.CategoriesDisliked))
+	}
+
+	if options.AlongRoute != nil {
+		stages = append(stages, NewAlongRouteStage(options.AlongRoute.Start, options.AlongRoute.End, options.AlongRoute.MaxDetourInMeter))
+	}
+
+	if options.Weather != nil {
+		stages = append(stages, NewWeatherStage(options.Weather))
+	}
+
+	return NewPipeline(name, stages...), nil
+}
@@ -0,0 +1,224 @@
+package placefilter
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"testing"
+)
+
+func TestLoadPipelineConfig(t *testing.T) {
+	expected := PipelineConfig{
+		Stages: []StageConfig{
+			{Name: StageNameDuplicated},
+			{Name: StageNameWithinDistanceRange, MaxDistanceInMeter: 500},
+			{Name: StageNameHasPhoto, Disabled: true},
+			{Name: StageNameRating, LowestRating: 3.5, LowestUserRatingsTotal: 10},
+		},
+	}
+
+	cases := []struct {
+		name string
+		path string
+	}{
+		{
+			name: "should load yaml config",
+			path: "testdata/pipeline.yaml",
+		},
+		{
+			name: "should load json config",
+			path: "testdata/pipeline.json",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := LoadPipelineConfig(c.path)
+			if err != nil {
+				t.Fatalf("error while loading pipeline config: %v", err)
+			}
+			if diff := cmp.Diff(expected, *actual); diff != "" {
+				t.Errorf("LoadPipelineConfig() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadPipelineConfig_UnknownStage(t *testing.T) {
+	if _, err := LoadPipelineConfig("testdata/pipeline_unknown_stage.yaml"); err == nil {
+		t.Errorf("expected error for unknown stage")
+	}
+}
+
+func TestPipelineConfig_Validate(t *testing.T) {
+	cases := []struct {
+		name        string
+		stageName   string
+		expectedErr bool
+	}{
+		{name: "tunable stage", stageName: StageNameRating, expectedErr: false},
+		{name: "categories disliked is always applied", stageName: StageNameCategoriesDisliked, expectedErr: true},
+		{name: "weather is always applied", stageName: StageNameWeather, expectedErr: true},
+		{name: "along route is always applied", stageName: StageNameAlongRoute, expectedErr: true},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := PipelineConfig{Stages: []StageConfig{{Name: c.stageName}}}.Validate()
+			if c.expectedErr != (err != nil) {
+				t.Errorf("expected error: %v, actual: %v", c.expectedErr, err)
+			}
+		})
+	}
+}
+
+func TestPipelineConfig_Build(t *testing.T) {
+	location := models.GeoLocation{Latitude: 35.0, Longitude: 135.0}
+
+	cases := []struct {
+		name     string
+		config   PipelineConfig
+		options  PipelineOptions
+		expected []string
+	}{
+		{
+			name:    "should skip stages whose options are not given",
+			config:  DefaultPipelineConfig(),
+			options: PipelineOptions{Location: location},
+			expected: []string{
+				StageNameDuplicated,
+				StageNameIgnoreCategory,
+				StageNameCategoryToFilter,
+				StageNameCompany,
+				StageNameWithinDistanceRange,
+				StageNameHasPhoto,
+			},
+		},
+		{
+			name:   "should include stages whose options are given",
+			config: DefaultPipelineConfig(),
+			options: PipelineOptions{
+				Location:           location,
+				CategoriesDisliked: []models.LocationCategory{models.CategoryCafe},
+				Weather:            &models.WeatherForecast{PrecipitationProbability: 80},
+				AlongRoute:         &AlongRouteOptions{Start: location, End: location},
+			},
+			expected: []string{
+				StageNameDuplicated,
+				StageNameIgnoreCategory,
+				StageNameCategoryToFilter,
+				StageNameCompany,
+				StageNameWithinDistanceRange,
+				StageNameHasPhoto,
+				StageNameCategoriesDisliked,
+				StageNameAlongRoute,
+				StageNameWeather,
+			},
+		},
+		{
+			name:   "should always include stages whose options are given even if config does not list them",
+			config: PipelineConfig{Stages: []StageConfig{{Name: StageNameDuplicated}}},
+			options: PipelineOptions{
+				Location:           location,
+				CategoriesDisliked: []models.LocationCategory{models.CategoryCafe},
+				Weather:            &models.WeatherForecast{PrecipitationProbability: 80},
+				AlongRoute:         &AlongRouteOptions{Start: location, End: location},
+			},
+			expected: []string{
+				StageNameDuplicated,
+				StageNameCategoriesDisliked,
+				StageNameAlongRoute,
+				StageNameWeather,
+			},
+		},
+		{
+			name:   "should apply stages of caller before stages of user conditions",
+			config: PipelineConfig{Stages: []StageConfig{{Name: StageNameDuplicated}}},
+			options: PipelineOptions{
+				Location: location,
+				Weather:  &models.WeatherForecast{PrecipitationProbability: 80},
+				Stages:   []Stage{NewRatingStage(3.0, 10)},
+			},
+			expected: []string{
+				StageNameDuplicated,
+				StageNameRating,
+				StageNameWeather,
+			},
+		},
+		{
+			name: "should skip disabled stages",
+			config: PipelineConfig{
+				Stages: []StageConfig{
+					{Name: StageNameDuplicated},
+					{Name: StageNameHasPhoto, Disabled: true},
+				},
+			},
+			options:  PipelineOptions{Location: location},
+			expected: []string{StageNameDuplicated},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pipeline, err := c.config.Build("test", c.options)
+			if err != nil {
+				t.Fatalf("error while building pipeline: %v", err)
+			}
+
+			actual := pipeline.StageNames()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Build() stage names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipelineConfig_Build_EmptyLocation(t *testing.T) {
+	if _, err := DefaultPipelineConfig().Build("test", PipelineOptions{}); err == nil {
+		t.Errorf("expected error when location is empty")
+	}
+}
+
+func TestPipelineConfig_BuildWithinDistanceRange(t *testing.T) {
+	location := models.GeoLocation{Latitude: 35.0, Longitude: 135.0}
+	places := []models.Place{
+		{Id: "near", Location: models.GeoLocation{Latitude: 35.003, Longitude: 135.0}},
+		{Id: "far", Location: models.GeoLocation{Latitude: 35.010, Longitude: 135.0}},
+	}
+	config := PipelineConfig{Stages: []StageConfig{{Name: StageNameWithinDistanceRange, MaxDistanceInMeter: 500}}}
+
+	cases := []struct {
+		name     string
+		options  PipelineOptions
+		expected []string
+	}{
+		{
+			name:     "should use distance in config when options does not specify it",
+			options:  PipelineOptions{Location: location},
+			expected: []string{"near"},
+		},
+		{
+			name:     "should prefer distance in options",
+			options:  PipelineOptions{Location: location, MaxDistanceInMeter: 2000},
+			expected: []string{"near", "far"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			pipeline, err := config.Build("test", c.options)
+			if err != nil {
+				t.Fatalf("error while building pipeline: %v", err)
+			}
+
+			placesFiltered, _ := pipeline.Apply(context.Background(), places)
+			actual := make([]string, len(placesFiltered))
+			for i, place := range placesFiltered {
+				actual[i] = place.Id
+			}
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("places mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package placefilter
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"testing"
+)
+
+func TestPipeline_Apply(t *testing.T) {
+	cases := []struct {
+		name              string
+		pipeline          Pipeline
+		places            []models.Place
+		withPlacesDropped bool
+		expectedPlaces    []models.Place
+		expectedResults   []StageResult
+	}{
+		{
+			name: "should apply stages in order and record dropped places",
+			pipeline: NewPipeline(
+				"test",
+				NewDuplicatedStage(),
+				NewStage("not_b", func(place models.Place) bool { return place.Id != "b" }),
+			),
+			places:            []models.Place{{Id: "a"}, {Id: "a"}, {Id: "b"}, {Id: "c"}},
+			withPlacesDropped: true,
+			expectedPlaces:    []models.Place{{Id: "a"}, {Id: "c"}},
+			expectedResults: []StageResult{
+				{Name: StageNameDuplicated, NumPlacesInput: 4, NumPlacesDropped: 1},
+				{Name: "not_b", NumPlacesInput: 3, NumPlacesDropped: 1, PlacesDropped: []models.Place{{Id: "b"}}},
+			},
+		},
+		{
+			name: "should not record dropped places unless requested",
+			pipeline: NewPipeline(
+				"test",
+				NewDuplicatedStage(),
+				NewStage("not_b", func(place models.Place) bool { return place.Id != "b" }),
+			),
+			places:         []models.Place{{Id: "a"}, {Id: "a"}, {Id: "b"}, {Id: "c"}},
+			expectedPlaces: []models.Place{{Id: "a"}, {Id: "c"}},
+			expectedResults: []StageResult{
+				{Name: StageNameDuplicated, NumPlacesInput: 4, NumPlacesDropped: 1},
+				{Name: "not_b", NumPlacesInput: 3, NumPlacesDropped: 1},
+			},
+		},
+		{
+			name:            "should return places as is when pipeline has no stages",
+			pipeline:        NewPipeline("test"),
+			places:          []models.Place{{Id: "a"}},
+			expectedPlaces:  []models.Place{{Id: "a"}},
+			expectedResults: []StageResult{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			if c.withPlacesDropped {
+				ctx = WithPlacesDropped(ctx)
+			}
+
+			actualPlaces, actualResults := c.pipeline.Apply(ctx, c.places)
+			if diff := cmp.Diff(c.expectedPlaces, actualPlaces); diff != "" {
+				t.Errorf("places mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(c.expectedResults, actualResults); diff != "" {
+				t.Errorf("results mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPipeline_Then(t *testing.T) {
+	pipeline := NewPipeline("test", NewDuplicatedStage())
+	pipelineExtended := pipeline.Then(NewHasPhotoStage())
+
+	if diff := cmp.Diff([]string{StageNameDuplicated}, pipeline.StageNames()); diff != "" {
+		t.Errorf("original pipeline should not be changed (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff([]string{StageNameDuplicated, StageNameHasPhoto}, pipelineExtended.StageNames()); diff != "" {
+		t.Errorf("stage names mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPipeline_ApplyWithRecorder(t *testing.T) {
+	ctx, recorder := WithStageResultRecorder(context.Background())
+
+	NewPipeline("first", NewDuplicatedStage()).Apply(ctx, []models.Place{{Id: "a"}, {Id: "a"}})
+	NewPipeline("second").Apply(ctx, []models.Place{{Id: "a"}})
+
+	expected := []PipelineResult{
+		{
+			Pipeline: "first",
+			Stages:   []StageResult{{Name: StageNameDuplicated, NumPlacesInput: 2, NumPlacesDropped: 1}},
+		},
+		{
+			Pipeline: "second",
+			Stages:   []StageResult{},
+		},
+	}
+	if diff := cmp.Diff(expected, recorder.Results()); diff != "" {
+		t.Errorf("recorded results mismatch (-want +got):\n%s", diff)
+	}
+}
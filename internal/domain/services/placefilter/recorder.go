@@ -0,0 +1,53 @@
+package placefilter
+
+import (
+	"context"
+	"sync"
+)
+
+type stageResultRecorderKey struct{}
+
+// PipelineResult はパイプラインを適用したときの各ステージの結果
+type PipelineResult struct {
+	Pipeline string        `json:"pipeline"`
+	Stages   []StageResult `json:"stages"`
+}
+
+// StageResultRecorder はリクエスト中に適用されたパイプラインの結果を記録する
+type StageResultRecorder struct {
+	mu      sync.Mutex
+	results []PipelineResult
+}
+
+// WithStageResultRecorder は ctx に StageResultRecorder を設定する
+// ctx を引き継いで適用されたパイプラインの結果は、返り値の StageResultRecorder に記録される
+func WithStageResultRecorder(ctx context.Context) (context.Context, *StageResultRecorder) {
+	recorder := &StageResultRecorder{}
+	return context.WithValue(ctx, stageResultRecorderKey{}, recorder), recorder
+}
+
+func stageResultRecorderFromContext(ctx context.Context) *StageResultRecorder {
+	if ctx == nil {
+		return nil
+	}
+
+	recorder, ok := ctx.Value(stageResultRecorderKey{}).(*StageResultRecorder)
+	if !ok {
+		return nil
+	}
+	return recorder
+}
+
+func (r *StageResultRecorder) record(pipeline string, stages []StageResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.results = append(r.results, PipelineResult{Pipeline: pipeline, Stages: stages})
+}
+
+func (r *StageResultRecorder) Results() []PipelineResult {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	results := make([]PipelineResult, len(r.results))
+	copy(results, r.results)
+	return results
+}
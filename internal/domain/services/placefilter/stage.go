@@ -0,0 +1,98 @@
+package placefilter
+
+import "poroto.app/poroto/planner/internal/domain/models"
+
+const (
+	StageNameDuplicated          = "duplicated"
+	StageNameIgnoreCategory      = "ignore_category"
+	StageNameCategoryToFilter    = "category_to_filter"
+	StageNameCompany             = "company"
+	StageNameWithinDistanceRange = "within_distance_range"
+	StageNameHasPhoto            = "has_photo"
+	StageNameRating              = "rating"
+	StageNameCategoriesDisliked  = "categories_disliked"
+	StageNameWeather             = "weather"
+	StageNameAlongRoute          = "along_route"
+)
+
+// NewStage は条件を満たす場所のみを残すステージを作成する
+func NewStage(name string, filterFunc func(place models.Place) bool) Stage {
+	return Stage{
+		Name: name,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterPlaces(places, filterFunc)
+		},
+	}
+}
+
+func NewDuplicatedStage() Stage {
+	return Stage{Name: StageNameDuplicated, Filter: FilterDuplicated}
+}
+
+func NewIgnoreCategoryStage() Stage {
+	return Stage{Name: StageNameIgnoreCategory, Filter: FilterIgnoreCategory}
+}
+
+// NewCategoryToFilterStage はプランに含めることのできるカテゴリの場所のみを残す
+func NewCategoryToFilterStage() Stage {
+	return Stage{
+		Name: StageNameCategoryToFilter,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterByCategory(places, models.GetCategoryToFilter(), true)
+		},
+	}
+}
+
+func NewCompanyStage() Stage {
+	return Stage{Name: StageNameCompany, Filter: FilterCompany}
+}
+
+func NewWithinDistanceRangeStage(location models.GeoLocation, maxDistanceInMeter float64) Stage {
+	return Stage{
+		Name: StageNameWithinDistanceRange,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterWithinDistanceRange(places, location, 0, maxDistanceInMeter)
+		},
+	}
+}
+
+func NewHasPhotoStage() Stage {
+	return Stage{Name: StageNameHasPhoto, Filter: FilterByHasPhoto}
+}
+
+func NewRatingStage(lowestRating float32, lowestUserRatingsTotal int) Stage {
+	return Stage{
+		Name: StageNameRating,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterByRating(places, lowestRating, lowestUserRatingsTotal)
+		},
+	}
+}
+
+// NewCategoriesDislikedStage はユーザーが拒否したカテゴリの場所を取り除く
+func NewCategoriesDislikedStage(categories []models.LocationCategory) Stage {
+	return Stage{
+		Name: StageNameCategoriesDisliked,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterByCategory(places, categories, false)
+		},
+	}
+}
+
+func NewWeatherStage(weather *models.WeatherForecast) Stage {
+	return Stage{
+		Name: StageNameWeather,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterByWeather(places, weather)
+		},
+	}
+}
+
+func NewAlongRouteStage(start models.GeoLocation, end models.GeoLocation, maxDetourInMeter float64) Stage {
+	return Stage{
+		Name: StageNameAlongRoute,
+		Filter: func(places []models.Place) []models.Place {
+			return FilterAlongRoute(places, start, end, maxDetourInMeter)
+		},
+	}
+}
@@ -0,0 +1,37 @@
+package placeranking
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// ComputeRankingScores は Google の評価・いいね数・保存されたプランに含まれる回数から
+// すべての場所のスコアを計算し直して保存する
+func (s Service) ComputeRankingScores(ctx context.Context, weights models.PlaceRankingWeights) (int, error) {
+	signals, err := s.placeRepository.FindRankingSignals(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("error while fetching place ranking signals: %v", err)
+	}
+
+	scores := array.Map(signals, func(signal models.PlaceRankingSignals) models.PlaceRankingScore {
+		return signal.RankingScore(weights)
+	})
+
+	if err := s.placeRepository.SaveRankingScores(ctx, scores); err != nil {
+		return 0, fmt.Errorf("error while saving place ranking scores: %v", err)
+	}
+
+	s.logger.Info(
+		"Place ranking scores computed",
+		zap.Int("placesCount", len(scores)),
+		zap.Float64("weightGoogleRating", weights.GoogleRating),
+		zap.Float64("weightLike", weights.Like),
+		zap.Float64("weightSavedPlan", weights.SavedPlan),
+	)
+
+	return len(scores), nil
+}
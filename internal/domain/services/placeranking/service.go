@@ -0,0 +1,18 @@
+package placeranking
+
+import (
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/repository"
+)
+
+type Service struct {
+	placeRepository repository.PlaceRepository
+	logger          *zap.Logger
+}
+
+func NewService(placeRepository repository.PlaceRepository, logger *zap.Logger) *Service {
+	return &Service{
+		placeRepository: placeRepository,
+		logger:          logger.With(zap.String("tag", "PlaceRankingService")),
+	}
+}
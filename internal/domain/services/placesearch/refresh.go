@@ -0,0 +1,81 @@
+package placesearch
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/factory"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/api/google/places"
+)
+
+type RefreshStaleGooglePlacesInput struct {
+	Policy models.GooglePlaceRefreshPolicy
+	// CallBudget は Places API を呼び出してよい最大の回数（1つの場所につき1回呼び出す）
+	CallBudget int
+}
+
+type RefreshStaleGooglePlacesOutput struct {
+	RefreshedCount int
+	FailedCount    int
+}
+
+// RefreshStaleGooglePlaces は保存されてから時間が経った場所の情報を Place Detail API で取得し直して更新する
+// 古い情報を持つ場所の中から、古さと人気（レビュー数）をもとに優先度の高いものを CallBudget 件まで選ぶ
+// 一部の場所の更新に失敗しても、残りの場所の更新は続ける
+func (s Service) RefreshStaleGooglePlaces(ctx context.Context, input RefreshStaleGooglePlacesInput) (*RefreshStaleGooglePlacesOutput, error) {
+	if input.CallBudget <= 0 {
+		return &RefreshStaleGooglePlacesOutput{}, nil
+	}
+
+	now := s.clock.Now()
+	// 優先度の高い順に取得するため、取得した場所をすべて更新する
+	placesToRefresh, err := s.placeRepository.FindStaleGooglePlaces(ctx, input.Policy, now, input.CallBudget)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching stale google places: %v", err)
+	}
+
+	var output RefreshStaleGooglePlacesOutput
+	for _, placeToRefresh := range placesToRefresh {
+		if err := s.refreshGooglePlace(ctx, placeToRefresh.GooglePlaceId); err != nil {
+			s.logger.Warn(
+				"error while refreshing google place",
+				zap.String("googlePlaceId", placeToRefresh.GooglePlaceId),
+				zap.Error(err),
+			)
+			output.FailedCount++
+			continue
+		}
+		output.RefreshedCount++
+	}
+
+	s.logger.Info(
+		"Stale google places refreshed",
+		zap.Int("refreshedCount", output.RefreshedCount),
+		zap.Int("failedCount", output.FailedCount),
+	)
+
+	return &output, nil
+}
+
+func (s Service) refreshGooglePlace(ctx context.Context, googlePlaceId string) error {
+	placeEntity, err := s.placesApi.FetchPlaceDetail(ctx, places.FetchPlaceDetailRequest{
+		PlaceId:  googlePlaceId,
+		Language: "ja",
+	})
+	if err != nil {
+		return fmt.Errorf("error while fetching google place detail: %v", err)
+	}
+
+	if placeEntity == nil {
+		return fmt.Errorf("could not fetch google place detail: %v", googlePlaceId)
+	}
+
+	googlePlace := factory.GooglePlaceFromPlaceEntity(*placeEntity, nil)
+	if err := s.placeRepository.RefreshGooglePlace(ctx, googlePlace, s.clock.Now()); err != nil {
+		return fmt.Errorf("error while refreshing google place: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package placesearch
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+	"googlemaps.github.io/maps"
+	"poroto.app/poroto/planner/internal/domain/factory"
+	"poroto.app/poroto/planner/internal/domain/models"
+	googleplaces "poroto.app/poroto/planner/internal/infrastructure/api/google/places"
+)
+
+// SearchNearbyLodgings location の付近にある宿泊施設を検索し、保存する
+// すでに保存されている宿泊施設がある場合は、検索を行わない
+func (s Service) SearchNearbyLodgings(ctx context.Context, location models.GeoLocation, radius uint) ([]models.Place, error) {
+	lodgingsSaved, err := s.placeRepository.FindByGooglePlaceType(ctx, models.GooglePlaceTypeLodging, location, float64(radius))
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching lodgings from google place type: %w", err)
+	}
+
+	if lodgingsSaved != nil && len(*lodgingsSaved) > 0 {
+		return *lodgingsSaved, nil
+	}
+
+	placeType := maps.PlaceTypeLodging
+	placesSearched, err := s.placesApi.NearbySearch(ctx, &googleplaces.NearbySearchRequest{
+		Location: googleplaces.Location{
+			Latitude:  location.Latitude,
+			Longitude: location.Longitude,
+		},
+		Radius:      radius,
+		Language:    "ja",
+		Type:        &placeType,
+		SearchCount: 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching lodgings: %w", err)
+	}
+
+	s.logger.Info(
+		"successfully fetched nearby lodgings",
+		zap.Uint("radius", radius),
+		zap.Int("places", len(placesSearched)),
+	)
+
+	var googlePlaces []models.GooglePlace
+	for _, place := range placesSearched {
+		googlePlaces = append(googlePlaces, factory.GooglePlaceFromPlaceEntity(place, nil))
+	}
+
+	places, err := s.placeRepository.SavePlacesFromGooglePlaces(ctx, googlePlaces...)
+	if err != nil {
+		return nil, fmt.Errorf("error while saving lodgings from google place: %w", err)
+	}
+
+	if places == nil {
+		return nil, nil
+	}
+
+	return *places, nil
+}
@@ -10,7 +10,6 @@ import (
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	googleplaces "poroto.app/poroto/planner/internal/infrastructure/api/google/places"
-	"time"
 )
 
 // nearbySearchRadius すでに保存された場所から近くにある場所を検索するときの検索範囲
@@ -23,9 +22,18 @@ const (
 // PlanCandidateSetId が指定されており、すでに対応するプラン候補で検索が行われている場合は、検索を行わない
 // これは、付近の特定のカテゴリが無い場合に、すでに検索が行われているのに、再度検索が行われてしまうという状況を防ぐため
 // 例：水族館は30km圏内に存在しない場合は検索が行われるが、検索したことを記録していないと、水族館が30km圏内に存在しない場合にもう一度検索が行われてしまう
+//
+// Radius は保存された場所を取得する範囲（メートル）で、指定されていない場合は nearbySearchRadius を用いる
 type SearchNearbyPlacesInput struct {
 	Location           models.GeoLocation
 	PlanCandidateSetId *string
+	Radius             float64
+}
+
+// NearbySearchRadiusOf 移動手段に応じて、保存された場所を取得する範囲を広げる
+// 自転車や車で移動する場合は、同じ時間で移動できる範囲まで場所を取得する
+func NearbySearchRadiusOf(travelMode models.TravelMode) float64 {
+	return travelMode.ScaleDistance(nearbySearchRadius)
 }
 
 // placeTypeWithCondition 検索する必要のあるカテゴリを表す
@@ -54,7 +62,7 @@ func (s Service) SearchNearbyPlaces(ctx context.Context, input SearchNearbyPlace
 
 	var isAlreadySearched bool
 	if input.PlanCandidateSetId != nil {
-		planCandidateSet, err := s.planCandidateRepository.Find(ctx, *input.PlanCandidateSetId, time.Now())
+		planCandidateSet, err := s.planCandidateRepository.Find(ctx, *input.PlanCandidateSetId, s.clock.Now())
 		if err != nil {
 			// エラーが発生しても処理を続行する
 			s.logger.Warn("error while fetching plan candidate set", zap.Error(err))
@@ -65,8 +73,13 @@ func (s Service) SearchNearbyPlaces(ctx context.Context, input SearchNearbyPlace
 		s.logger.Warn("plan candidate set id is not specified")
 	}
 
+	radius := input.Radius
+	if radius == 0 {
+		radius = nearbySearchRadius
+	}
+
 	// キャッシュされた検索結果を取得
-	placesSaved, err := s.placeRepository.FindByLocation(ctx, input.Location, float64(nearbySearchRadius))
+	placesSaved, err := s.placeRepository.FindByLocation(ctx, input.Location, radius)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching places from location: %w", err)
 	}
@@ -1,49 +1,42 @@
 package placesearch
 
 import (
-	"database/sql"
-	"fmt"
+	"context"
+
 	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/repository"
 	"poroto.app/poroto/planner/internal/domain/utils"
 	"poroto.app/poroto/planner/internal/infrastructure/api/google/places"
-	"poroto.app/poroto/planner/internal/infrastructure/rdb"
 )
 
+// PlacesApi Google Places API の呼び出し
+type PlacesApi interface {
+	NearbySearch(ctx context.Context, req *places.NearbySearchRequest) ([]places.Place, error)
+	FetchPlaceDetail(ctx context.Context, req places.FetchPlaceDetailRequest) (*places.Place, error)
+	FetchPlacePhotos(ctx context.Context, photoReferences []models.GooglePlacePhotoReference, maxPhotoCount int) ([]models.GooglePlacePhoto, error)
+}
+
 type Service struct {
-	placesApi               places.PlacesApi
+	placesApi               PlacesApi
 	placeRepository         repository.PlaceRepository
 	planCandidateRepository repository.PlanCandidateRepository
+	clock                   utils.Clock
 	logger                  *zap.Logger
 }
 
-func NewPlaceSearchService(db *sql.DB) (*Service, error) {
-	placesApi, err := places.NewPlacesApi()
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing places api: %v", err)
-	}
-
-	placeRepository, err := rdb.NewPlaceRepository(db)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing place repository: %v", err)
-	}
-
-	planCandidateRepository, err := rdb.NewPlanCandidateRepository(db)
-	if err != nil {
-		return nil, err
-	}
-
-	logger, err := utils.NewLogger(utils.LoggerOption{
-		Tag: "PlaceService",
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing logger: %v", err)
-	}
-
+func NewService(
+	placesApi PlacesApi,
+	placeRepository repository.PlaceRepository,
+	planCandidateRepository repository.PlanCandidateRepository,
+	clock utils.Clock,
+	logger *zap.Logger,
+) *Service {
 	return &Service{
-		placesApi:               *placesApi,
-		placeRepository:         *placeRepository,
+		placesApi:               placesApi,
+		placeRepository:         placeRepository,
 		planCandidateRepository: planCandidateRepository,
-		logger:                  logger,
-	}, nil
+		clock:                   clock,
+		logger:                  logger.With(zap.String("tag", "PlaceSearchService")),
+	}
 }
@@ -0,0 +1,196 @@
+package plan
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/user"
+)
+
+// EditPlanInput は保存されたプランを編集するための共通の入力
+// Version には編集前に取得したプランのバージョンを指定する
+type EditPlanInput struct {
+	PlanId            string
+	Version           int
+	UserId            string
+	FirebaseAuthToken string
+}
+
+type EditPlanOutput struct {
+	Plan models.Plan
+}
+
+// UpdatePlanTitleAndDescription はプランのタイトルと説明を更新する
+// 以下の編集系のメソッドはいずれも、ユーザーがプランの作者でない場合は apperrors.ErrUnauthorized を返し
+// 他のユーザーによってすでに編集されている場合は apperrors.ErrVersionConflict を返す
+func (s Service) UpdatePlanTitleAndDescription(ctx context.Context, input EditPlanInput, title string, description *string) (*EditPlanOutput, error) {
+	if title == "" {
+		return nil, fmt.Errorf("title must not be empty")
+	}
+
+	if _, err := s.checkPlanEditable(ctx, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.planRepository.UpdateTitleAndDescription(ctx, input.PlanId, input.Version, title, description); err != nil {
+		return nil, planEditError("error while updating title and description of plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// UpdatePlanVisibility はプランの公開範囲を変更する
+func (s Service) UpdatePlanVisibility(ctx context.Context, input EditPlanInput, visibility models.PlanVisibility) (*EditPlanOutput, error) {
+	if _, err := s.checkPlanEditable(ctx, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.planRepository.UpdateVisibility(ctx, input.PlanId, input.Version, visibility); err != nil {
+		return nil, planEditError("error while updating visibility of plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// ChangePlacesOrderInPlan はプランに含まれる場所の順番を変更する
+func (s Service) ChangePlacesOrderInPlan(ctx context.Context, input EditPlanInput, placeIdsOrdered []string) (*EditPlanOutput, error) {
+	if _, err := s.checkPlanEditable(ctx, input); err != nil {
+		return nil, err
+	}
+
+	if err := s.planRepository.UpdatePlacesOrder(ctx, input.PlanId, input.Version, placeIdsOrdered); err != nil {
+		return nil, planEditError("error while updating places order of plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// AddPlaceToPlan はプランの previousPlaceId の後に場所を追加する
+func (s Service) AddPlaceToPlan(ctx context.Context, input EditPlanInput, previousPlaceId string, placeId string) (*EditPlanOutput, error) {
+	plan, err := s.checkPlanEditable(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.GetPlace(placeId) != nil {
+		return nil, fmt.Errorf("place already exists in plan: %v", placeId)
+	}
+
+	placeToAdd, err := s.placeRepository.Find(ctx, placeId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching place: %v", err)
+	}
+
+	if placeToAdd == nil {
+		return nil, fmt.Errorf("place not found: %v", placeId)
+	}
+
+	if err := s.planRepository.AddPlaceToPlan(ctx, input.PlanId, input.Version, previousPlaceId, *placeToAdd); err != nil {
+		return nil, planEditError("error while adding place to plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// RemovePlaceFromPlan はプランから場所を削除する
+// プランに含まれる場所が0になる場合はエラーを返す
+func (s Service) RemovePlaceFromPlan(ctx context.Context, input EditPlanInput, placeId string) (*EditPlanOutput, error) {
+	plan, err := s.checkPlanEditable(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(plan.Places) <= 1 {
+		return nil, fmt.Errorf("plan must have at least one place")
+	}
+
+	if err := s.planRepository.RemovePlaceFromPlan(ctx, input.PlanId, input.Version, placeId); err != nil {
+		return nil, planEditError("error while removing place from plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// ReplacePlaceInPlan はプランに含まれる場所を別の場所に入れ替える
+func (s Service) ReplacePlaceInPlan(ctx context.Context, input EditPlanInput, placeIdToBeReplaced string, placeIdToReplace string) (*EditPlanOutput, error) {
+	plan, err := s.checkPlanEditable(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.GetPlace(placeIdToBeReplaced) == nil {
+		return nil, fmt.Errorf("place to be replaced not found: %v", placeIdToBeReplaced)
+	}
+
+	if plan.GetPlace(placeIdToReplace) != nil {
+		return nil, fmt.Errorf("place to replace already exists: %v", placeIdToReplace)
+	}
+
+	placeToReplace, err := s.placeRepository.Find(ctx, placeIdToReplace)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching place: %v", err)
+	}
+
+	if placeToReplace == nil {
+		return nil, fmt.Errorf("place to replace not found: %v", placeIdToReplace)
+	}
+
+	if err := s.planRepository.ReplacePlace(ctx, input.PlanId, input.Version, placeIdToBeReplaced, *placeToReplace); err != nil {
+		return nil, planEditError("error while replacing place of plan", err)
+	}
+
+	return s.editPlanOutput(ctx, input.PlanId, input.UserId)
+}
+
+// checkPlanEditable はユーザーがプランの作者であり、編集前のプランを取得できることを確認する
+func (s Service) checkPlanEditable(ctx context.Context, input EditPlanInput) (*models.Plan, error) {
+	checkAuthStateResult, err := s.userService.CheckUserAuthState(ctx, user.CheckUserAuthStateInput{
+		UserId:            input.UserId,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while checking user auth state: %v", err)
+	}
+
+	if !checkAuthStateResult.IsAuthenticated {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	plan, err := s.planRepository.Find(ctx, input.PlanId, &input.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan: %v", err)
+	}
+
+	// プランの作者のみがプランを編集できる
+	if !plan.IsAuthoredBy(input.UserId) {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	if plan.Version != input.Version {
+		return nil, apperrors.ErrVersionConflict
+	}
+
+	return plan, nil
+}
+
+func (s Service) editPlanOutput(ctx context.Context, planId string, userId string) (*EditPlanOutput, error) {
+	plan, err := s.planRepository.Find(ctx, planId, &userId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan after editing: %v", err)
+	}
+
+	return &EditPlanOutput{
+		Plan: *plan,
+	}, nil
+}
+
+// planEditError は呼び出し元がバージョンの不一致を判別できるように apperrors.ErrVersionConflict をそのまま返す
+func planEditError(message string, err error) error {
+	if errors.Is(err, apperrors.ErrVersionConflict) {
+		return apperrors.ErrVersionConflict
+	}
+	return fmt.Errorf("%s: %v", message, err)
+}
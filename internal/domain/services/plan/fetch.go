@@ -2,16 +2,30 @@ package plan
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
 
-func (s Service) FetchPlan(ctx context.Context, planId string) (*models.Plan, error) {
+// FetchPlanVisibleTo viewer が閲覧できるプランのみを取得する
+// viewer が nil の場合は未ログインのユーザーとして扱い、存在しない・閲覧できない場合は nil を返す
+func (s Service) FetchPlanVisibleTo(ctx context.Context, planId string, viewer *models.User) (*models.Plan, error) {
 	// TODO: ユーザーとして Like した場所を取得できるようにする
-	plan, err := s.planRepository.Find(ctx, planId)
+	plan, err := s.planRepository.Find(ctx, planId, viewerIdOf(viewer))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	return plan, nil
 }
+
+func viewerIdOf(viewer *models.User) *string {
+	if viewer == nil {
+		return nil
+	}
+	return &viewer.Id
+}
@@ -6,8 +6,12 @@ import (
 	"poroto.app/poroto/planner/internal/domain/models"
 )
 
-func (s Service) PlansByUser(ctx context.Context, userId string) (*[]models.Plan, error) {
-	plans, err := s.planRepository.FindByAuthorId(ctx, userId)
+// PlansByUser ユーザーが作成したプランを取得する
+// viewer がプランの作者本人である場合のみ、限定公開・非公開のプランも含める
+func (s Service) PlansByUser(ctx context.Context, userId string, viewer *models.User) (*[]models.Plan, error) {
+	publicOnly := viewer == nil || viewer.Id != userId
+
+	plans, err := s.planRepository.FindByAuthorId(ctx, userId, publicOnly)
 	if err != nil {
 		return nil, fmt.Errorf("error while finding plans by user: %v", err)
 	}
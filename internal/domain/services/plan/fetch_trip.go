@@ -0,0 +1,22 @@
+package plan
+
+import (
+	"context"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// FetchTrip viewer が閲覧できる日のプランのみを含む旅行を取得する
+// 閲覧できる日が1日もない場合は nil を返す
+func (s Service) FetchTrip(ctx context.Context, tripId string, viewer *models.User) (*models.Trip, error) {
+	trip, err := s.planRepository.FindTrip(ctx, tripId, viewerIdOf(viewer))
+	if err != nil {
+		return nil, err
+	}
+
+	if trip == nil || len(trip.Plans) == 0 {
+		return nil, nil
+	}
+
+	return trip, nil
+}
@@ -42,11 +42,15 @@ func (s Service) LikeToPlace(
 		return nil, fmt.Errorf("error while updating like to place in plan: %v", err)
 	}
 
-	plan, err := s.FetchPlan(ctx, input.PlanId)
+	plan, err := s.FetchPlanVisibleTo(ctx, input.PlanId, &checkAuthStateResult.User)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan after updating: %v", err)
 	}
 
+	if plan == nil {
+		return nil, fmt.Errorf("plan not found")
+	}
+
 	likedPlaces, err := s.placeRepository.FindLikePlacesByUserId(ctx, input.UserId)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching liked places: %v", err)
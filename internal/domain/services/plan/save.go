@@ -7,14 +7,20 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/array"
-	"time"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
 
-func (s Service) SavePlanFromPlanCandidateSet(ctx context.Context, planCandidateSetId string, planId string, authToken *string) (*models.Plan, error) {
+// SavePlanFromPlanCandidateSet プラン候補に含まれるプランを保存する
+// visibility が nil の場合は公開されたプランとして保存する
+func (s Service) SavePlanFromPlanCandidateSet(ctx context.Context, planCandidateSetId string, planId string, authToken *string, visibility *models.PlanVisibility) (*models.Plan, error) {
+	// 作者のいないプランは誰も閲覧できなくなるため、非公開にできない
+	if visibility != nil && *visibility == models.PlanVisibilityPrivate && authToken == nil {
+		return nil, fmt.Errorf("plan without author cannot be private")
+	}
+
 	// プラン候補から対応するプランを取得
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +32,22 @@ func (s Service) SavePlanFromPlanCandidateSet(ctx context.Context, planCandidate
 		return nil, fmt.Errorf("plan(%v) not found in plan candidate(%v)", planId, planCandidateSetId)
 	}
 
+	// ユーザー情報を取得
+	if authToken != nil {
+		user, err := s.userService.FindByFirebaseIdToken(ctx, *authToken)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting user from firebase id token: %v", err)
+		}
+
+		if user == nil {
+			return nil, fmt.Errorf("user not found")
+		}
+
+		planToSave.Author = user
+	}
+
 	// 冪等性を保つために、既存のプランを取得してから保存する
-	planSaved, err := s.planRepository.Find(ctx, planId)
+	planSaved, err := s.planRepository.Find(ctx, planId, viewerIdOf(planToSave.Author))
 	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		// ログに出力するが、エラーは返さない
 		s.logger.Warn(
@@ -38,25 +58,12 @@ func (s Service) SavePlanFromPlanCandidateSet(ctx context.Context, planCandidate
 	}
 
 	if planSaved != nil {
-		s.logger.Debug(
-			"plan already exists. skip saving plan",
-			zap.String("planId", planId),
-		)
-		return planSaved, nil
+		return s.updateVisibilityOfSavedPlan(ctx, *planSaved, planToSave.Author, visibility)
 	}
 
-	// ユーザー情報を取得
-	if authToken != nil {
-		user, err := s.userService.FindByFirebaseIdToken(ctx, *authToken)
-		if err != nil {
-			return nil, fmt.Errorf("error while getting user from firebase id token: %v", err)
-		}
-
-		if user == nil {
-			return nil, fmt.Errorf("user not found")
-		}
-
-		planToSave.Author = user
+	planToSave.Visibility = models.PlanVisibilityPublic
+	if visibility != nil {
+		planToSave.Visibility = *visibility
 	}
 
 	// プランを保存
@@ -66,3 +73,28 @@ func (s Service) SavePlanFromPlanCandidateSet(ctx context.Context, planCandidate
 
 	return &planToSave, nil
 }
+
+// updateVisibilityOfSavedPlan すでに保存されているプランを異なる公開範囲で保存しようとした場合は、公開範囲を更新する
+// 公開範囲を変更できるのはプランの作者のみ
+func (s Service) updateVisibilityOfSavedPlan(ctx context.Context, planSaved models.Plan, author *models.User, visibility *models.PlanVisibility) (*models.Plan, error) {
+	if visibility == nil || *visibility == planSaved.Visibility {
+		s.logger.Debug(
+			"plan already exists. skip saving plan",
+			zap.String("planId", planSaved.Id),
+		)
+		return &planSaved, nil
+	}
+
+	if author == nil || !planSaved.IsAuthoredBy(author.Id) {
+		return nil, fmt.Errorf("plan(%v) is already saved and only the author can change its visibility", planSaved.Id)
+	}
+
+	if err := s.planRepository.UpdateVisibility(ctx, planSaved.Id, planSaved.Version, *visibility); err != nil {
+		return nil, fmt.Errorf("error while updating visibility of saved plan: %v", err)
+	}
+
+	planSaved.Visibility = *visibility
+	planSaved.Version++
+
+	return &planSaved, nil
+}
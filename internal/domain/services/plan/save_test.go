@@ -0,0 +1,115 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/user"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/inmemory"
+)
+
+// fakeFirebaseAuth トークンをそのまま Firebase UID として扱う
+type fakeFirebaseAuth struct{}
+
+func (f fakeFirebaseAuth) Verify(ctx context.Context, firebaseUid string, tokenId string) (bool, error) {
+	return firebaseUid == tokenId, nil
+}
+
+func (f fakeFirebaseAuth) GetFirebaseUIDFromTokenId(ctx context.Context, tokenId string) (*string, error) {
+	return &tokenId, nil
+}
+
+func (f fakeFirebaseAuth) GetUser(ctx context.Context, firebaseUid string) (*firebaseauth.UserRecord, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func TestSavePlanFromPlanCandidateSet_ShouldUpdateVisibilityOfSavedPlan(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+	author := models.User{Id: "author", FirebaseUID: "author-firebase-uid", Name: "author"}
+	anotherUser := models.User{Id: "another-user", FirebaseUID: "another-user-firebase-uid", Name: "another user"}
+
+	cases := []struct {
+		name               string
+		authToken          *string
+		visibility         *models.PlanVisibility
+		expectedVisibility models.PlanVisibility
+		expectedErr        bool
+	}{
+		{
+			name:               "visibility is kept when it is not specified",
+			authToken:          &author.FirebaseUID,
+			visibility:         nil,
+			expectedVisibility: models.PlanVisibilityPublic,
+		},
+		{
+			name:               "author can change visibility by saving again",
+			authToken:          &author.FirebaseUID,
+			visibility:         utils.ToPointer(models.PlanVisibilityPrivate),
+			expectedVisibility: models.PlanVisibilityPrivate,
+		},
+		{
+			name:               "user who is not author cannot change visibility",
+			authToken:          &anotherUser.FirebaseUID,
+			visibility:         utils.ToPointer(models.PlanVisibilityUnlisted),
+			expectedVisibility: models.PlanVisibilityPublic,
+			expectedErr:        true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := inmemory.NewDB()
+			userRepository, _ := inmemory.NewUserRepository(db)
+			placeRepository, _ := inmemory.NewPlaceRepository(db)
+			planRepository, _ := inmemory.NewPlanRepository(db)
+			planCandidateRepository, _ := inmemory.NewPlanCandidateRepository(db)
+
+			for _, u := range []models.User{author, anotherUser} {
+				if err := userRepository.Create(ctx, u); err != nil {
+					t.Fatalf("error while creating user: %v", err)
+				}
+			}
+
+			places, err := placeRepository.SavePlacesFromGooglePlaces(ctx, models.GooglePlace{PlaceId: "google-place", Name: "place"})
+			if err != nil {
+				t.Fatalf("error while saving places: %v", err)
+			}
+
+			if err := planCandidateRepository.Create(ctx, "plan-candidate-set", now.Add(time.Hour)); err != nil {
+				t.Fatalf("error while creating plan candidate set: %v", err)
+			}
+			if err := planCandidateRepository.AddPlan(ctx, "plan-candidate-set", models.Plan{Id: "plan", Name: "plan", Places: *places}); err != nil {
+				t.Fatalf("error while adding plan: %v", err)
+			}
+
+			userService := user.NewService(userRepository, placeRepository, planRepository, planCandidateRepository, fakeFirebaseAuth{})
+			service := NewService(userService, placeRepository, planRepository, planCandidateRepository, utils.FixedClock{Time: now}, zap.NewNop())
+
+			if _, err := service.SavePlanFromPlanCandidateSet(ctx, "plan-candidate-set", "plan", &author.FirebaseUID, nil); err != nil {
+				t.Fatalf("error while saving plan: %v", err)
+			}
+
+			_, err = service.SavePlanFromPlanCandidateSet(ctx, "plan-candidate-set", "plan", c.authToken, c.visibility)
+			if c.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, actual: %v", c.expectedErr, err)
+			}
+
+			planSaved, err := planRepository.Find(ctx, "plan", &author.Id)
+			if err != nil {
+				t.Fatalf("error while finding plan: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedVisibility, planSaved.Visibility); diff != "" {
+				t.Errorf("visibility mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
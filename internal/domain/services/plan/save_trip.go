@@ -0,0 +1,34 @@
+package plan
+
+import (
+	"context"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// SaveTrip 作成された旅行を保存する
+// authToken が指定された場合は、旅行と1日ごとのプランの作者をユーザーに紐づける
+func (s Service) SaveTrip(ctx context.Context, trip models.Trip, authToken *string) (*models.Trip, error) {
+	if authToken != nil {
+		user, err := s.userService.FindByFirebaseIdToken(ctx, *authToken)
+		if err != nil {
+			return nil, fmt.Errorf("error while getting user from firebase id token: %v", err)
+		}
+
+		if user == nil {
+			return nil, fmt.Errorf("user not found")
+		}
+
+		trip.Author = user
+		for i := range trip.Plans {
+			trip.Plans[i].Author = user
+		}
+	}
+
+	if err := s.planRepository.SaveTrip(ctx, trip); err != nil {
+		return nil, fmt.Errorf("error while saving trip: %v", err)
+	}
+
+	return &trip, nil
+}
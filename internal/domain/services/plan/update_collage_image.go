@@ -33,11 +33,15 @@ func (s Service) UpdatePlanCollageImage(ctx context.Context, input UpdatePlanCol
 		return nil, fmt.Errorf("user is not authenticated")
 	}
 
-	plan, err := s.FetchPlan(ctx, input.PlanId)
+	plan, err := s.FetchPlanVisibleTo(ctx, input.PlanId, &checkAuthStateResult.User)
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan after updating: %v", err)
 	}
 
+	if plan == nil {
+		return nil, fmt.Errorf("plan not found")
+	}
+
 	// プランの作者のみがプランの画像を更新できる
 	if plan.Author == nil || plan.Author.Id != input.UserId {
 		return nil, fmt.Errorf("user is not author of the plan")
@@ -5,13 +5,12 @@ import (
 	"fmt"
 	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
-	"time"
 )
 
 // AddPlaceAfterPlace プランに指定された場所を追加する
 // すでに指定された場所が登録されている場合は、なにもしない
 func (s Service) AddPlaceAfterPlace(ctx context.Context, planCandidateSetId string, planId string, previousPlaceId string, placeId string) (*models.Plan, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate: %v", err)
 	}
@@ -78,7 +77,7 @@ func (s Service) AddPlaceAfterPlace(ctx context.Context, planCandidateSetId stri
 		"Fetching plan candidate",
 		zap.String("planCandidateSetId", planCandidateSetId),
 	)
-	planCandidateSet, err = s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err = s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate: %v", err)
 	}
@@ -3,16 +3,41 @@ package plancandidate
 import (
 	"context"
 	"fmt"
+
+	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
 )
 
+// AutoReorderPlacesInput
+// FixLastPlace が true の場合は、最後の場所を入れ替えない
+// ReturnToStart が true の場合は、最後に出発地点へ戻るものとして順番を求める
+// ReturnToStart が false でプラン候補に到着地点が指定されている場合は、最後に到着地点へ向かうものとして順番を求める
 type AutoReorderPlacesInput struct {
 	PlanCandidateSetId string
 	PlanId             string
+	FixLastPlace       bool
+	ReturnToStart      bool
+}
+
+// AutoReorderPlacesOutput
+// TimeSavedInMinutes は並び替えによって短縮されたプランの所要時間（分）
+type AutoReorderPlacesOutput struct {
+	Plan               models.Plan
+	TimeSavedInMinutes int
 }
 
 // AutoReorderPlaces はプラン候補の場所をスタート地点からの移動が最小になるように並び替える
-func (s *Service) AutoReorderPlaces(ctx context.Context, input AutoReorderPlacesInput) (*models.Plan, error) {
+// プラン候補に出発時刻が指定されている場合は、すべての場所に営業時間内に到着できる順番を優先する
+func (s *Service) AutoReorderPlaces(ctx context.Context, input AutoReorderPlacesInput) (*AutoReorderPlacesOutput, error) {
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plan candidate set: %w", err)
+	}
+
+	if planCandidateSet == nil {
+		return nil, fmt.Errorf("plan candidate set not found")
+	}
+
 	plan, err := s.planCandidateRepository.FindPlan(ctx, input.PlanCandidateSetId, input.PlanId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find plan: %w", err)
@@ -22,8 +47,43 @@ func (s *Service) AutoReorderPlaces(ctx context.Context, input AutoReorderPlaces
 		return nil, fmt.Errorf("plan not found")
 	}
 
-	placesReordered := plan.PlacesReorderedToMinimizeDistance()
+	if len(plan.Places) == 0 {
+		return nil, fmt.Errorf("plan has no places")
+	}
+
+	locationStart := planCandidateSet.MetaData.GetLocationStart()
+	travelMode := planCandidateSet.MetaData.GetTravelMode()
+
+	optimizeRouteInput := models.OptimizeRouteInput{
+		Places:        plan.Places,
+		StartLocation: locationStart,
+		FixFirstPlace: true,
+		FixLastPlace:  input.FixLastPlace,
+		StartTime:     planCandidateSet.MetaData.StartTime,
+		TravelMode:    travelMode,
+	}
+
+	if input.ReturnToStart {
+		if locationStart != nil {
+			optimizeRouteInput.EndLocation = locationStart
+		} else {
+			optimizeRouteInput.EndLocation = &plan.Places[0].Location
+		}
+	} else if planCandidateSet.MetaData.LocationEnd != nil {
+		optimizeRouteInput.EndLocation = planCandidateSet.MetaData.LocationEnd
+	}
+
+	placesReordered, ok := models.OptimizeRoute(optimizeRouteInput)
+	if !ok {
+		// 営業時間内にすべての場所を巡れない場合は、移動距離のみを考慮して並び替える
+		optimizeRouteInput.StartTime = nil
+		placesReordered, _ = models.OptimizeRoute(optimizeRouteInput)
+	}
+
+	// 並び替えで考慮した出発地点・到着地点への移動を含めて、経路探索による所要時間を比較する
+	timeInMinutesBefore := s.planTimeInMinutes(ctx, plan.Places, locationStart, optimizeRouteInput.EndLocation, travelMode)
 	plan.Places = placesReordered
+	timeInMinutesAfter := s.planTimeInMinutes(ctx, plan.Places, locationStart, optimizeRouteInput.EndLocation, travelMode)
 
 	var placeIdsOrdered []string
 	for _, place := range placesReordered {
@@ -34,5 +94,27 @@ func (s *Service) AutoReorderPlaces(ctx context.Context, input AutoReorderPlaces
 		return nil, fmt.Errorf("failed to update places order: %v", err)
 	}
 
-	return plan, nil
+	return &AutoReorderPlacesOutput{
+		Plan:               *plan,
+		TimeSavedInMinutes: int(timeInMinutesBefore) - int(timeInMinutesAfter),
+	}, nil
+}
+
+// planTimeInMinutes locationStart から places を順番に巡り、locationEnd が指定されている場合はそこへ向かうまでの所要時間を求める
+// 経路探索に失敗した場合は、直線距離から移動時間を推定する
+func (s *Service) planTimeInMinutes(ctx context.Context, places []models.Place, locationStart *models.GeoLocation, locationEnd *models.GeoLocation, travelMode models.TravelMode) uint {
+	plan := models.Plan{Places: places}
+
+	transitions, err := plan.TransitionsWithRouting(ctx, s.routingProvider, locationStart, travelMode)
+	if err != nil {
+		s.logger.Warn("error while calculating transitions with routing provider", zap.Error(err))
+		transitions = plan.Transitions(locationStart, travelMode)
+	}
+
+	timeInMinutes := plan.TimeInMinutesWithTransitions(transitions)
+	if locationEnd != nil && len(places) > 0 {
+		timeInMinutes += models.CreateTransitionToEndLocation(ctx, s.routingProvider, places, *locationEnd, travelMode).Duration
+	}
+
+	return timeInMinutes
 }
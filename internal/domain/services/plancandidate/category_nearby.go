@@ -3,6 +3,7 @@ package plancandidate
 import (
 	"context"
 	"fmt"
+	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
@@ -55,11 +56,14 @@ func (s Service) CategoriesNearLocation(
 		return nil, fmt.Errorf("error while fetching places: %v\n", err)
 	}
 
-	placesFiltered := placesNearby
-	placesFiltered = placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:        placesFiltered,
-		StartLocation: params.Location,
+	pipeline, err := s.placeFilterPipelineConfig.Build("plancandidate.categories_near_location", placefilter.PipelineOptions{
+		Location: params.Location,
 	})
+	if err != nil {
+		return nil, fmt.Errorf("error while building place filter pipeline: %v", err)
+	}
+	placesFiltered, results := pipeline.Apply(ctx, placesNearby)
+	s.logger.Debug("places after filtering", zap.String("pipeline", pipeline.Name), zap.Any("stages", results))
 
 	// 場所をカテゴリごとにグループ化し、対応する場所の少ないカテゴリから順に写真を取得する
 	placeCategoryGroups := groupPlacesByCategory(placesFiltered)
@@ -10,7 +10,7 @@ func (s Service) CreatePlanCandidateSet(
 	ctx context.Context,
 	planCandidateSetId string,
 ) error {
-	if err := s.planCandidateRepository.Create(ctx, planCandidateSetId, time.Now().Add(7*24*time.Hour)); err != nil {
+	if err := s.planCandidateRepository.Create(ctx, planCandidateSetId, s.clock.Now().Add(7*24*time.Hour)); err != nil {
 		return fmt.Errorf("error while creating plan candidate: %v\n", err)
 	}
 	return nil
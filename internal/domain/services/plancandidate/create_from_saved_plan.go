@@ -2,9 +2,12 @@ package plancandidate
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"github.com/google/uuid"
 	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/user"
 )
 
 type CreatePlanCandidateSetFromSavedPlanInput struct {
@@ -18,14 +21,29 @@ type CreatePlanCandidateSetFromSavedPlanOutput struct {
 }
 
 func (s Service) CreatePlanCandidateSetFromSavedPlan(ctx context.Context, input CreatePlanCandidateSetFromSavedPlanInput) (*CreatePlanCandidateSetFromSavedPlanOutput, error) {
-	plan, err := s.planRepository.Find(ctx, input.PlanId)
-	if err != nil {
-		return nil, fmt.Errorf("error while fetching plan: %v", err)
+	// 非公開のプランは作者のみが複製できるため、認証されたユーザーとして取得する
+	var viewerId *string
+	if input.UserId != nil && input.FirebaseAuthToken != nil {
+		checkAuthResult, err := s.userService.CheckUserAuthState(ctx, user.CheckUserAuthStateInput{
+			UserId:            *input.UserId,
+			FirebaseAuthToken: *input.FirebaseAuthToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error while checking user auth state: %v", err)
+		}
+
+		if checkAuthResult.IsAuthenticated {
+			viewerId = input.UserId
+		}
 	}
 
-	if plan == nil {
+	plan, err := s.planRepository.Find(ctx, input.PlanId, viewerId)
+	if errors.Is(err, sql.ErrNoRows) {
 		return nil, fmt.Errorf("plan not found")
 	}
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan: %v", err)
+	}
 
 	// 保存されるときにもとのプランと別のIDになるようにする
 	copiedPlan := plan
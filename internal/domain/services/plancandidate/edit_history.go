@@ -0,0 +1,83 @@
+package plancandidate
+
+import (
+	"context"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// EditHistoryOutput
+// History は取り消した（やり直した）編集で、Plan はその編集を反映した後のプラン
+// 取り消せる（やり直せる）編集がない場合は、どちらも nil になる
+type EditHistoryOutput struct {
+	History *models.PlanCandidateEditHistory
+	Plan    *models.Plan
+}
+
+// FindEditHistories はプラン候補に対する編集の履歴を編集された順に取得する
+func (s Service) FindEditHistories(ctx context.Context, planCandidateSetId string) (models.PlanCandidateEditHistories, error) {
+	histories, err := s.planCandidateRepository.FindEditHistories(ctx, planCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching edit histories: %v", err)
+	}
+	return histories, nil
+}
+
+// UndoEdit はプラン候補に対する最新の編集を取り消す
+func (s Service) UndoEdit(ctx context.Context, planCandidateSetId string) (*EditHistoryOutput, error) {
+	if err := s.checkPlanCandidateSetExists(ctx, planCandidateSetId); err != nil {
+		return nil, err
+	}
+
+	history, err := s.planCandidateRepository.UndoEdit(ctx, planCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while undoing edit: %v", err)
+	}
+
+	return s.editHistoryOutput(ctx, planCandidateSetId, history)
+}
+
+// RedoEdit は最後に取り消した編集をやり直す
+func (s Service) RedoEdit(ctx context.Context, planCandidateSetId string) (*EditHistoryOutput, error) {
+	if err := s.checkPlanCandidateSetExists(ctx, planCandidateSetId); err != nil {
+		return nil, err
+	}
+
+	history, err := s.planCandidateRepository.RedoEdit(ctx, planCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while redoing edit: %v", err)
+	}
+
+	return s.editHistoryOutput(ctx, planCandidateSetId, history)
+}
+
+// checkPlanCandidateSetExists は有効期限切れのプラン候補を編集しないようにする
+func (s Service) checkPlanCandidateSetExists(ctx context.Context, planCandidateSetId string) error {
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
+	if err != nil {
+		return fmt.Errorf("error while fetching plan candidate: %v", err)
+	}
+
+	if planCandidateSet == nil {
+		return fmt.Errorf("plan candidate not found: %v", planCandidateSetId)
+	}
+
+	return nil
+}
+
+func (s Service) editHistoryOutput(ctx context.Context, planCandidateSetId string, history *models.PlanCandidateEditHistory) (*EditHistoryOutput, error) {
+	if history == nil {
+		return &EditHistoryOutput{}, nil
+	}
+
+	plan, err := s.planCandidateRepository.FindPlan(ctx, planCandidateSetId, history.PlanId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan: %v", err)
+	}
+
+	return &EditHistoryOutput{
+		History: history,
+		Plan:    plan,
+	}, nil
+}
@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/services/user"
-	"time"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
@@ -17,7 +16,7 @@ type FindPlanCandidateSetInput struct {
 }
 
 func (s Service) Find(ctx context.Context, input FindPlanCandidateSetInput) (*models.PlanCandidateSet, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error finding plan candidate: %w", err)
 	}
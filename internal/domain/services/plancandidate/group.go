@@ -0,0 +1,261 @@
+package plancandidate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// defaultGroupParticipantName は表示名が指定されなかったゲストの参加者の名前
+const defaultGroupParticipantName = "ゲスト"
+
+// GroupPlanningOutput
+// WinningPlan は最も投票数の多いプラン（投票がない場合は nil）
+// Participant はグループに参加・作成したときの参加者本人で、それ以外の場合は nil
+type GroupPlanningOutput struct {
+	Group       models.PlanCandidateGroup
+	WinningPlan *models.Plan
+	Participant *models.PlanCandidateGroupParticipant
+}
+
+type StartGroupPlanningInput struct {
+	PlanCandidateSetId string
+	User               *models.User
+	DisplayName        string
+}
+
+// StartGroupPlanning はプラン候補を複数人で選べるようにし、作成者をグループに参加させる
+// すでにグループが作成されている場合は、そのグループに参加する
+func (s Service) StartGroupPlanning(ctx context.Context, input StartGroupPlanningInput) (*GroupPlanningOutput, error) {
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate set: %v", err)
+	}
+
+	if planCandidateSet == nil {
+		return nil, fmt.Errorf("plan candidate set not found: %s", input.PlanCandidateSetId)
+	}
+
+	// 同時に作成された場合も一つのグループだけが作成され、joinGroup で作成されたグループを取得し直す
+	if err := s.planCandidateRepository.CreateGroup(ctx, input.PlanCandidateSetId, uuid.New().String()); err != nil {
+		return nil, fmt.Errorf("error while creating plan candidate group: %v", err)
+	}
+
+	return s.joinGroup(ctx, input.PlanCandidateSetId, input.User, input.DisplayName)
+}
+
+type JoinGroupPlanningInput struct {
+	ShareToken  string
+	User        *models.User
+	DisplayName string
+}
+
+// JoinGroupPlanning は共有用のトークンを用いてグループに参加する
+// ログインしているユーザーがすでに参加している場合は、その参加者として扱う
+func (s Service) JoinGroupPlanning(ctx context.Context, input JoinGroupPlanningInput) (*GroupPlanningOutput, error) {
+	group, err := s.planCandidateRepository.FindGroupByShareToken(ctx, input.ShareToken)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, fmt.Errorf("plan candidate group not found")
+	}
+
+	return s.joinGroup(ctx, group.PlanCandidateSetId, input.User, input.DisplayName)
+}
+
+func (s Service) joinGroup(ctx context.Context, planCandidateSetId string, user *models.User, displayName string) (*GroupPlanningOutput, error) {
+	group, err := s.planCandidateRepository.FindGroup(ctx, planCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, fmt.Errorf("plan candidate group not found: %s", planCandidateSetId)
+	}
+
+	if user != nil {
+		if participant := group.FindParticipantByUserId(user.Id); participant != nil {
+			return s.groupPlanningOutput(ctx, planCandidateSetId, participant)
+		}
+	}
+
+	participant := models.PlanCandidateGroupParticipant{
+		Id:          uuid.New().String(),
+		DisplayName: participantDisplayName(user, displayName),
+		Token:       uuid.New().String(),
+	}
+	if user != nil {
+		participant.UserId = &user.Id
+	}
+
+	if err := s.planCandidateRepository.AddGroupParticipant(ctx, planCandidateSetId, participant); err != nil {
+		return nil, fmt.Errorf("error while adding participant to plan candidate group: %v", err)
+	}
+
+	return s.groupPlanningOutput(ctx, planCandidateSetId, &participant)
+}
+
+type FindGroupInput struct {
+	PlanCandidateSetId string
+	ParticipantToken   *string
+	User               *models.User
+}
+
+// FindGroup はグループの参加者・投票と、最も投票数の多いプランを取得する
+// グループが作成されていない場合は nil を返す
+// 共有用のトークンを含むため、ParticipantToken またはログインしているユーザーが参加者と一致しない場合は apperrors.ErrUnauthorized を返す
+func (s Service) FindGroup(ctx context.Context, input FindGroupInput) (*GroupPlanningOutput, error) {
+	group, err := s.planCandidateRepository.FindGroup(ctx, input.PlanCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, nil
+	}
+
+	var participant *models.PlanCandidateGroupParticipant
+	if input.ParticipantToken != nil {
+		participant = group.FindParticipantByToken(*input.ParticipantToken)
+	}
+	if participant == nil && input.User != nil {
+		participant = group.FindParticipantByUserId(input.User.Id)
+	}
+	if participant == nil {
+		return nil, apperrors.ErrUnauthorized
+	}
+
+	return s.groupPlanningOutput(ctx, input.PlanCandidateSetId, nil)
+}
+
+// FindGroupByShareToken は共有用のトークンに対応するグループを取得する
+// 対応するグループがない場合は nil を返す
+func (s Service) FindGroupByShareToken(ctx context.Context, shareToken string) (*GroupPlanningOutput, error) {
+	group, err := s.planCandidateRepository.FindGroupByShareToken(ctx, shareToken)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, nil
+	}
+
+	return s.groupPlanningOutput(ctx, group.PlanCandidateSetId, nil)
+}
+
+type VoteInGroupInput struct {
+	PlanCandidateSetId string
+	ParticipantToken   string
+	Vote               bool
+}
+
+// VoteToPlanInGroup は参加者としてプランに投票する（Vote が false の場合は投票を取り消す）
+// ParticipantToken に対応する参加者がいない場合は apperrors.ErrUnauthorized を返す
+func (s Service) VoteToPlanInGroup(ctx context.Context, input VoteInGroupInput, planId string) (*GroupPlanningOutput, error) {
+	participant, planCandidateSet, err := s.findParticipantToVote(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if planCandidateSet.GetPlan(planId) == nil {
+		return nil, fmt.Errorf("plan not found in plan candidate set: %s", planId)
+	}
+
+	if err := s.planCandidateRepository.UpdatePlanVoteInGroup(ctx, input.PlanCandidateSetId, participant.Id, planId, input.Vote); err != nil {
+		return nil, fmt.Errorf("error while updating vote to plan: %v", err)
+	}
+
+	return s.groupPlanningOutput(ctx, input.PlanCandidateSetId, nil)
+}
+
+// VoteToPlaceInGroup は参加者として場所に投票する（Vote が false の場合は投票を取り消す）
+// ParticipantToken に対応する参加者がいない場合は apperrors.ErrUnauthorized を返す
+func (s Service) VoteToPlaceInGroup(ctx context.Context, input VoteInGroupInput, placeId string) (*GroupPlanningOutput, error) {
+	participant, planCandidateSet, err := s.findParticipantToVote(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !planCandidateSet.HasPlace(placeId) {
+		return nil, fmt.Errorf("place not found in plan candidate set: %s", placeId)
+	}
+
+	if err := s.planCandidateRepository.UpdatePlaceVoteInGroup(ctx, input.PlanCandidateSetId, participant.Id, placeId, input.Vote); err != nil {
+		return nil, fmt.Errorf("error while updating vote to place: %v", err)
+	}
+
+	return s.groupPlanningOutput(ctx, input.PlanCandidateSetId, nil)
+}
+
+func (s Service) findParticipantToVote(ctx context.Context, input VoteInGroupInput) (*models.PlanCandidateGroupParticipant, *models.PlanCandidateSet, error) {
+	group, err := s.planCandidateRepository.FindGroup(ctx, input.PlanCandidateSetId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, nil, fmt.Errorf("plan candidate group not found: %s", input.PlanCandidateSetId)
+	}
+
+	participant := group.FindParticipantByToken(input.ParticipantToken)
+	if participant == nil {
+		return nil, nil, apperrors.ErrUnauthorized
+	}
+
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, input.PlanCandidateSetId, s.clock.Now())
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while fetching plan candidate set: %v", err)
+	}
+
+	if planCandidateSet == nil {
+		return nil, nil, fmt.Errorf("plan candidate set not found: %s", input.PlanCandidateSetId)
+	}
+
+	return participant, planCandidateSet, nil
+}
+
+func (s Service) groupPlanningOutput(ctx context.Context, planCandidateSetId string, participant *models.PlanCandidateGroupParticipant) (*GroupPlanningOutput, error) {
+	group, err := s.planCandidateRepository.FindGroup(ctx, planCandidateSetId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate group: %v", err)
+	}
+
+	if group == nil {
+		return nil, fmt.Errorf("plan candidate group not found: %s", planCandidateSetId)
+	}
+
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching plan candidate set: %v", err)
+	}
+
+	var winningPlan *models.Plan
+	if planCandidateSet != nil {
+		winningPlan = group.WinningPlan(planCandidateSet.Plans)
+	}
+
+	return &GroupPlanningOutput{
+		Group:       *group,
+		WinningPlan: winningPlan,
+		Participant: participant,
+	}, nil
+}
+
+// participantDisplayName は表示名が指定されていなければ、ユーザー名またはデフォルトの名前を用いる
+func participantDisplayName(user *models.User, displayName string) string {
+	if name := strings.TrimSpace(displayName); name != "" {
+		return name
+	}
+
+	if user != nil && user.Name != "" {
+		return user.Name
+	}
+
+	return defaultGroupParticipantName
+}
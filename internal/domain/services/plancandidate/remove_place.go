@@ -4,14 +4,13 @@ import (
 	"context"
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/models"
-	"time"
 )
 
 // RemovePlaceFromPlan プラン候補から場所を削除する
 // planId に対応するプランが存在しない場合はエラーを返す
 // 指定された場所をプランから除外すると、プランに含まれる場所が0になる場合はエラーを返す
 func (s Service) RemovePlaceFromPlan(ctx context.Context, planCandidateSetId string, planId string, placeId string) (*models.Plan, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while retrieving plan candidate: %v", err)
 	}
@@ -33,7 +32,7 @@ func (s Service) RemovePlaceFromPlan(ctx context.Context, planCandidateSetId str
 	}
 
 	// 更新後のプラン候補を取得
-	planCandidateSet, err = s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err = s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while retrieving plan candidate: %v", err)
 	}
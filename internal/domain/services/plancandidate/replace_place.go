@@ -4,11 +4,10 @@ import (
 	"context"
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/models"
-	"time"
 )
 
 func (s Service) ReplacePlace(ctx context.Context, planCandidateSetId string, planId string, placeIdToBeReplaced string, placeIdToReplace string) (*models.Plan, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate: %v\n", err)
 	}
@@ -42,7 +41,7 @@ func (s Service) ReplacePlace(ctx context.Context, planCandidateSetId string, pl
 		return nil, fmt.Errorf("error while replacing place: %v\n", err)
 	}
 
-	planCandidateSetUpdated, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, time.Now())
+	planCandidateSetUpdated, err := s.planCandidateRepository.Find(ctx, planCandidateSetId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate: %v\n", err)
 	}
@@ -4,15 +4,23 @@ import (
 	"context"
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/models"
+	"time"
 )
 
 type SavePlansInput struct {
 	PlanCandidateSetId           string
 	Plans                        []models.Plan
 	LocationStart                *models.GeoLocation
+	LocationEnd                  *models.GeoLocation
 	CategoryNamesPreferred       *[]string
 	CategoryNamesRejected        *[]string
 	FreeTime                     *int
+	StartTime                    *time.Time
+	TravelMode                   models.TravelMode
+	BudgetMax                    *int
+	NumberOfPeople               *int
+	Weather                      *models.WeatherForecast
+	PromptIntent                 *models.PlanPromptIntent
 	CreateBasedOnCurrentLocation bool
 	CreateByCategoryMetaData     *models.CreateByCategoryMetaData
 }
@@ -51,9 +59,16 @@ func (s Service) SavePlans(ctx context.Context, input SavePlansInput) (err error
 
 	if err := s.planCandidateRepository.UpdatePlanCandidateMetaData(ctx, input.PlanCandidateSetId, models.PlanCandidateMetaData{
 		LocationStart:                 input.LocationStart,
+		LocationEnd:                   input.LocationEnd,
 		CategoriesPreferred:           categoriesPreferred,
 		CategoriesRejected:            categoriesDisliked,
 		FreeTime:                      input.FreeTime,
+		StartTime:                     input.StartTime,
+		TravelMode:                    input.TravelMode,
+		BudgetMax:                     input.BudgetMax,
+		NumberOfPeople:                input.NumberOfPeople,
+		Weather:                       input.Weather,
+		PromptIntent:                  input.PromptIntent,
 		CreatedBasedOnCurrentLocation: input.CreateBasedOnCurrentLocation,
 		CreateByCategoryMetaData:      input.CreateByCategoryMetaData,
 	}); err != nil {
@@ -0,0 +1,75 @@
+package plancandidatecleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultBatchSize   = 100
+	defaultGracePeriod = 24 * time.Hour
+)
+
+type DeleteExpiredInput struct {
+	// GracePeriod は期限が切れてから削除するまでの猶予
+	GracePeriod time.Duration
+	// BatchSize は1回のトランザクションで削除するプラン候補の数
+	BatchSize int
+	// MaxBatches は削除を繰り返す最大の回数（0 の場合は削除できるものがなくなるまで繰り返す）
+	MaxBatches int
+}
+
+type DeleteExpiredOutput struct {
+	DeletedCount int
+	// RetainedCount は期限切れだが、ユーザーに紐付けられている等の理由で削除しなかったプラン候補の数
+	// MaxBatches に達したために削除されずに残ったものは含まない
+	RetainedCount int
+}
+
+func DefaultDeleteExpiredInput() DeleteExpiredInput {
+	return DeleteExpiredInput{
+		GracePeriod: defaultGracePeriod,
+		BatchSize:   defaultBatchSize,
+	}
+}
+
+// DeleteExpiredPlanCandidateSets は期限切れのプラン候補を BatchSize 件ずつ削除する
+// ユーザーに紐付けられたもの・プランとして保存されたものは削除しない
+func (s Service) DeleteExpiredPlanCandidateSets(ctx context.Context, input DeleteExpiredInput) (*DeleteExpiredOutput, error) {
+	var output DeleteExpiredOutput
+	if input.BatchSize <= 0 {
+		return &output, fmt.Errorf("batch size must be positive: %d", input.BatchSize)
+	}
+
+	expiredBefore := s.clock.Now().Add(-input.GracePeriod)
+
+	for batch := 0; input.MaxBatches <= 0 || batch < input.MaxBatches; batch++ {
+		deletedCount, err := s.planCandidateRepository.DeleteExpired(ctx, expiredBefore, input.BatchSize)
+		if err != nil {
+			return &output, fmt.Errorf("error while deleting expired plan candidate sets: %v", err)
+		}
+
+		output.DeletedCount += deletedCount
+		if deletedCount < input.BatchSize {
+			break
+		}
+	}
+
+	retainedCount, err := s.planCandidateRepository.CountExpiredRetained(ctx, expiredBefore)
+	if err != nil {
+		return &output, fmt.Errorf("error while counting retained plan candidate sets: %v", err)
+	}
+	output.RetainedCount = retainedCount
+
+	s.logger.Info(
+		"Expired plan candidate sets deleted",
+		zap.Time("expiredBefore", expiredBefore),
+		zap.Int("deletedCount", output.DeletedCount),
+		zap.Int("retainedCount", output.RetainedCount),
+	)
+
+	return &output, nil
+}
@@ -0,0 +1,94 @@
+package plancandidatecleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/inmemory"
+)
+
+func TestDeleteExpiredPlanCandidateSets(t *testing.T) {
+	now := time.Date(2024, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name              string
+		expiresAt         map[string]time.Time
+		input             DeleteExpiredInput
+		expected          DeleteExpiredOutput
+		expectedRemaining []string
+	}{
+		{
+			name: "plan candidate sets expired before grace period are deleted",
+			expiresAt: map[string]time.Time{
+				"expired":                now.Add(-48 * time.Hour),
+				"expired-in-grace":       now.Add(-1 * time.Hour),
+				"not-expired":            now.Add(time.Hour),
+				"expired-another-batch":  now.Add(-72 * time.Hour),
+				"expired-another-batch2": now.Add(-96 * time.Hour),
+			},
+			input: DeleteExpiredInput{
+				GracePeriod: 24 * time.Hour,
+				BatchSize:   2,
+			},
+			expected: DeleteExpiredOutput{
+				DeletedCount: 3,
+			},
+			expectedRemaining: []string{"not-expired"},
+		},
+		{
+			name: "deletion stops after max batches",
+			expiresAt: map[string]time.Time{
+				"expired-1": now.Add(-48 * time.Hour),
+				"expired-2": now.Add(-72 * time.Hour),
+				"expired-3": now.Add(-96 * time.Hour),
+			},
+			input: DeleteExpiredInput{
+				GracePeriod: 24 * time.Hour,
+				BatchSize:   1,
+				MaxBatches:  2,
+			},
+			expected: DeleteExpiredOutput{
+				DeletedCount: 2,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			planCandidateRepository, err := inmemory.NewPlanCandidateRepository(inmemory.NewDB())
+			if err != nil {
+				t.Fatalf("error while initializing plan candidate repository: %v", err)
+			}
+
+			for planCandidateSetId, expiresAt := range c.expiresAt {
+				if err := planCandidateRepository.Create(context.Background(), planCandidateSetId, expiresAt); err != nil {
+					t.Fatalf("error while creating plan candidate set: %v", err)
+				}
+			}
+
+			service := NewService(planCandidateRepository, utils.FixedClock{Time: now}, zap.NewNop())
+			result, err := service.DeleteExpiredPlanCandidateSets(context.Background(), c.input)
+			if err != nil {
+				t.Fatalf("error while deleting expired plan candidate sets: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, *result); diff != "" {
+				t.Errorf("DeleteExpiredPlanCandidateSets() mismatch (-want +got):\n%s", diff)
+			}
+
+			for _, planCandidateSetId := range c.expectedRemaining {
+				planCandidateSet, err := planCandidateRepository.Find(context.Background(), planCandidateSetId, now)
+				if err != nil {
+					t.Fatalf("error while finding plan candidate set: %v", err)
+				}
+				if planCandidateSet == nil {
+					t.Errorf("expected %s to be retained but not found", planCandidateSetId)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package plancandidatecleanup
+
+import (
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/repository"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+type Service struct {
+	planCandidateRepository repository.PlanCandidateRepository
+	clock                   utils.Clock
+	logger                  *zap.Logger
+}
+
+func NewService(planCandidateRepository repository.PlanCandidateRepository, clock utils.Clock, logger *zap.Logger) *Service {
+	return &Service{
+		planCandidateRepository: planCandidateRepository,
+		clock:                   clock,
+		logger:                  logger.With(zap.String("tag", "PlanCandidateCleanupService")),
+	}
+}
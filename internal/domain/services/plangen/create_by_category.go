@@ -8,11 +8,18 @@ import (
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
 )
 
+// CreatePlanByCategoryInput
+// BudgetMax が指定された場合は、NumberOfPeople 人で巡るときの推定金額が予算に収まるようにプランを作成する
+// Weather で雨が予報されている場合は、起点となる場所以外は屋内の場所を優先する
 type CreatePlanByCategoryInput struct {
 	PlanCandidateSetId string
 	Category           models.LocationCategoryCreatePlan
 	Location           models.GeoLocation
 	RadiusInKm         float64
+	TravelMode         models.TravelMode
+	BudgetMax          *int
+	NumberOfPeople     int
+	Weather            *models.WeatherForecast
 }
 
 func (s Service) CreatePlanByCategory(ctx context.Context, input CreatePlanByCategoryInput) (*[]models.Plan, error) {
@@ -45,6 +52,11 @@ func (s Service) CreatePlanByCategory(ctx context.Context, input CreatePlanByCat
 			break
 		}
 
+		// 起点となる場所だけで予算を超える場合はスキップ
+		if input.BudgetMax != nil && !models.EstimatedBudgetOfPlaces([]models.Place{placeOfCategory}).ForPeople(input.NumberOfPeople).IsWithin(*input.BudgetMax) {
+			continue
+		}
+
 		placesNearby, err := s.placeSearchService.SearchNearbyPlaces(ctx, placesearch.SearchNearbyPlacesInput{
 			Location: placeOfCategory.Location,
 		})
@@ -52,11 +64,15 @@ func (s Service) CreatePlanByCategory(ctx context.Context, input CreatePlanByCat
 			return nil, fmt.Errorf("error while fetching nearby places: %v\n", err)
 		}
 
-		planPlaces, err := s.CreatePlanPlaces(CreatePlanPlacesInput{
+		planPlaces, err := s.CreatePlanPlaces(ctx, CreatePlanPlacesInput{
 			PlanCandidateSetId: input.PlanCandidateSetId,
 			LocationStart:      placeOfCategory.Location,
 			PlaceStart:         placeOfCategory,
 			Places:             placesNearby,
+			TravelMode:         input.TravelMode,
+			BudgetMax:          input.BudgetMax,
+			NumberOfPeople:     input.NumberOfPeople,
+			Weather:            input.Weather,
 			PlacesOtherPlansContain: array.FlatMap(createPlanParams, func(p CreatePlanParams) []models.Place {
 				return p.Places
 			}),
@@ -7,8 +7,10 @@ import (
 	"googlemaps.github.io/maps"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
-	"sort"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"time"
 )
 
 const (
@@ -18,16 +20,31 @@ const (
 // CreatePlanByLocationInput
 // GooglePlaceId が指定された場合は、その場所を起点としてプランを作成する
 // MaxDistanceFromStart は、プランの起点となる場所を選択するときの LocationStart からの最大距離
+// StartTime が指定された場合は、その時刻に出発したときに営業している場所のみでプランを作成する
+// ShouldOpenWhileTraveling が true で StartTime が指定されていない場合は、現在時刻に出発するものとする
+// TravelMode は移動手段を表し、指定しない場合は徒歩とする
+// BudgetMax が指定された場合は、NumberOfPeople 人で巡るときの推定金額が予算に収まるようにプランを作成する
+// Weather で雨が予報されている場合は、屋内の場所を優先してプランを作成する
+// LocationEnd が指定された場合は、LocationStart から LocationEnd へ向かう途中にある場所でプランを作成する
+// UserPreference が指定された場合は、ユーザーの好むカテゴリの場所を優先してプランを作成する
+// CategoryNamesPreferred が指定された場合は、そのカテゴリの場所を起点として優先して選択する
 type CreatePlanByLocationInput struct {
 	PlanCandidateSetId           string
 	LocationStart                models.GeoLocation
+	LocationEnd                  *models.GeoLocation
 	GooglePlaceId                *string
 	CategoryNamesPreferred       *[]string
 	CategoryNamesDisliked        *[]string
 	FreeTime                     *int
+	StartTime                    *time.Time
+	TravelMode                   models.TravelMode
+	BudgetMax                    *int
+	NumberOfPeople               int
+	Weather                      *models.WeatherForecast
 	CreateBasedOnCurrentLocation bool
 	ShouldOpenWhileTraveling     bool
 	MaxDistanceFromStart         int
+	UserPreference               *models.UserPreferenceProfile
 }
 
 // CreatePlanByLocation は指定した位置から近い場所を起点として複数のプランを作成する
@@ -36,15 +53,42 @@ func (s Service) CreatePlanByLocation(ctx context.Context, input CreatePlanByLoc
 		input.MaxDistanceFromStart = defaultMaxDistanceFromStart
 	}
 
+	if !input.TravelMode.IsValid() {
+		input.TravelMode = models.TravelModeWalking
+	}
+
+	if input.StartTime == nil && input.ShouldOpenWhileTraveling {
+		now := s.clock.Now().In(input.LocationStart.TimeZone())
+		input.StartTime = &now
+	}
+
 	// 付近の場所を検索
 	placesNearby, err := s.placeSearchService.SearchNearbyPlaces(ctx, placesearch.SearchNearbyPlacesInput{
 		Location:           input.LocationStart,
 		PlanCandidateSetId: &input.PlanCandidateSetId,
+		Radius:             placesearch.NearbySearchRadiusOf(input.TravelMode),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching google Places: %v\n", err)
 	}
 
+	// 到着地点が指定されている場合は、到着地点へ向かう途中にある場所も検索する
+	if input.LocationEnd != nil {
+		placesAlongRoute, err := s.searchPlacesAlongRoute(ctx, input.PlanCandidateSetId, input.LocationStart, *input.LocationEnd, input.TravelMode)
+		if err != nil {
+			return nil, fmt.Errorf("error while fetching places along route: %v\n", err)
+		}
+		placesNearby = array.DistinctBy(append(placesNearby, placesAlongRoute...), func(place models.Place) string { return place.Id })
+		placesNearby = placefilter.FilterAlongRoute(placesNearby, input.LocationStart, *input.LocationEnd, input.TravelMode.ScaleDistance(maxDetourDistanceToEnd))
+	}
+
+	placesNearby = s.withRankingScores(ctx, placesNearby)
+
+	// ユーザーの好みが分かっている場合は、好みのカテゴリの場所が選ばれやすくなるようにする
+	if input.UserPreference != nil {
+		placesNearby = input.UserPreference.PersonalizePlaces(placesNearby)
+	}
+
 	s.logger.Debug(
 		"Places searched",
 		zap.String("PlanCandidateSetId", input.PlanCandidateSetId),
@@ -67,9 +111,10 @@ func (s Service) CreatePlanByLocation(ctx context.Context, input CreatePlanByLoc
 		}
 
 		if place != nil && array.IsContain(place.Google.Types, string(maps.AutocompletePlaceTypeEstablishment)) {
-			createPlanParam := s.CreatePlan(input, placesNearby, *place, createPlanParams)
+			createPlanParam := s.CreatePlan(traceBasePlace(ctx, *place, nil), input, placesNearby, *place, createPlanParams)
 			if createPlanParam != nil {
 				createPlanParams = append(createPlanParams, *createPlanParam)
+				traceBasePlaceSelected(ctx, place.Id)
 			}
 		}
 	}
@@ -83,17 +128,22 @@ func (s Service) CreatePlanByLocation(ctx context.Context, input CreatePlanByLoc
 			return param.Places
 		})
 
-		placesForPlanStart := s.SelectBasePlace(SelectBasePlaceInput{
-			BaseLocation:      input.LocationStart,
-			Places:            placesNearby,
-			IgnorePlaces:      placesAlreadyAdded,
-			MaxBasePlaceCount: 10,
-			Radius:            filterDistance,
+		placesForPlanStart := s.SelectBasePlace(ctx, SelectBasePlaceInput{
+			BaseLocation:           input.LocationStart,
+			Places:                 placesNearby,
+			IgnorePlaces:           placesAlreadyAdded,
+			CategoryNamesPreferred: input.CategoryNamesPreferred,
+			CategoryNamesDisliked:  input.CategoryNamesDisliked,
+			MaxBasePlaceCount:      10,
+			Radius:                 filterDistance,
+			TravelMode:             input.TravelMode,
+			Weather:                input.Weather,
 		})
 
 		var createPlanParamsInRange []CreatePlanParams
 		for _, placeForPlanStart := range placesForPlanStart {
-			createPlanParam := s.CreatePlan(input, placesNearby, placeForPlanStart, createPlanParams)
+			ctxBasePlace := traceBasePlace(ctx, placeForPlanStart, utils.ToPointer(filterDistance))
+			createPlanParam := s.CreatePlan(ctxBasePlace, input, placesNearby, placeForPlanStart, createPlanParams)
 			if createPlanParam != nil {
 				createPlanParamsInRange = append(createPlanParamsInRange, *createPlanParam)
 			}
@@ -107,13 +157,18 @@ func (s Service) CreatePlanByLocation(ctx context.Context, input CreatePlanByLoc
 			continue
 		}
 
-		// もっとも場所の数が多いプランを追加する
-		sort.SliceStable(createPlanParamsInRange, func(i, j int) bool {
-			return len(createPlanParamsInRange[i].Places) > len(createPlanParamsInRange[j].Places)
-		})
-		createPlanParams = append(createPlanParams, createPlanParamsInRange[0])
+		// 場所の数が多く、すでに追加したプランとカテゴリ・場所・価格帯の傾向が異なるプランを追加する
+		createPlanParamSelected := selectPlanParamForDiversity(createPlanParams, createPlanParamsInRange)
+		createPlanParams = append(createPlanParams, createPlanParamSelected)
+		traceBasePlaceSelected(ctx, createPlanParamSelected.PlaceStart.Id)
 	}
 
+	s.logger.Debug(
+		"plans selected",
+		zap.Int("plansCount", len(createPlanParams)),
+		zap.Any("diversity", CalculatePlanDiversity(createPlanParams)),
+	)
+
 	plans := s.createPlanData(ctx, input.PlanCandidateSetId, createPlanParams...)
 
 	// 場所を指定してプランを作成した場合、その場所を起点としたプランを最初に表示する
@@ -134,6 +189,32 @@ func (s Service) CreatePlanByLocation(ctx context.Context, input CreatePlanByLoc
 	return &plans, nil
 }
 
+// searchPlacesAlongRoute locationStart から locationEnd へ向かう途中にある場所を検索する
+// 到着地点と、出発地点との中間地点の付近を検索する
+func (s Service) searchPlacesAlongRoute(ctx context.Context, planCandidateSetId string, locationStart models.GeoLocation, locationEnd models.GeoLocation, travelMode models.TravelMode) ([]models.Place, error) {
+	var places []models.Place
+	for _, location := range []models.GeoLocation{locationStart.MidpointTo(locationEnd), locationEnd} {
+		placesSearched, err := s.placeSearchService.SearchNearbyPlaces(ctx, placesearch.SearchNearbyPlacesInput{
+			Location:           location,
+			PlanCandidateSetId: &planCandidateSetId,
+			Radius:             placesearch.NearbySearchRadiusOf(travelMode),
+		})
+		if err != nil {
+			return nil, err
+		}
+		places = append(places, placesSearched...)
+	}
+
+	s.logger.Debug(
+		"Places searched along route",
+		zap.Float64("distanceToEnd", locationStart.DistanceInMeter(locationEnd)),
+		zap.String("travelMode", string(travelMode)),
+		zap.Int("placesCount", len(places)),
+	)
+
+	return places, nil
+}
+
 // findOrFetchPlaceById は、googlePlaceId に対応する場所を
 // placesSearched から探し、なければAPIを使って取得する
 func (s Service) findOrFetchPlaceById(ctx context.Context, placesSearched []models.Place, googlePlaceId string) (*models.Place, bool, error) {
@@ -156,19 +237,33 @@ func (s Service) findOrFetchPlaceById(ctx context.Context, placesSearched []mode
 	return place, false, nil
 }
 
-func (s Service) CreatePlan(input CreatePlanByLocationInput, places []models.Place, placeRecommend models.Place, createdPlanParams []CreatePlanParams) *CreatePlanParams {
+func (s Service) CreatePlan(ctx context.Context, input CreatePlanByLocationInput, places []models.Place, placeRecommend models.Place, createdPlanParams []CreatePlanParams) *CreatePlanParams {
 	var placesInPlan []models.Place
 	for _, createPlanParam := range createdPlanParams {
 		placesInPlan = append(placesInPlan, createPlanParam.Places...)
 	}
 
-	planPlaces, err := s.CreatePlanPlaces(CreatePlanPlacesInput{
+	// 現在地から作成する場合は、起点となる場所まで移動してからプランを開始する
+	startTime := input.StartTime
+	if startTime != nil && input.CreateBasedOnCurrentLocation {
+		travelTime := s.travelTime(ctx, input.LocationStart, placeRecommend.Location, input.TravelMode)
+		startTimeAtPlaceStart := startTime.Add(time.Duration(travelTime) * time.Minute)
+		startTime = &startTimeAtPlaceStart
+	}
+
+	planPlaces, err := s.CreatePlanPlaces(ctx, CreatePlanPlacesInput{
 		PlanCandidateSetId:      input.PlanCandidateSetId,
 		LocationStart:           placeRecommend.Location,
+		LocationEnd:             input.LocationEnd,
 		PlaceStart:              placeRecommend,
 		Places:                  places,
 		PlacesOtherPlansContain: placesInPlan,
 		FreeTime:                input.FreeTime,
+		StartTime:               startTime,
+		TravelMode:              input.TravelMode,
+		BudgetMax:               input.BudgetMax,
+		NumberOfPeople:          input.NumberOfPeople,
+		Weather:                 input.Weather,
 		CategoryNamesDisliked:   input.CategoryNamesDisliked,
 	})
 	if err != nil {
@@ -177,9 +272,12 @@ func (s Service) CreatePlan(input CreatePlanByLocationInput, places []models.Pla
 			zap.String("place", placeRecommend.Google.Name),
 			zap.Error(err),
 		)
+		tracePlanFailed(ctx, err)
 		return nil
 	}
 
+	tracePlanCreated(ctx, len(planPlaces))
+
 	return &CreatePlanParams{
 		LocationStart: input.LocationStart,
 		PlaceStart:    placeRecommend,
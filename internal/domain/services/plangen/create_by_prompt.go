@@ -0,0 +1,213 @@
+package plangen
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+const (
+	extractPlanIntentFunctionName = "extract_plan_intent"
+	minFreeTimeOfPromptIntent     = 30
+	maxFreeTimeOfPromptIntent     = 24 * 60
+)
+
+type CreatePlanByPromptInput struct {
+	PlanCandidateSetId           string
+	Prompt                       string
+	Location                     models.GeoLocation
+	TravelMode                   models.TravelMode
+	CreateBasedOnCurrentLocation bool
+	UserPreference               *models.UserPreferenceProfile
+}
+
+// CreatePlanByPromptOutput
+// Weather は抽出された条件をもとに取得した、プランを巡る時間帯の天気予報
+type CreatePlanByPromptOutput struct {
+	Plans   []models.Plan
+	Intent  models.PlanPromptIntent
+	Weather *models.WeatherForecast
+}
+
+// CreatePlanByPrompt は自然文で入力された要望から条件を抽出し、指定した位置を起点としてプランを作成する
+func (s Service) CreatePlanByPrompt(ctx context.Context, input CreatePlanByPromptInput) (*CreatePlanByPromptOutput, error) {
+	intent, err := s.ExtractPlanIntentFromPrompt(ctx, input.Prompt, s.clock.Now().In(input.Location.TimeZone()))
+	if err != nil {
+		return nil, fmt.Errorf("error while extracting plan intent from prompt: %v", err)
+	}
+
+	s.logger.Debug(
+		"extracted plan intent from prompt",
+		zap.String("prompt", intent.Prompt),
+		zap.Strings("categoriesPreferred", intent.CategoriesPreferred),
+		zap.Strings("categoriesDisliked", intent.CategoriesDisliked),
+	)
+
+	weather := s.FetchWeatherForecast(ctx, input.Location, intent.StartTime, intent.FreeTime)
+
+	// 避けたいカテゴリは SubCategory として扱われるため、カテゴリに含まれる SubCategory に展開する
+	categoryNamesDisliked := array.FlatMap(intent.CategoriesDisliked, func(name string) []string {
+		category := models.GetCategoryOfName(name)
+		if category == nil {
+			return nil
+		}
+		return category.SubCategories
+	})
+
+	plans, err := s.CreatePlanByLocation(ctx, CreatePlanByLocationInput{
+		PlanCandidateSetId:           input.PlanCandidateSetId,
+		LocationStart:                input.Location,
+		CategoryNamesPreferred:       &intent.CategoriesPreferred,
+		CategoryNamesDisliked:        &categoryNamesDisliked,
+		FreeTime:                     intent.FreeTime,
+		StartTime:                    intent.StartTime,
+		TravelMode:                   input.TravelMode,
+		BudgetMax:                    intent.BudgetMax,
+		NumberOfPeople:               1,
+		Weather:                      weather,
+		CreateBasedOnCurrentLocation: input.CreateBasedOnCurrentLocation,
+		ShouldOpenWhileTraveling:     true,
+		UserPreference:               input.UserPreference,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatePlanByPromptOutput{
+		Plans:   *plans,
+		Intent:  *intent,
+		Weather: weather,
+	}, nil
+}
+
+// ExtractPlanIntentFromPrompt は自然文で入力されたプランの要望から、プランを作成するための条件を抽出する
+// 抽出された条件のうち、不正な値は無視する
+func (s Service) ExtractPlanIntentFromPrompt(ctx context.Context, prompt string, now time.Time) (*models.PlanPromptIntent, error) {
+	categoryNames := array.Map(models.GetCategoryToFilter(), func(category models.LocationCategory) string { return category.Name })
+
+	response, err := s.chatCompletionClient.Complete(ctx, models.ChatCompletionRequest{
+		Messages: []models.ChatMessage{
+			{
+				Role: models.ChatMessageRoleSystem,
+				Content: "あなたはおでかけプランの要望から条件を抽出するアシスタントです。\n" +
+					"要望に含まれていない条件は指定しないでください。\n" +
+					fmt.Sprintf("現在時刻: %s", now.Format(time.RFC3339)),
+			},
+			{
+				Role:    models.ChatMessageRoleUser,
+				Content: prompt,
+			},
+		},
+		Tool: &models.ChatCompletionTool{
+			Name:        extractPlanIntentFunctionName,
+			Description: "おでかけプランの要望から条件を抽出する",
+			Parameters:  planIntentParameters(categoryNames),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(response.Choices) == 0 {
+		return nil, fmt.Errorf("response.Choices is empty")
+	}
+
+	toolCall, found := array.Find(response.Choices[0].ToolCalls, func(toolCall models.ChatCompletionToolCall) bool {
+		return toolCall.Name == extractPlanIntentFunctionName
+	})
+	if !found {
+		return nil, fmt.Errorf("function %s was not called", extractPlanIntentFunctionName)
+	}
+
+	return parsePlanIntent(prompt, toolCall.Arguments, now)
+}
+
+// planIntentParameters は条件を抽出する関数の引数を表す JSON Schema
+func planIntentParameters(categoryNames []string) map[string]interface{} {
+	categories := map[string]interface{}{
+		"type":  "array",
+		"items": map[string]interface{}{"type": "string", "enum": categoryNames},
+	}
+	return map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"categories_preferred": withDescription(categories, "行きたい場所のカテゴリ"),
+			"categories_disliked":  withDescription(categories, "行きたくない場所のカテゴリ"),
+			"free_time_in_minutes": map[string]interface{}{"type": "integer", "description": "プランにかけられる時間（分）"},
+			"budget_max":           map[string]interface{}{"type": "integer", "description": "一人あたりの予算の上限（円）"},
+			"start_time":           map[string]interface{}{"type": "string", "description": "出発時刻（RFC3339形式）"},
+		},
+	}
+}
+
+func withDescription(schema map[string]interface{}, description string) map[string]interface{} {
+	s := make(map[string]interface{}, len(schema)+1)
+	for k, v := range schema {
+		s[k] = v
+	}
+	s["description"] = description
+	return s
+}
+
+type planIntentArguments struct {
+	CategoriesPreferred []string `json:"categories_preferred"`
+	CategoriesDisliked  []string `json:"categories_disliked"`
+	FreeTimeInMinutes   *int     `json:"free_time_in_minutes"`
+	BudgetMax           *int     `json:"budget_max"`
+	StartTime           *string  `json:"start_time"`
+}
+
+// parsePlanIntent は関数の引数として抽出された条件を検証する
+// 存在しないカテゴリや範囲外の値、過去の出発時刻は無視する
+// 行きたい場所と行きたくない場所の両方に含まれるカテゴリは、行きたい場所として扱う
+func parsePlanIntent(prompt string, arguments string, now time.Time) (*models.PlanPromptIntent, error) {
+	var args planIntentArguments
+	if err := json.Unmarshal([]byte(arguments), &args); err != nil {
+		return nil, fmt.Errorf("error while unmarshalling function arguments: %v", err)
+	}
+
+	categoriesPreferred := validCategoryNames(args.CategoriesPreferred)
+	categoriesDisliked := validCategoryNames(array.Filter(args.CategoriesDisliked, func(name string) bool {
+		return !array.IsContain(categoriesPreferred, name)
+	}))
+
+	intent := models.PlanPromptIntent{
+		Prompt:              prompt,
+		CategoriesPreferred: categoriesPreferred,
+		CategoriesDisliked:  categoriesDisliked,
+	}
+
+	if args.FreeTimeInMinutes != nil && *args.FreeTimeInMinutes >= minFreeTimeOfPromptIntent && *args.FreeTimeInMinutes <= maxFreeTimeOfPromptIntent {
+		intent.FreeTime = args.FreeTimeInMinutes
+	}
+
+	if args.BudgetMax != nil && *args.BudgetMax > 0 {
+		intent.BudgetMax = args.BudgetMax
+	}
+
+	if args.StartTime != nil {
+		startTime, err := time.Parse(time.RFC3339, *args.StartTime)
+		if err == nil && !startTime.Before(now) {
+			intent.StartTime = &startTime
+		}
+	}
+
+	return &intent, nil
+}
+
+func validCategoryNames(names []string) []string {
+	validNames := make([]string, 0, len(names))
+	for _, name := range names {
+		category := models.GetCategoryOfName(name)
+		if category == nil || array.IsContain(validNames, category.Name) {
+			continue
+		}
+		validNames = append(validNames, category.Name)
+	}
+	return validNames
+}
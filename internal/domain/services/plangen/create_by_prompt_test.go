@@ -0,0 +1,260 @@
+package plangen
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
+	"poroto.app/poroto/planner/internal/domain/services/placesearch"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	googleplaces "poroto.app/poroto/planner/internal/infrastructure/api/google/places"
+	"poroto.app/poroto/planner/internal/infrastructure/inmemory"
+	"testing"
+	"time"
+)
+
+func TestParsePlanIntent(t *testing.T) {
+	now := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name      string
+		arguments string
+		expected  models.PlanPromptIntent
+	}{
+		{
+			name:      "all conditions are extracted",
+			arguments: `{"categories_preferred":["cafe","park"],"categories_disliked":["shopping"],"free_time_in_minutes":180,"budget_max":3000,"start_time":"2024-07-01T13:00:00Z"}`,
+			expected: models.PlanPromptIntent{
+				Prompt:              "prompt",
+				CategoriesPreferred: []string{"cafe", "park"},
+				CategoriesDisliked:  []string{"shopping"},
+				FreeTime:            utils.ToPointer(180),
+				BudgetMax:           utils.ToPointer(3000),
+				StartTime:           utils.ToPointer(time.Date(2024, 7, 1, 13, 0, 0, 0, time.UTC)),
+			},
+		},
+		{
+			name:      "no conditions are extracted",
+			arguments: `{}`,
+			expected: models.PlanPromptIntent{
+				Prompt:              "prompt",
+				CategoriesPreferred: []string{},
+				CategoriesDisliked:  []string{},
+			},
+		},
+		{
+			name:      "unknown and duplicated categories are ignored",
+			arguments: `{"categories_preferred":["cafe","unknown","cafe"],"categories_disliked":["cafe","spa"]}`,
+			expected: models.PlanPromptIntent{
+				Prompt:              "prompt",
+				CategoriesPreferred: []string{"cafe"},
+				CategoriesDisliked:  []string{"spa"},
+			},
+		},
+		{
+			name:      "out of range values are ignored",
+			arguments: `{"free_time_in_minutes":10,"budget_max":-1,"start_time":"2024-07-01T08:00:00Z"}`,
+			expected: models.PlanPromptIntent{
+				Prompt:              "prompt",
+				CategoriesPreferred: []string{},
+				CategoriesDisliked:  []string{},
+			},
+		},
+		{
+			name:      "start time in invalid format is ignored",
+			arguments: `{"start_time":"13:00"}`,
+			expected: models.PlanPromptIntent{
+				Prompt:              "prompt",
+				CategoriesPreferred: []string{},
+				CategoriesDisliked:  []string{},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := parsePlanIntent("prompt", c.arguments, now)
+			if err != nil {
+				t.Fatalf("error while parsing plan intent: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, *actual); diff != "" {
+				t.Errorf("parsePlanIntent() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParsePlanIntent_InvalidArguments(t *testing.T) {
+	if _, err := parsePlanIntent("prompt", `not json`, time.Now()); err == nil {
+		t.Errorf("expected error for invalid arguments")
+	}
+}
+
+// fakeChatCompletionClient 条件の抽出には arguments を関数の引数として返し、タイトルの生成には固定の文章を返す
+type fakeChatCompletionClient struct {
+	arguments string
+	requests  *[]models.ChatCompletionRequest
+}
+
+func (f fakeChatCompletionClient) Complete(ctx context.Context, request models.ChatCompletionRequest) (*models.ChatCompletionResponse, error) {
+	*f.requests = append(*f.requests, request)
+
+	if request.Tool != nil {
+		return &models.ChatCompletionResponse{
+			Choices: []models.ChatCompletionChoice{
+				{
+					ToolCalls: []models.ChatCompletionToolCall{
+						{Name: extractPlanIntentFunctionName, Arguments: f.arguments},
+					},
+				},
+			},
+		}, nil
+	}
+
+	return &models.ChatCompletionResponse{
+		Choices: []models.ChatCompletionChoice{
+			{Content: "プランのタイトル"},
+		},
+	}, nil
+}
+
+// fakePlacesApi 保存済みの場所のみでプランを作成するため、検索結果を返さない
+type fakePlacesApi struct{}
+
+func (f fakePlacesApi) NearbySearch(ctx context.Context, req *googleplaces.NearbySearchRequest) ([]googleplaces.Place, error) {
+	return nil, nil
+}
+
+func (f fakePlacesApi) FetchPlaceDetail(ctx context.Context, req googleplaces.FetchPlaceDetailRequest) (*googleplaces.Place, error) {
+	return nil, nil
+}
+
+func (f fakePlacesApi) FetchPlacePhotos(ctx context.Context, photoReferences []models.GooglePlacePhotoReference, maxPhotoCount int) ([]models.GooglePlacePhoto, error) {
+	return nil, nil
+}
+
+func TestCreatePlanByPrompt(t *testing.T) {
+	now := time.Date(2024, 7, 1, 9, 0, 0, 0, time.UTC)
+
+	// 東京駅
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+	newGooglePlace := func(placeId string, placeType string, latitude float64, rating float32, userRatingsTotal int) models.GooglePlace {
+		return models.GooglePlace{
+			PlaceId:          placeId,
+			Name:             placeId,
+			Types:            []string{placeType},
+			Location:         models.GeoLocation{Latitude: latitude, Longitude: location.Longitude},
+			PhotoReferences:  []models.GooglePlacePhotoReference{{PhotoReference: placeId}},
+			Rating:           rating,
+			UserRatingsTotal: userRatingsTotal,
+			PlaceDetail: &models.GooglePlaceDetail{
+				OpeningHours: &models.GooglePlaceOpeningHours{
+					Periods: []models.GooglePlaceOpeningPeriod{
+						{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "0000", ClosingTime: "2359"},
+					},
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		name                   string
+		arguments              string
+		expectedIntent         models.PlanPromptIntent
+		expectedFirstBasePlace string
+	}{
+		{
+			name:      "base place is selected from preferred category",
+			arguments: `{"categories_preferred":["cafe"]}`,
+			expectedIntent: models.PlanPromptIntent{
+				Prompt:              "カフェでゆっくりしたい",
+				CategoriesPreferred: []string{"cafe"},
+				CategoriesDisliked:  []string{},
+			},
+			expectedFirstBasePlace: "cafe",
+		},
+		{
+			name:      "base place of highest score is selected without preferred category",
+			arguments: `{}`,
+			expectedIntent: models.PlanPromptIntent{
+				Prompt:              "カフェでゆっくりしたい",
+				CategoriesPreferred: []string{},
+				CategoriesDisliked:  []string{},
+			},
+			expectedFirstBasePlace: "restaurant",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx := context.Background()
+			db := inmemory.NewDB()
+			placeRepository, _ := inmemory.NewPlaceRepository(db)
+			planRepository, _ := inmemory.NewPlanRepository(db)
+			planCandidateRepository, _ := inmemory.NewPlanCandidateRepository(db)
+
+			// 互いに 500m 以内にあるため、起点となる場所はどちらか一方のみが選ばれる
+			if _, err := placeRepository.SavePlacesFromGooglePlaces(
+				ctx,
+				newGooglePlace("cafe", "cafe", location.Latitude+0.001, 3.5, 20),
+				newGooglePlace("restaurant", "restaurant", location.Latitude+0.002, 4.8, 500),
+			); err != nil {
+				t.Fatalf("error while saving places: %v", err)
+			}
+
+			if err := planCandidateRepository.Create(ctx, "plan-candidate-set", now.Add(time.Hour)); err != nil {
+				t.Fatalf("error while creating plan candidate set: %v", err)
+			}
+
+			var requests []models.ChatCompletionRequest
+			clock := utils.FixedClock{Time: now}
+			placeSearchService := placesearch.NewService(fakePlacesApi{}, placeRepository, planCandidateRepository, clock, zap.NewNop())
+			service := NewService(
+				placeSearchService,
+				placeRepository,
+				planCandidateRepository,
+				planRepository,
+				fakeChatCompletionClient{arguments: c.arguments, requests: &requests},
+				models.HaversineRoutingProvider{},
+				models.UnknownWeatherProvider{},
+				placefilter.DefaultPipelineConfig(),
+				clock,
+				zap.NewNop(),
+			)
+
+			ctxCreatePlan, tracer := WithGenerationTracer(ctx)
+			output, err := service.CreatePlanByPrompt(ctxCreatePlan, CreatePlanByPromptInput{
+				PlanCandidateSetId: "plan-candidate-set",
+				Prompt:             "カフェでゆっくりしたい",
+				Location:           location,
+			})
+			if err != nil {
+				t.Fatalf("error while creating plan by prompt: %v", err)
+			}
+
+			if len(requests) == 0 || requests[0].Messages[len(requests[0].Messages)-1].Content != "カフェでゆっくりしたい" {
+				t.Errorf("prompt should be sent to chat completion client")
+			}
+
+			if diff := cmp.Diff(c.expectedIntent, output.Intent); diff != "" {
+				t.Errorf("intent mismatch (-want +got):\n%s", diff)
+			}
+
+			if len(output.Plans) == 0 {
+				t.Fatalf("plans should be created")
+			}
+
+			// 最も狭い検索範囲で最初に選ばれた起点となる場所
+			basePlaces := tracer.Trace().BasePlaces
+			if len(basePlaces) == 0 {
+				t.Fatalf("base places should be traced")
+			}
+
+			if diff := cmp.Diff(c.expectedFirstBasePlace, basePlaces[0].PlaceName); diff != "" {
+				t.Errorf("first base place mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
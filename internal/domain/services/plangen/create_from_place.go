@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"poroto.app/poroto/planner/internal/domain/services/placesearch"
-	"time"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
@@ -15,7 +14,7 @@ func (s Service) CreatePlanFromPlace(
 	createPlanSessionId string,
 	placeId string,
 ) (*models.Plan, error) {
-	planCandidateSet, err := s.planCandidateRepository.Find(ctx, createPlanSessionId, time.Now())
+	planCandidateSet, err := s.planCandidateRepository.Find(ctx, createPlanSessionId, s.clock.Now())
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan candidate")
 	}
@@ -32,6 +31,7 @@ func (s Service) CreatePlanFromPlace(
 	placesNearby, err := s.placeSearchService.SearchNearbyPlaces(ctx, placesearch.SearchNearbyPlacesInput{
 		Location:           placeStart.Location,
 		PlanCandidateSetId: &createPlanSessionId,
+		Radius:             placesearch.NearbySearchRadiusOf(planCandidateSet.MetaData.GetTravelMode()),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching nearby places")
@@ -45,13 +45,19 @@ func (s Service) CreatePlanFromPlace(
 	}
 
 	// TODO: ユーザーの興味等を保存しておいて、それを反映させる
-	planPlaces, err := s.CreatePlanPlaces(CreatePlanPlacesInput{
+	planPlaces, err := s.CreatePlanPlaces(ctx, CreatePlanPlacesInput{
 		PlanCandidateSetId:    createPlanSessionId,
 		LocationStart:         placeStart.Location,
+		LocationEnd:           planCandidateSet.MetaData.LocationEnd,
 		PlaceStart:            *placeStart,
 		Places:                placesNearby,
 		CategoryNamesDisliked: &categoryNamesRejected,
 		FreeTime:              planCandidateSet.MetaData.FreeTime,
+		StartTime:             planCandidateSet.MetaData.StartTime,
+		TravelMode:            planCandidateSet.MetaData.GetTravelMode(),
+		BudgetMax:             planCandidateSet.MetaData.BudgetMax,
+		NumberOfPeople:        planCandidateSet.MetaData.GetNumberOfPeople(),
+		Weather:               planCandidateSet.MetaData.Weather,
 	})
 	if err != nil {
 		return nil, err
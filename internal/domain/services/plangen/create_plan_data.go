@@ -37,7 +37,7 @@ func (s Service) createPlanData(ctx context.Context, planCandidateSetId string,
 			chPlanTitle := make(chan string, 1)
 			go func(ctx context.Context, chPlanTitle chan<- string) {
 				performanceTimer := time.Now()
-				title, err := s.GeneratePlanTitle(placesSortedByDistance)
+				title, err := s.GeneratePlanTitle(ctx, placesSortedByDistance)
 				if err != nil {
 					s.logger.Warn(
 						"error while generating plan title",
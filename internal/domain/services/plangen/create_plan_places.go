@@ -1,11 +1,13 @@
 package plangen
 
 import (
+	"context"
 	"fmt"
 	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/placefilter"
+	"time"
 )
 
 const (
@@ -13,21 +15,38 @@ const (
 	defaultMaxPlaceInPlan  = 4
 
 	placeDistanceRangeInPlan = 500 // 徒歩5分以内
+
+	// maxDetourDistanceToEnd 到着地点へ向かう途中で立ち寄る場所として、遠回りしてもよい距離（徒歩の場合）
+	maxDetourDistanceToEnd = 1000
+
+	stageNameNotInOtherPlans = "not_in_other_plans"
 )
 
+// CreatePlanPlacesInput
+// StartTime が指定された場合は、各場所に到着する時刻に営業している場所のみでプランを作成する
+// TravelMode は移動手段を表し、場所の検索範囲と移動時間の算出に用いる（指定しない場合は徒歩）
+// BudgetMax が指定された場合は、NumberOfPeople 人で巡るときの推定金額が予算に収まる場所のみでプランを作成する
+// Weather で雨が予報されている場合は、屋内の場所を優先してプランを作成する
+// LocationEnd が指定された場合は、到着地点へ向かう途中にある場所でプランを作成し、到着地点までの移動時間も所要時間に含める
 type CreatePlanPlacesInput struct {
 	PlanCandidateSetId      string
 	LocationStart           models.GeoLocation
+	LocationEnd             *models.GeoLocation
 	PlaceStart              models.Place
 	Places                  []models.Place
 	PlacesOtherPlansContain []models.Place
 	CategoryNamesDisliked   *[]string
 	FreeTime                *int
+	StartTime               *time.Time
+	TravelMode              models.TravelMode
+	BudgetMax               *int
+	NumberOfPeople          int
+	Weather                 *models.WeatherForecast
 	MaxPlace                int
 }
 
 // CreatePlanPlaces プランの候補地となる場所を作成する
-func (s Service) CreatePlanPlaces(input CreatePlanPlacesInput) ([]models.Place, error) {
+func (s Service) CreatePlanPlaces(ctx context.Context, input CreatePlanPlacesInput) ([]models.Place, error) {
 	if input.PlanCandidateSetId == "" {
 		panic("PlanCandidateSetId is required")
 	}
@@ -36,6 +55,10 @@ func (s Service) CreatePlanPlaces(input CreatePlanPlacesInput) ([]models.Place,
 		input.MaxPlace = defaultMaxPlaceInPlan
 	}
 
+	if !input.TravelMode.IsValid() {
+		input.TravelMode = models.TravelModeWalking
+	}
+
 	/**
 	* プラン作成の方針
 	* 1. スタート地点から近い場所の中で、レビューの高い場所を選択
@@ -44,9 +67,22 @@ func (s Service) CreatePlanPlaces(input CreatePlanPlacesInput) ([]models.Place,
 	 */
 	placesInPlan := make([]models.Place, 0)
 	placesInPlan = append(placesInPlan, input.PlaceStart)
+
+	// 出発時刻が指定されている場合、起点となる場所が営業していなければプランを作成しない
+	if input.StartTime != nil {
+		if _, ok := s.sortPlacesToOpenAtArrival(ctx, input.LocationStart, *input.StartTime, placesInPlan, input.TravelMode); !ok {
+			return nil, fmt.Errorf("place start is not opening at %s", input.StartTime.Format(time.RFC3339))
+		}
+	}
+
+	// 予算が指定されている場合、起点となる場所だけで予算を超えるならプランを作成しない
+	if input.BudgetMax != nil && !isWithinBudget(placesInPlan, input) {
+		return nil, fmt.Errorf("place start is over budget %d", *input.BudgetMax)
+	}
+
 	for len(placesInPlan) < input.MaxPlace {
 		prevPlace := placesInPlan[len(placesInPlan)-1]
-		nextPlace := s.getNextPlaceForPlan(prevPlace, placesInPlan, input, placeDistanceRangeInPlan)
+		nextPlace := s.getNextPlaceForPlan(ctx, prevPlace, placesInPlan, input, placeDistanceRangeInPlan)
 		if nextPlace == nil {
 			break
 		}
@@ -57,27 +93,56 @@ func (s Service) CreatePlanPlaces(input CreatePlanPlacesInput) ([]models.Place,
 		return nil, fmt.Errorf("could not contain any Places in plan")
 	}
 
+	// 食事の時間帯をまたぐプランに飲食店が含まれていない場合は、飲食店を追加する
+	if needsMeal(placesInPlan, input) {
+		placesInPlan = s.insertMeal(ctx, placesInPlan, input)
+	}
+
+	// 到着地点が指定されている場合は、到着地点へ向かう順番に並び替える
+	if input.LocationEnd != nil {
+		placesInPlan = sortPlacesToEndLocation(placesInPlan, *input.LocationEnd)
+	}
+
+	// 到着時刻に営業しており、飲食店・カフェには適した時間帯に到着するように場所を並び替える
+	if input.StartTime != nil {
+		if placesOrdered, ok := s.sortPlacesToArriveAtMealTime(ctx, input.LocationStart, input.LocationEnd, *input.StartTime, placesInPlan, input.TravelMode); ok {
+			placesInPlan = placesOrdered
+		}
+	}
+
 	return placesInPlan, nil
 }
 
-func (s Service) getNextPlaceForPlan(prevPlace models.Place, placesInPlan []models.Place, input CreatePlanPlacesInput, placeDistanceRangeInPlan float64) *models.Place {
+func (s Service) getNextPlaceForPlan(ctx context.Context, prevPlace models.Place, placesInPlan []models.Place, input CreatePlanPlacesInput, placeDistanceRangeInPlan float64) *models.Place {
 	// 最後に追加した場所から近い場所を選択
-	placesFiltered := placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:              input.Places,
-		StartLocation:       prevPlace.Location,
-		IgnoreDistanceRange: placeDistanceRangeInPlan,
-	})
-	s.logger.Debug("Places after filtering by default ignore", zap.Int("Places", len(placesFiltered)))
+	// 到着地点が指定されている場合は、到着地点へ向かう途中にある場所のみを残す
+	// 雨が予報されている場合は、屋外の場所を除外する
+	options := placefilter.PipelineOptions{
+		Location:           prevPlace.Location,
+		MaxDistanceInMeter: input.TravelMode.ScaleDistance(placeDistanceRangeInPlan),
+		Weather:            input.Weather,
+	}
+	if input.LocationEnd != nil {
+		options.AlongRoute = &placefilter.AlongRouteOptions{
+			Start:            input.LocationStart,
+			End:              *input.LocationEnd,
+			MaxDetourInMeter: input.TravelMode.ScaleDistance(maxDetourDistanceToEnd),
+		}
+	}
 
 	// ユーザーが拒否した場所は取り除く
 	if input.CategoryNamesDisliked != nil {
-		categoriesDisliked := models.GetCategoriesFromSubCategories(*input.CategoryNamesDisliked)
-		placesFiltered = placefilter.FilterByCategory(placesFiltered, categoriesDisliked, false)
-		s.logger.Debug("Places after filtering by disliked categories", zap.Int("Places", len(placesFiltered)))
+		options.CategoriesDisliked = models.GetCategoriesFromSubCategories(*input.CategoryNamesDisliked)
 	}
 
 	// 他のプランに含まれている場所を除外する
-	placesFiltered = placefilter.FilterPlaces(placesFiltered, func(place models.Place) bool {
+	pipeline, err := s.placeFilterPipelineConfig.Build("plangen.next_place", options)
+	if err != nil {
+		s.logger.Error("error while building place filter pipeline", zap.Error(err))
+		return nil
+	}
+
+	pipeline = pipeline.Then(placefilter.NewStage(stageNameNotInOtherPlans, func(place models.Place) bool {
 		if input.PlacesOtherPlansContain == nil {
 			return true
 		}
@@ -88,16 +153,21 @@ func (s Service) getNextPlaceForPlan(prevPlace models.Place, placesInPlan []mode
 			}
 		}
 		return true
+	}))
+	placesFiltered, results := pipeline.Apply(ctx, input.Places)
+	s.logger.Debug("places after filtering", zap.String("pipeline", pipeline.Name), zap.Any("stages", results))
+	traceRejectedPlacesByStages(ctx, results, map[string]models.PlanGenerationRejectReason{
+		placefilter.StageNameCategoriesDisliked: models.PlanGenerationRejectReasonDislikedCategory,
+		stageNameNotInOtherPlans:                models.PlanGenerationRejectReasonInOtherPlan,
 	})
-	s.logger.Debug("places after filtering by other plans", zap.Int("places", len(placesFiltered)))
 
 	if len(placesFiltered) == 0 {
 		return nil
 	}
 
-	// レビューの高い場所からプランに含められる場所を選択
-	for _, place := range models.SortPlacesByRating(placesFiltered) {
-		if s.checkForIncludeForPlan(place, placesInPlan, input) {
+	// スコアの高い場所からプランに含められる場所を選択
+	for _, place := range models.SortPlacesByRanking(placesFiltered) {
+		if s.checkForIncludeForPlan(ctx, place, placesInPlan, input) {
 			return &place
 		}
 	}
@@ -106,6 +176,7 @@ func (s Service) getNextPlaceForPlan(prevPlace models.Place, placesInPlan []mode
 }
 
 func (s Service) checkForIncludeForPlan(
+	ctx context.Context,
 	place models.Place,
 	placesInPlan []models.Place,
 	input CreatePlanPlacesInput,
@@ -114,6 +185,7 @@ func (s Service) checkForIncludeForPlan(
 	if _, isAlreadyInPlan := array.Find(placesInPlan, func(p models.Place) bool {
 		return p.Id == place.Id
 	}); isAlreadyInPlan {
+		traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonAlreadyInPlan)
 		return false
 	}
 
@@ -141,13 +213,50 @@ func (s Service) checkForIncludeForPlan(
 				fmt.Sprintf("skip place because the %d %s places are already in plan", condition.numPlacesCanContain, condition.category.Name),
 				zap.String("place", place.Google.Name),
 			)
+			traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonCategoryLimit)
+			return false
+		}
+	}
+
+	// 予算を超える場合はスキップ
+	if input.BudgetMax != nil && !isWithinBudget(append(placesInPlan, place), input) {
+		s.logger.Debug(
+			"skip place because it will be over budget",
+			zap.String("place", place.Google.Name),
+			zap.Int("BudgetMax", *input.BudgetMax),
+		)
+		traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonOverBudget)
+		return false
+	}
+
+	var sortedByDistance []models.Place
+	if input.LocationEnd != nil {
+		sortedByDistance = sortPlacesToEndLocation(append(placesInPlan, place), *input.LocationEnd)
+	} else {
+		sortedByDistance = sortPlacesByDistanceFrom(input.LocationStart, append(placesInPlan, place))
+	}
+
+	// 出発時刻が指定されている場合、到着時刻に営業していない場所が含まれないようにする
+	// 飲食店・カフェはなるべく食事の時間帯に到着するように並び替えるが、時間帯から外れることを理由には除外しない
+	if input.StartTime != nil {
+		placesOrdered, ok := s.sortPlacesToArriveAtMealTime(ctx, input.LocationStart, input.LocationEnd, *input.StartTime, sortedByDistance, input.TravelMode)
+		if !ok {
+			s.logger.Debug(
+				"skip place because it will be closed at arrival",
+				zap.String("place", place.Google.Name),
+				zap.Time("StartTime", *input.StartTime),
+			)
+			traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonClosedAtArrival)
 			return false
 		}
+		sortedByDistance = placesOrdered
 	}
 
 	// 最適経路で巡ったときの所要時間が予定の時間を超える場合はスキップ
-	sortedByDistance := sortPlacesByDistanceFrom(input.LocationStart, append(placesInPlan, place))
-	timeInPlan := planTimeFromPlaces(input.LocationStart, sortedByDistance)
+	timeInPlan := s.planTimeFromPlaces(ctx, input.LocationStart, sortedByDistance, input.TravelMode)
+	if input.LocationEnd != nil {
+		timeInPlan += s.travelTime(ctx, sortedByDistance[len(sortedByDistance)-1].Location, *input.LocationEnd, input.TravelMode)
+	}
 	if input.FreeTime != nil && timeInPlan > uint(*input.FreeTime) {
 		s.logger.Debug(
 			"skip place because it will be over time",
@@ -155,6 +264,7 @@ func (s Service) checkForIncludeForPlan(
 			zap.Uint("timeInPlan", timeInPlan),
 			zap.Int("FreeTime", *input.FreeTime),
 		)
+		traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonOverTime)
 
 		return false
 	}
@@ -167,6 +277,7 @@ func (s Service) checkForIncludeForPlan(
 			zap.Uint("timeInPlan", timeInPlan),
 			zap.Int("defaultMaxPlanDuration", defaultMaxPlanDuration),
 		)
+		traceRejectedPlace(ctx, place, models.PlanGenerationRejectReasonOverTime)
 
 		return false
 	}
@@ -174,43 +285,165 @@ func (s Service) checkForIncludeForPlan(
 	return true
 }
 
-// sortPlacesByDistanceFrom location からplacesを巡回する最短経路をgreedy法で求める
-func sortPlacesByDistanceFrom(location models.GeoLocation, places []models.Place) []models.Place {
-	placesSorted := make([]models.Place, len(places))
-	copy(placesSorted, places)
-
-	prevLocation := location
-	for i := 0; i < len(places); i++ {
-		nearestPlaceIndex := i
-		for j := i; j < len(places); j++ {
-			locationCurrent := placesSorted[j].Location
-			locationNearest := placesSorted[nearestPlaceIndex].Location
-
-			distanceFromCurrent := prevLocation.DistanceInMeter(locationCurrent)
-			distanceFromNearest := prevLocation.DistanceInMeter(locationNearest)
-			if distanceFromCurrent < distanceFromNearest {
-				nearestPlaceIndex = j
-			}
+// insertMeal プランに飲食店を追加する
+// 場所の数や所要時間の制約により追加できない場合は、後から追加された場所を取り除いて追加を試みる（起点となる場所は取り除かない）
+func (s Service) insertMeal(ctx context.Context, placesInPlan []models.Place, input CreatePlanPlacesInput) []models.Place {
+	inputForMeal := input
+	inputForMeal.Places = placefilter.FilterByCategory(input.Places, []models.LocationCategory{models.CategoryRestaurant}, true)
+
+	for numPlacesToKeep := len(placesInPlan); numPlacesToKeep >= 1; numPlacesToKeep-- {
+		if numPlacesToKeep >= input.MaxPlace {
+			continue
 		}
 
-		placesSorted[i], placesSorted[nearestPlaceIndex] = placesSorted[nearestPlaceIndex], placesSorted[i]
-		prevLocation = placesSorted[i].Location
+		placesKept := placesInPlan[:numPlacesToKeep]
+		meal := s.getNextPlaceForPlan(ctx, placesKept[len(placesKept)-1], placesKept, inputForMeal, placeDistanceRangeInPlan)
+		if meal == nil {
+			continue
+		}
+
+		s.logger.Debug(
+			"insert restaurant into plan for meal time",
+			zap.String("place", meal.Google.Name),
+			zap.Int("placesRemoved", len(placesInPlan)-numPlacesToKeep),
+		)
+
+		placesWithMeal := make([]models.Place, 0, numPlacesToKeep+1)
+		placesWithMeal = append(placesWithMeal, placesKept...)
+		return append(placesWithMeal, *meal)
 	}
+
+	s.logger.Debug("could not find any restaurant to insert into plan")
+	return placesInPlan
+}
+
+// needsMeal 出発時刻から予定の時間までの間に食事の時間帯が含まれるにもかかわらず、飲食店が含まれていないかを判定する
+func needsMeal(places []models.Place, input CreatePlanPlacesInput) bool {
+	if input.StartTime == nil || models.ContainsMeal(places) {
+		return false
+	}
+
+	duration := defaultMaxPlanDuration
+	if input.FreeTime != nil {
+		duration = *input.FreeTime
+	}
+
+	// 食事の時間帯は出発地点のタイムゾーンでの時刻で判定する
+	startAt := input.StartTime.In(input.LocationStart.TimeZone())
+	endAt := startAt.Add(time.Duration(duration) * time.Minute)
+	return len(models.MealTimeSlotsDuring(startAt, endAt)) > 0
+}
+
+// sortPlacesByDistanceFrom location からplacesを巡回する最短経路を求める
+func sortPlacesByDistanceFrom(location models.GeoLocation, places []models.Place) []models.Place {
+	placesSorted, _ := models.OptimizeRoute(models.OptimizeRouteInput{
+		Places:        places,
+		StartLocation: &location,
+	})
 	return placesSorted
 }
 
+// sortPlacesToEndLocation 最初の場所から出発し、最後に locationEnd へ向かうときの最短経路を求める
+func sortPlacesToEndLocation(places []models.Place, locationEnd models.GeoLocation) []models.Place {
+	placesSorted, _ := models.OptimizeRoute(models.OptimizeRouteInput{
+		Places:        places,
+		EndLocation:   &locationEnd,
+		FixFirstPlace: true,
+	})
+	return placesSorted
+}
+
+// isWithinBudget places を NumberOfPeople 人で巡るときの推定金額が予算に収まるかを判定する
+func isWithinBudget(places []models.Place, input CreatePlanPlacesInput) bool {
+	return models.EstimatedBudgetOfPlaces(places).ForPeople(input.NumberOfPeople).IsWithin(*input.BudgetMax)
+}
+
 // planTimeFromPlaces プランの所要時間を計算する
-func planTimeFromPlaces(locationStart models.GeoLocation, places []models.Place) uint {
-	prevLocation := locationStart
+func (s Service) planTimeFromPlaces(ctx context.Context, locationStart models.GeoLocation, places []models.Place, mode models.TravelMode) uint {
 	var planTimeInMinutes uint
-	for _, place := range places {
-		travelTime := prevLocation.TravelTimeTo(place.Location, 80.0)
-		planTimeInMinutes += travelTime
+	for _, transition := range s.createTransitions(ctx, locationStart, places, mode) {
+		planTimeInMinutes += transition.Duration
+	}
 
+	for _, place := range places {
 		planTimeInMinutes += place.EstimatedStayDuration()
-
-		prevLocation = place.Location
 	}
 
 	return planTimeInMinutes
 }
+
+// createTransitions locationStart から places を順番に巡るときの移動情報を求める
+// 経路探索に失敗した場合は、直線距離から移動時間を推定する
+func (s Service) createTransitions(ctx context.Context, locationStart models.GeoLocation, places []models.Place, mode models.TravelMode) []models.Transition {
+	if len(places) == 0 {
+		return nil
+	}
+
+	transitions, err := models.CreateTransitionWithRouting(ctx, s.routingProvider, places, &locationStart, mode)
+	if err != nil {
+		s.logger.Warn("error while calculating transitions with routing provider", zap.Error(err))
+		return models.CreateTransition(places, &locationStart, mode)
+	}
+
+	return transitions
+}
+
+// travelTime from から to までの移動時間を求める
+// 経路探索に失敗した場合は、直線距離から移動時間を推定する
+func (s Service) travelTime(ctx context.Context, from models.GeoLocation, to models.GeoLocation, mode models.TravelMode) uint {
+	travelTime, err := s.routingProvider.TravelTimeInMinutes(ctx, from, to, mode)
+	if err != nil {
+		s.logger.Warn("error while calculating travel time with routing provider", zap.Error(err))
+		return from.TravelTimeTo(to, mode.MeterPerMinute())
+	}
+
+	return travelTime
+}
+
+// sortPlacesToOpenAtArrival startTime に location を出発したときに、すべての場所に営業時間内に到着できる順番を求める
+// 与えられた順番で到着できない場合は、最初の場所を固定したまま営業時間内に到着できる最短経路を求める
+func (s Service) sortPlacesToOpenAtArrival(ctx context.Context, location models.GeoLocation, startTime time.Time, places []models.Place, mode models.TravelMode) ([]models.Place, bool) {
+	return s.sortPlacesToFitSchedule(ctx, location, nil, startTime, places, mode, false)
+}
+
+// sortPlacesToArriveAtMealTime 営業時間内に到着することに加えて、飲食店は食事の時間帯に、カフェは午後の時間帯に到着できる順番を求める
+// 食事の時間帯は優先するだけの条件で、そのような順番がない場合は営業時間内に到着できる順番を返す
+// 最初の場所はプランの起点として固定されるため、時間帯の判定には含めない
+// locationEnd が指定された場合は、並び替えるときに最後に到着地点へ向かうものとする
+func (s Service) sortPlacesToArriveAtMealTime(ctx context.Context, location models.GeoLocation, locationEnd *models.GeoLocation, startTime time.Time, places []models.Place, mode models.TravelMode) ([]models.Place, bool) {
+	if placesOrdered, ok := s.sortPlacesToFitSchedule(ctx, location, locationEnd, startTime, places, mode, true); ok {
+		return placesOrdered, true
+	}
+	return s.sortPlacesToFitSchedule(ctx, location, locationEnd, startTime, places, mode, false)
+}
+
+func (s Service) sortPlacesToFitSchedule(ctx context.Context, location models.GeoLocation, locationEnd *models.GeoLocation, startTime time.Time, places []models.Place, mode models.TravelMode, considerMealTime bool) ([]models.Place, bool) {
+	createTransitions := func(places []models.Place) []models.Transition {
+		return s.createTransitions(ctx, location, places, mode)
+	}
+
+	schedules := models.CreatePlaceSchedules(places, createTransitions(places), startTime)
+	isFeasible := models.IsOpeningAtArrival(places, schedules)
+	if considerMealTime && len(schedules) > 0 {
+		isFeasible = isFeasible && models.IsArrivingAtMealTime(places, schedules[1:])
+	}
+
+	if isFeasible {
+		return places, true
+	}
+
+	if len(places) <= 1 {
+		return nil, false
+	}
+
+	return models.OptimizeRoute(models.OptimizeRouteInput{
+		Places:            places,
+		StartLocation:     &location,
+		EndLocation:       locationEnd,
+		FixFirstPlace:     true,
+		StartTime:         &startTime,
+		TravelMode:        mode,
+		ConsiderMealTime:  considerMealTime,
+		CreateTransitions: createTransitions,
+	})
+}
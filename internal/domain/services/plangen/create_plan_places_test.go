@@ -1,10 +1,20 @@
 package plangen
 
 import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/api/routing"
+	"poroto.app/poroto/planner/internal/infrastructure/api/routing/routingtest"
 	"testing"
+	"time"
 )
 
+// timeZoneJapan テストで用いる場所のタイムゾーン（日本標準時）
+var timeZoneJapan = time.FixedZone("Asia/Tokyo", 9*60*60)
+
 func TestSortPlacesByDistanceFrom(t *testing.T) {
 	cases := []struct {
 		name     string
@@ -78,3 +88,353 @@ func TestSortPlacesByDistanceFrom(t *testing.T) {
 		})
 	}
 }
+
+func TestSortPlacesToEndLocation(t *testing.T) {
+	newPlace := func(id string, latitude float64) models.Place {
+		return models.Place{Id: id, Location: models.GeoLocation{Latitude: latitude, Longitude: 0}}
+	}
+
+	cases := []struct {
+		name        string
+		places      []models.Place
+		locationEnd models.GeoLocation
+		expected    []string
+	}{
+		{
+			name:        "should visit places on the way to end location",
+			places:      []models.Place{newPlace("start", 0), newPlace("far", 0.003), newPlace("near", 0.001), newPlace("middle", 0.002)},
+			locationEnd: models.GeoLocation{Latitude: 0.004, Longitude: 0},
+			expected:    []string{"start", "near", "middle", "far"},
+		},
+		{
+			name:        "should keep first place when end location is behind start",
+			places:      []models.Place{newPlace("start", 0.002), newPlace("a", 0.003), newPlace("b", 0.001)},
+			locationEnd: models.GeoLocation{Latitude: 0, Longitude: 0},
+			expected:    []string{"start", "a", "b"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result := sortPlacesToEndLocation(c.places, c.locationEnd)
+
+			var placeIds []string
+			for _, place := range result {
+				placeIds = append(placeIds, place.Id)
+			}
+
+			if diff := cmp.Diff(c.expected, placeIds); diff != "" {
+				t.Errorf("sortPlacesToEndLocation() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSortPlacesToOpenAtArrival(t *testing.T) {
+	newOpeningHours := func(openingTime, closingTime string) *models.GooglePlaceDetail {
+		return &models.GooglePlaceDetail{
+			OpeningHours: &models.GooglePlaceOpeningHours{
+				Periods: []models.GooglePlaceOpeningPeriod{
+					{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: openingTime, ClosingTime: closingTime},
+				},
+			},
+		}
+	}
+
+	placeStart := models.Place{
+		Id:       "start",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0},
+	}
+	placeOpenFromEleven := models.Place{
+		Id:       "open-from-eleven",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0.001},
+		Google:   models.GooglePlace{PlaceDetail: newOpeningHours("1100", "2000")},
+	}
+	placeOpenUntilEleven := models.Place{
+		Id:       "open-until-eleven",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0.002},
+		Google: models.GooglePlace{
+			Types:       []string{models.CategoryRestaurant.SubCategories[0]},
+			PlaceDetail: newOpeningHours("0900", "1100"),
+		},
+	}
+	placeClosed := models.Place{
+		Id:       "closed",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0.003},
+		Google:   models.GooglePlace{PlaceDetail: newOpeningHours("2200", "2300")},
+	}
+
+	cases := []struct {
+		name       string
+		startTime  time.Time
+		places     []models.Place
+		expected   []string
+		expectedOk bool
+	}{
+		{
+			name:       "should keep order when all places are opening at arrival",
+			startTime:  time.Date(2024, 7, 1, 12, 0, 0, 0, timeZoneJapan),
+			places:     []models.Place{placeStart, placeOpenFromEleven},
+			expected:   []string{"start", "open-from-eleven"},
+			expectedOk: true,
+		},
+		{
+			name:       "should reorder places to visit them while opening",
+			startTime:  time.Date(2024, 7, 1, 10, 45, 0, 0, timeZoneJapan),
+			places:     []models.Place{placeStart, placeOpenFromEleven, placeOpenUntilEleven},
+			expected:   []string{"start", "open-until-eleven", "open-from-eleven"},
+			expectedOk: true,
+		},
+		{
+			name:       "should return false when a place is closed whatever the order is",
+			startTime:  time.Date(2024, 7, 1, 10, 30, 0, 0, timeZoneJapan),
+			places:     []models.Place{placeStart, placeOpenUntilEleven, placeClosed},
+			expectedOk: false,
+		},
+	}
+
+	service := Service{
+		routingProvider: models.NewHaversineRoutingProvider(),
+		logger:          zap.NewNop(),
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, ok := service.sortPlacesToOpenAtArrival(context.Background(), placeStart.Location, c.startTime, c.places, models.TravelModeWalking)
+			if ok != c.expectedOk {
+				t.Fatalf("expected: %v\nactual: %v", c.expectedOk, ok)
+			}
+
+			if len(result) != len(c.expected) {
+				t.Fatalf("expected: %v\nactual: %v", len(c.expected), len(result))
+			}
+
+			for i := 0; i < len(result); i++ {
+				if result[i].Id != c.expected[i] {
+					t.Errorf("expected: %v\nactual: %v", c.expected[i], result[i].Id)
+				}
+			}
+		})
+	}
+}
+
+func TestSortPlacesToArriveAtMealTime(t *testing.T) {
+	openingHours := &models.GooglePlaceDetail{
+		OpeningHours: &models.GooglePlaceOpeningHours{
+			Periods: []models.GooglePlaceOpeningPeriod{
+				{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "0900", ClosingTime: "2300"},
+			},
+		},
+	}
+
+	placeStart := models.Place{
+		Id:       "start",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0},
+	}
+	restaurant := models.Place{
+		Id:       "restaurant",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0.001},
+		Google: models.GooglePlace{
+			Types:       []string{models.CategoryRestaurant.SubCategories[0]},
+			PlaceDetail: openingHours,
+		},
+	}
+	placeClosed := models.Place{
+		Id:       "closed",
+		Location: models.GeoLocation{Latitude: 0, Longitude: 0.002},
+		Google: models.GooglePlace{
+			PlaceDetail: &models.GooglePlaceDetail{
+				OpeningHours: &models.GooglePlaceOpeningHours{
+					Periods: []models.GooglePlaceOpeningPeriod{
+						{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "2200", ClosingTime: "2300"},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name       string
+		startTime  time.Time
+		places     []models.Place
+		expected   []string
+		expectedOk bool
+	}{
+		{
+			name:       "should keep restaurant even if it cannot be visited at meal time",
+			startTime:  time.Date(2024, 7, 1, 9, 0, 0, 0, timeZoneJapan),
+			places:     []models.Place{placeStart, restaurant},
+			expected:   []string{"start", "restaurant"},
+			expectedOk: true,
+		},
+		{
+			name:       "should return false when a place is closed at arrival",
+			startTime:  time.Date(2024, 7, 1, 9, 0, 0, 0, timeZoneJapan),
+			places:     []models.Place{placeStart, restaurant, placeClosed},
+			expectedOk: false,
+		},
+	}
+
+	service := Service{
+		routingProvider: models.NewHaversineRoutingProvider(),
+		logger:          zap.NewNop(),
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			result, ok := service.sortPlacesToArriveAtMealTime(context.Background(), placeStart.Location, nil, c.startTime, c.places, models.TravelModeWalking)
+			if ok != c.expectedOk {
+				t.Fatalf("expected: %v\nactual: %v", c.expectedOk, ok)
+			}
+
+			var placeIds []string
+			for _, place := range result {
+				placeIds = append(placeIds, place.Id)
+			}
+			if diff := cmp.Diff(c.expected, placeIds); diff != "" {
+				t.Errorf("places mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanTimeFromPlaces(t *testing.T) {
+	locationStart := models.GeoLocation{Latitude: 35.658581, Longitude: 139.745433}
+	places := []models.Place{
+		{
+			Id:       "tokyo-skytree",
+			Location: models.GeoLocation{Latitude: 35.710063, Longitude: 139.8107},
+			Google:   models.GooglePlace{Types: []string{models.CategoryRestaurant.SubCategories[0]}},
+		},
+	}
+
+	server := routingtest.NewServer()
+	defer server.Close()
+
+	osrmRoutingProvider, err := routing.NewOsrmRoutingProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create osrm routing provider: %v", err)
+	}
+
+	cases := []struct {
+		name            string
+		routingProvider models.RoutingProvider
+		expected        uint
+	}{
+		{
+			name:            "travel time is estimated from straight distance",
+			routingProvider: models.NewHaversineRoutingProvider(),
+			expected:        102 + models.CategoryRestaurant.EstimatedStayDuration,
+		},
+		{
+			name:            "travel time is calculated by routing server",
+			routingProvider: osrmRoutingProvider,
+			expected:        137 + models.CategoryRestaurant.EstimatedStayDuration,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			service := Service{
+				routingProvider: c.routingProvider,
+				logger:          zap.NewNop(),
+			}
+
+			result := service.planTimeFromPlaces(context.Background(), locationStart, places, models.TravelModeWalking)
+			if result != c.expected {
+				t.Errorf("expected: %v\nactual: %v", c.expected, result)
+			}
+		})
+	}
+}
+
+func TestIsWithinBudget(t *testing.T) {
+	places := []models.Place{
+		{Id: "1", Google: models.GooglePlace{PriceLevel: 1}},
+		{Id: "2", Google: models.GooglePlace{PriceLevel: 2}},
+	}
+
+	cases := []struct {
+		name     string
+		input    CreatePlanPlacesInput
+		expected bool
+	}{
+		{
+			name:     "within budget",
+			input:    CreatePlanPlacesInput{BudgetMax: utils.ToPointer(4000)},
+			expected: true,
+		},
+		{
+			name:     "over budget",
+			input:    CreatePlanPlacesInput{BudgetMax: utils.ToPointer(3999)},
+			expected: false,
+		},
+		{
+			name:     "over budget for two people",
+			input:    CreatePlanPlacesInput{BudgetMax: utils.ToPointer(4000), NumberOfPeople: 2},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := isWithinBudget(places, c.input)
+			if actual != c.expected {
+				t.Errorf("expected: %v, actual: %v", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestNeedsMeal(t *testing.T) {
+	park := models.Place{Id: "park", Google: models.GooglePlace{Types: []string{models.CategoryPark.SubCategories[0]}}}
+	restaurant := models.Place{Id: "restaurant", Google: models.GooglePlace{Types: []string{models.CategoryRestaurant.SubCategories[0]}}}
+
+	cases := []struct {
+		name     string
+		places   []models.Place
+		input    CreatePlanPlacesInput
+		expected bool
+	}{
+		{
+			name:     "start time is not specified",
+			places:   []models.Place{park},
+			input:    CreatePlanPlacesInput{},
+			expected: false,
+		},
+		{
+			name:   "plan spans lunch time without restaurant",
+			places: []models.Place{park},
+			input: CreatePlanPlacesInput{
+				StartTime: utils.ToPointer(time.Date(2024, 7, 1, 10, 0, 0, 0, timeZoneJapan)),
+			},
+			expected: true,
+		},
+		{
+			name:   "plan spans lunch time with restaurant",
+			places: []models.Place{park, restaurant},
+			input: CreatePlanPlacesInput{
+				StartTime: utils.ToPointer(time.Date(2024, 7, 1, 10, 0, 0, 0, timeZoneJapan)),
+			},
+			expected: false,
+		},
+		{
+			name:   "plan ends before lunch time",
+			places: []models.Place{park},
+			input: CreatePlanPlacesInput{
+				StartTime: utils.ToPointer(time.Date(2024, 7, 1, 9, 0, 0, 0, timeZoneJapan)),
+				FreeTime:  utils.ToPointer(90),
+			},
+			expected: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := needsMeal(c.places, c.input)
+			if actual != c.expected {
+				t.Errorf("expected: %v, actual: %v", c.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package plangen
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placesearch"
+	"sort"
+	"time"
+)
+
+const (
+	maxPlaceInTripDay   = 6
+	lodgingSearchRadius = 3000
+)
+
+// CreateTripPlanInput
+// FreeTime は旅行全体の時間（分）で、1日ごとの行動時間に分割してプランを作成する
+// StartTime が指定されていない場合は、現在時刻に出発するものとする
+// 1日ごとの行動時間は LocationStart のタイムゾーンで区切る
+// TravelMode は移動手段を表し、指定しない場合は徒歩とする
+type CreateTripPlanInput struct {
+	LocationStart          models.GeoLocation
+	StartTime              *time.Time
+	FreeTime               int
+	TravelMode             models.TravelMode
+	CategoryNamesPreferred *[]string
+	CategoryNamesDisliked  *[]string
+}
+
+// CreateTripPlan は複数日にわたる旅行のプランを作成する
+// 1日ごとにプランを作成し、最終日以外は宿泊施設で1日を終えるようにする
+// 翌日のプランは宿泊施設の付近から作成する
+func (s Service) CreateTripPlan(ctx context.Context, input CreateTripPlanInput) (*models.Trip, error) {
+	if !input.TravelMode.IsValid() {
+		input.TravelMode = models.TravelModeWalking
+	}
+
+	startTime := s.clock.Now()
+	if input.StartTime != nil {
+		startTime = *input.StartTime
+	}
+	// 1日の区切りはサーバーやクライアントではなく、出発地点のタイムゾーンで判断する
+	startTime = startTime.In(input.LocationStart.TimeZone())
+
+	segments := models.SplitIntoTripDaySegments(startTime, input.FreeTime)
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("free time is too short to create trip")
+	}
+
+	tripId := uuid.New().String()
+
+	var createPlanParams []CreatePlanParams
+	var placesInTrip []models.Place
+	locationOfDay := input.LocationStart
+	for day, segment := range segments {
+		createPlanParam, err := s.createTripDayPlan(ctx, tripId, input, locationOfDay, segment, placesInTrip)
+		if err != nil {
+			return nil, fmt.Errorf("error while creating plan of day %d: %w", day+1, err)
+		}
+
+		placesInTrip = append(placesInTrip, createPlanParam.Places...)
+
+		// 最終日以外は宿泊施設で1日を終える
+		lastPlace := createPlanParam.Places[len(createPlanParam.Places)-1]
+		locationOfDay = lastPlace.Location
+		if day < len(segments)-1 {
+			lodging := s.findLodging(ctx, lastPlace.Location, input.TravelMode, placesInTrip)
+			if lodging != nil {
+				createPlanParam.Places = append(createPlanParam.Places, *lodging)
+				placesInTrip = append(placesInTrip, *lodging)
+				locationOfDay = lodging.Location
+			} else {
+				s.logger.Warn(
+					"could not find lodging",
+					zap.String("tripId", tripId),
+					zap.Int("day", day+1),
+				)
+			}
+		}
+
+		createPlanParams = append(createPlanParams, *createPlanParam)
+	}
+
+	// プランは作成された順に返されないため、起点となる場所をもとに日付順に並べる
+	plansCreated := s.createPlanData(ctx, tripId, createPlanParams...)
+	plans := make([]models.Plan, 0, len(plansCreated))
+	for _, param := range createPlanParams {
+		plan, ok := array.Find(plansCreated, func(plan models.Plan) bool {
+			return len(plan.Places) > 0 && plan.Places[0].Id == param.PlaceStart.Id
+		})
+		if !ok {
+			return nil, fmt.Errorf("could not find plan starting from %s", param.PlaceStart.Id)
+		}
+		plans = append(plans, plan)
+	}
+
+	tripStartTime := segments[0].StartTime
+	return &models.Trip{
+		Id:         tripId,
+		Name:       fmt.Sprintf("%d日間の旅", len(plans)),
+		Plans:      plans,
+		StartTime:  &tripStartTime,
+		TravelMode: input.TravelMode,
+	}, nil
+}
+
+// createTripDayPlan location 付近を起点として、旅行の1日分のプランを作成する
+func (s Service) createTripDayPlan(
+	ctx context.Context,
+	tripId string,
+	input CreateTripPlanInput,
+	location models.GeoLocation,
+	segment models.TripDaySegment,
+	placesInTrip []models.Place,
+) (*CreatePlanParams, error) {
+	placesNearby, err := s.placeSearchService.SearchNearbyPlaces(ctx, placesearch.SearchNearbyPlacesInput{
+		Location: location,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching nearby places: %w", err)
+	}
+	placesNearby = s.withRankingScores(ctx, placesNearby)
+
+	weather := s.FetchWeatherForecast(ctx, location, &segment.StartTime, &segment.DurationInMinutes)
+
+	placesForPlanStart := s.SelectBasePlace(ctx, SelectBasePlaceInput{
+		BaseLocation:           location,
+		Places:                 placesNearby,
+		IgnorePlaces:           placesInTrip,
+		CategoryNamesPreferred: input.CategoryNamesPreferred,
+		CategoryNamesDisliked:  input.CategoryNamesDisliked,
+		TravelMode:             input.TravelMode,
+		Weather:                weather,
+	})
+
+	for _, placeStart := range placesForPlanStart {
+		freeTime := segment.DurationInMinutes
+		startTime := segment.StartTime
+		planPlaces, err := s.CreatePlanPlaces(ctx, CreatePlanPlacesInput{
+			PlanCandidateSetId:      tripId,
+			LocationStart:           placeStart.Location,
+			PlaceStart:              placeStart,
+			Places:                  placesNearby,
+			PlacesOtherPlansContain: placesInTrip,
+			CategoryNamesDisliked:   input.CategoryNamesDisliked,
+			FreeTime:                &freeTime,
+			StartTime:               &startTime,
+			TravelMode:              input.TravelMode,
+			Weather:                 weather,
+			MaxPlace:                maxPlaceInTripDay,
+		})
+		if err != nil {
+			s.logger.Warn(
+				"error while creating trip day plan",
+				zap.String("place", placeStart.Google.Name),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		return &CreatePlanParams{
+			LocationStart: location,
+			PlaceStart:    placeStart,
+			Places:        planPlaces,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("could not find any place to start plan")
+}
+
+// findLodging location から最も近い宿泊施設を探す
+// すでに旅行に含まれている場所は選択しない
+func (s Service) findLodging(ctx context.Context, location models.GeoLocation, travelMode models.TravelMode, placesInTrip []models.Place) *models.Place {
+	lodgings, err := s.placeSearchService.SearchNearbyLodgings(ctx, location, uint(travelMode.ScaleDistance(lodgingSearchRadius)))
+	if err != nil {
+		s.logger.Warn("error while searching lodgings", zap.Error(err))
+		return nil
+	}
+
+	lodgings = array.Filter(lodgings, func(lodging models.Place) bool {
+		_, isAlreadyInTrip := array.Find(placesInTrip, func(place models.Place) bool {
+			return place.Id == lodging.Id
+		})
+		return lodging.IsLodging() && !isAlreadyInTrip
+	})
+	if len(lodgings) == 0 {
+		return nil
+	}
+
+	sort.SliceStable(lodgings, func(i, j int) bool {
+		return lodgings[i].Location.DistanceInMeter(location) < lodgings[j].Location.DistanceInMeter(location)
+	})
+
+	return &lodgings[0]
+}
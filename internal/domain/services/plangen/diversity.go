@@ -0,0 +1,163 @@
+package plangen
+
+import (
+	"math"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// プランの組み合わせの多様性を計算するときの、各観点の重み
+const (
+	planDiversityWeightCategory = 0.5
+	planDiversityWeightLocation = 0.3
+	planDiversityWeightPrice    = 0.2
+
+	// planDiversityDistanceSaturation プランの中心がこの距離（m）以上離れていれば、場所が十分に散らばっているとみなす
+	planDiversityDistanceSaturation = 1000
+
+	// planDiversityWeight プランを選ぶときに、場所の数に対して多様性をどれだけ重視するか
+	planDiversityWeight = 1.0
+
+	maxGooglePriceLevel = 4
+)
+
+// PlanDiversityScore はプランの組み合わせがどれだけ異なる傾向を持つかを表す
+// Category, Location, Price はそれぞれ、プランの組ごとのカテゴリの違い・中心の距離・価格帯の差の平均で、0〜1 の値をとる
+type PlanDiversityScore struct {
+	Category float64
+	Location float64
+	Price    float64
+}
+
+// Total は各観点のスコアを重み付けして足し合わせる
+func (s PlanDiversityScore) Total() float64 {
+	return planDiversityWeightCategory*s.Category +
+		planDiversityWeightLocation*s.Location +
+		planDiversityWeightPrice*s.Price
+}
+
+// CalculatePlanDiversity はプランの組み合わせの多様性を計算する
+// プランが 1 つ以下の場合は、比較する対象がないため 0 とする
+func CalculatePlanDiversity(plans []CreatePlanParams) PlanDiversityScore {
+	var score PlanDiversityScore
+	numPairs := 0
+	for i := 0; i < len(plans); i++ {
+		for j := i + 1; j < len(plans); j++ {
+			score.Category += categoryDistance(plans[i].Places, plans[j].Places)
+			score.Location += locationDistance(plans[i].Places, plans[j].Places)
+			score.Price += priceDistance(plans[i].Places, plans[j].Places)
+			numPairs++
+		}
+	}
+
+	if numPairs == 0 {
+		return PlanDiversityScore{}
+	}
+
+	return PlanDiversityScore{
+		Category: score.Category / float64(numPairs),
+		Location: score.Location / float64(numPairs),
+		Price:    score.Price / float64(numPairs),
+	}
+}
+
+// selectPlanParamForDiversity は候補の中から、場所の数が多く、すでに選択されたプランと異なる傾向のプランを選ぶ
+func selectPlanParamForDiversity(selected []CreatePlanParams, candidates []CreatePlanParams) CreatePlanParams {
+	maxNumPlaces := 0
+	for _, candidate := range candidates {
+		if len(candidate.Places) > maxNumPlaces {
+			maxNumPlaces = len(candidate.Places)
+		}
+	}
+
+	var bestCandidate CreatePlanParams
+	bestScore := math.Inf(-1)
+	for _, candidate := range candidates {
+		score := planDiversityWeight * CalculatePlanDiversity(append(append([]CreatePlanParams{}, selected...), candidate)).Total()
+		if maxNumPlaces > 0 {
+			score += float64(len(candidate.Places)) / float64(maxNumPlaces)
+		}
+
+		if score > bestScore {
+			bestCandidate = candidate
+			bestScore = score
+		}
+	}
+
+	return bestCandidate
+}
+
+// categoryDistance はプランに含まれるカテゴリの違いを 1 - Jaccard 係数で表す
+func categoryDistance(placesA []models.Place, placesB []models.Place) float64 {
+	categoriesA := categoryNamesOfPlaces(placesA)
+	categoriesB := categoryNamesOfPlaces(placesB)
+
+	union := array.DistinctBy(append(append([]string{}, categoriesA...), categoriesB...), func(name string) string { return name })
+	if len(union) == 0 {
+		return 0
+	}
+
+	intersection := array.Filter(categoriesA, func(name string) bool { return array.IsContain(categoriesB, name) })
+	return 1 - float64(len(intersection))/float64(len(union))
+}
+
+// locationDistance はプランの中心の距離を planDiversityDistanceSaturation で正規化する
+func locationDistance(placesA []models.Place, placesB []models.Place) float64 {
+	if len(placesA) == 0 || len(placesB) == 0 {
+		return 0
+	}
+
+	distance := centerOfPlaces(placesA).DistanceInMeter(centerOfPlaces(placesB))
+	return math.Min(distance/planDiversityDistanceSaturation, 1)
+}
+
+// priceDistance はプランの平均価格帯の差を 0〜1 の値で表す
+// どちらかのプランの価格帯が推定できない場合は 0 とする
+func priceDistance(placesA []models.Place, placesB []models.Place) float64 {
+	priceLevelA, okA := averagePriceLevel(placesA)
+	priceLevelB, okB := averagePriceLevel(placesB)
+	if !okA || !okB {
+		return 0
+	}
+	return math.Abs(priceLevelA-priceLevelB) / maxGooglePriceLevel
+}
+
+func categoryNamesOfPlaces(places []models.Place) []string {
+	var categoryNames []string
+	for _, place := range places {
+		category := place.MainCategory()
+		if category == nil || array.IsContain(categoryNames, category.Name) {
+			continue
+		}
+		categoryNames = append(categoryNames, category.Name)
+	}
+	return categoryNames
+}
+
+func centerOfPlaces(places []models.Place) models.GeoLocation {
+	var center models.GeoLocation
+	for _, place := range places {
+		center.Latitude += place.Location.Latitude / float64(len(places))
+		center.Longitude += place.Location.Longitude / float64(len(places))
+	}
+	return center
+}
+
+func averagePriceLevel(places []models.Place) (float64, bool) {
+	var sum float64
+	count := 0
+	for _, place := range places {
+		priceRange := place.EstimatedPriceRange()
+		if priceRange == nil {
+			continue
+		}
+		sum += float64(priceRange.GooglePriceLevel)
+		count++
+	}
+
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
@@ -0,0 +1,125 @@
+package plangen
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"googlemaps.github.io/maps"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"testing"
+)
+
+func TestCalculatePlanDiversity(t *testing.T) {
+	cases := []struct {
+		name     string
+		plans    []CreatePlanParams
+		expected PlanDiversityScore
+	}{
+		{
+			name: "single plan has no diversity",
+			plans: []CreatePlanParams{
+				{Places: []models.Place{newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1)}},
+			},
+			expected: PlanDiversityScore{},
+		},
+		{
+			name: "same plans have no diversity",
+			plans: []CreatePlanParams{
+				{Places: []models.Place{newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1)}},
+				{Places: []models.Place{newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1)}},
+			},
+			expected: PlanDiversityScore{},
+		},
+		{
+			name: "plans with different categories, locations and prices",
+			plans: []CreatePlanParams{
+				{Places: []models.Place{
+					newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1),
+					newDiversityTestPlace("park", maps.PlaceTypePark, 0, 0, 1),
+				}},
+				{Places: []models.Place{
+					newDiversityTestPlace("restaurant", maps.PlaceTypeRestaurant, 0.1, 0, 3),
+					newDiversityTestPlace("park-2", maps.PlaceTypePark, 0.1, 0, 3),
+				}},
+			},
+			expected: PlanDiversityScore{
+				// cafe, park, restaurant のうち共通するのは park のみ
+				Category: 1 - 1.0/3.0,
+				Location: 1,
+				Price:    0.5,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := CalculatePlanDiversity(c.plans)
+			if diff := cmp.Diff(c.expected, actual, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("CalculatePlanDiversity() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectPlanParamForDiversity(t *testing.T) {
+	cases := []struct {
+		name       string
+		selected   []CreatePlanParams
+		candidates []CreatePlanParams
+		expected   string
+	}{
+		{
+			name:     "select plan with most places when no plan is selected",
+			selected: nil,
+			candidates: []CreatePlanParams{
+				{PlaceStart: models.Place{Id: "1"}, Places: []models.Place{
+					newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1),
+				}},
+				{PlaceStart: models.Place{Id: "2"}, Places: []models.Place{
+					newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1),
+					newDiversityTestPlace("park", maps.PlaceTypePark, 0, 0, 1),
+				}},
+			},
+			expected: "2",
+		},
+		{
+			name: "select plan different from selected plans",
+			selected: []CreatePlanParams{
+				{PlaceStart: models.Place{Id: "selected"}, Places: []models.Place{
+					newDiversityTestPlace("cafe", maps.PlaceTypeCafe, 0, 0, 1),
+					newDiversityTestPlace("park", maps.PlaceTypePark, 0, 0, 1),
+				}},
+			},
+			candidates: []CreatePlanParams{
+				{PlaceStart: models.Place{Id: "1"}, Places: []models.Place{
+					newDiversityTestPlace("cafe-2", maps.PlaceTypeCafe, 0.001, 0, 1),
+					newDiversityTestPlace("park-2", maps.PlaceTypePark, 0.001, 0, 1),
+				}},
+				{PlaceStart: models.Place{Id: "2"}, Places: []models.Place{
+					newDiversityTestPlace("museum", maps.PlaceTypeMuseum, 0.01, 0, 2),
+					newDiversityTestPlace("restaurant", maps.PlaceTypeRestaurant, 0.01, 0, 3),
+				}},
+			},
+			expected: "2",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := selectPlanParamForDiversity(c.selected, c.candidates)
+			if diff := cmp.Diff(c.expected, actual.PlaceStart.Id); diff != "" {
+				t.Errorf("selectPlanParamForDiversity() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func newDiversityTestPlace(id string, placeType maps.PlaceType, latitude float64, longitude float64, priceLevel int) models.Place {
+	return models.Place{
+		Id:       id,
+		Location: models.GeoLocation{Latitude: latitude, Longitude: longitude},
+		Google: models.GooglePlace{
+			Types:      []string{string(placeType)},
+			PriceLevel: priceLevel,
+		},
+	}
+}
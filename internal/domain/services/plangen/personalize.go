@@ -0,0 +1,63 @@
+package plangen
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+// userPreferenceSavedPlanLimit はユーザーの好みを推定するときに用いる、最近保存したプランの数
+const userPreferenceSavedPlanLimit = 20
+
+// FetchUserPreferenceProfile はユーザーがいいねした場所・保存したプラン・過去に拒否したカテゴリから、ユーザーの好みを推定する
+// 未ログインの場合や、ユーザーが好みの反映を無効にしている場合、好みを推定できるほどの行動がない場合は nil を返す
+func (s Service) FetchUserPreferenceProfile(ctx context.Context, user *models.User) *models.UserPreferenceProfile {
+	if user == nil || user.PersonalizationDisabled {
+		return nil
+	}
+
+	profile, err := s.fetchUserPreferenceProfile(ctx, user.Id)
+	if err != nil {
+		s.logger.Warn(
+			"error while fetching user preference profile",
+			zap.String("userId", user.Id),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if profile.IsZero() {
+		return nil
+	}
+
+	s.logger.Debug(
+		"user preference profile",
+		zap.String("userId", user.Id),
+		zap.Any("categoryAffinities", profile.CategoryAffinities),
+	)
+
+	return profile
+}
+
+func (s Service) fetchUserPreferenceProfile(ctx context.Context, userId string) (*models.UserPreferenceProfile, error) {
+	placesLiked, err := s.placeRepository.FindLikePlacesByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching liked places: %v", err)
+	}
+
+	plansSaved, err := s.planRepository.FindRecentByAuthorId(ctx, userId, userPreferenceSavedPlanLimit)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching saved plans: %v", err)
+	}
+
+	categoriesDisliked, err := s.planCandidateRepository.FindCategoriesRejectedByUserId(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("error while fetching categories rejected: %v", err)
+	}
+
+	profile := models.NewUserPreferenceProfile(userId, utils.FromPointerOrZero(placesLiked), utils.FromPointerOrZero(plansSaved), categoriesDisliked)
+	return &profile, nil
+}
@@ -0,0 +1,44 @@
+package plangen
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// withRankingScores は計算済みのスコアを場所に設定する
+// スコアが計算されていない場所には、デフォルトのスコアの計算に用いる保存されたプランに含まれている回数を設定する
+// スコアの取得に失敗した場合は、Google の評価といいね数による順位付けにフォールバックする
+func (s Service) withRankingScores(ctx context.Context, places []models.Place) []models.Place {
+	placeIds := array.Map(places, func(place models.Place) string { return place.Id })
+	scores, err := s.placeRepository.FindRankingScoresByPlaceIds(ctx, placeIds)
+	if err != nil {
+		s.logger.Warn("error while fetching place ranking scores", zap.Error(err))
+		return places
+	}
+
+	placeIdsWithoutScore := array.MapAndFilter(places, func(place models.Place) (string, bool) {
+		_, ok := scores[place.Id]
+		return place.Id, !ok
+	})
+	savedPlanCounts, err := s.placeRepository.CountSavedPlansByPlaceIds(ctx, placeIdsWithoutScore)
+	if err != nil {
+		s.logger.Warn("error while counting saved plans of places", zap.Error(err))
+		savedPlanCounts = map[string]int{}
+	}
+
+	placesWithScore := make([]models.Place, len(places))
+	for i, place := range places {
+		if score, ok := scores[place.Id]; ok {
+			score := score
+			place.RankingScore = &score
+		} else {
+			place.SavedPlanCount = savedPlanCounts[place.Id]
+		}
+		placesWithScore[i] = place
+	}
+
+	return placesWithScore
+}
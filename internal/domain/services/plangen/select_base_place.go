@@ -1,6 +1,7 @@
 package plangen
 
 import (
+	"context"
 	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
@@ -15,6 +16,9 @@ const (
 // SelectBasePlaceInput
 // Places 選択候補となる場所
 // IgnorePlaces は，選択されないようにする場所
+// Radius は徒歩を基準とした検索範囲で、TravelMode に応じて広げられる
+// Weather で雨が予報されている場合は、屋内の場所を優先して選択する
+// CategoryNamesPreferred が指定された場合は、そのカテゴリの場所を優先して選択する
 type SelectBasePlaceInput struct {
 	BaseLocation           models.GeoLocation
 	Places                 []models.Place
@@ -23,10 +27,12 @@ type SelectBasePlaceInput struct {
 	CategoryNamesDisliked  *[]string
 	MaxBasePlaceCount      int
 	Radius                 int
+	TravelMode             models.TravelMode
+	Weather                *models.WeatherForecast
 }
 
 // SelectBasePlace は，プランの起点となる場所の候補を選択する
-func (s Service) SelectBasePlace(input SelectBasePlaceInput) []models.Place {
+func (s Service) SelectBasePlace(ctx context.Context, input SelectBasePlaceInput) []models.Place {
 	s.logger.Debug(
 		"SelectBasePlace",
 		zap.Int("Places", len(input.Places)),
@@ -42,57 +48,75 @@ func (s Service) SelectBasePlace(input SelectBasePlaceInput) []models.Place {
 		input.Radius = defaultRadius
 	}
 
+	if !input.TravelMode.IsValid() {
+		input.TravelMode = models.TravelModeWalking
+	}
+
 	if input.BaseLocation.IsZero() {
 		panic("base location is zero value")
 	}
 
-	placesFiltered := input.Places
-
-	// レビューが低い、またはレビュー数が少ない場所を除外する
-	placesFiltered = placefilter.FilterByRating(placesFiltered, 3.0, 10)
-	s.logger.Debug("Places after filtering by rating", zap.Int("Places", len(placesFiltered)))
+	options := placefilter.PipelineOptions{
+		Location:           input.BaseLocation,
+		MaxDistanceInMeter: input.TravelMode.ScaleDistance(float64(input.Radius)),
+		// 雨が予報されている場合は、屋外の場所を除外する
+		Weather: input.Weather,
+	}
 
 	// ユーザーが拒否した場所は取り除く
 	if input.CategoryNamesDisliked != nil {
-		categoriesDisliked := models.GetCategoriesFromSubCategories(*input.CategoryNamesDisliked)
-		placesFiltered = placefilter.FilterByCategory(placesFiltered, categoriesDisliked, false)
-		s.logger.Debug("Places after filtering by disliked categories", zap.Int("Places", len(placesFiltered)))
+		options.CategoriesDisliked = models.GetCategoriesFromSubCategories(*input.CategoryNamesDisliked)
 	}
 
-	// すでに選択された場所は除外
-	placesFiltered = array.Filter(placesFiltered, func(place models.Place) bool {
-		_, isIgnorePlace := array.Find(input.IgnorePlaces, func(p models.Place) bool {
-			return p.Google.PlaceId == place.Google.PlaceId
-		})
-		return !isIgnorePlace
-	})
-	s.logger.Debug("Places after filtering ignore places", zap.Int("places", len(placesFiltered)))
+	// 天気によるフィルタリングは屋内の場所が残るかどうかで結果が変わるため、
+	// レビューによるフィルタリングはその前に適用する
+	options.Stages = []placefilter.Stage{
+		// レビューが低い、またはレビュー数が少ない場所を除外する
+		placefilter.NewRatingStage(3.0, 10),
+	}
 
-	placesFiltered = placefilter.FilterDefaultIgnore(placefilter.FilterDefaultIgnoreInput{
-		Places:              placesFiltered,
-		StartLocation:       input.BaseLocation,
-		IgnoreDistanceRange: float64(input.Radius),
-	})
-	s.logger.Debug("Places after filtering default ignore", zap.Int("places", len(placesFiltered)))
+	pipeline, err := s.placeFilterPipelineConfig.Build("plangen.base_place", options)
+	if err != nil {
+		s.logger.Error("error while building place filter pipeline", zap.Error(err))
+		return nil
+	}
+
+	pipeline = pipeline.Then(
+		// すでに選択された場所は除外
+		placefilter.NewStage("ignore_places", func(place models.Place) bool {
+			_, isIgnorePlace := array.Find(input.IgnorePlaces, func(p models.Place) bool {
+				return p.Google.PlaceId == place.Google.PlaceId
+			})
+			return !isIgnorePlace
+		}),
+	)
+	placesFiltered, results := pipeline.Apply(ctx, input.Places)
+	s.logger.Debug("places after filtering", zap.String("pipeline", pipeline.Name), zap.Any("stages", results))
 
 	placesSelected := make([]models.Place, 0, input.MaxBasePlaceCount)
 	for len(placesSelected) < input.MaxBasePlaceCount || len(placesFiltered) > 0 {
-		// プラン間で重複が発生しないように、すでに選択された場所から500m以内の場所は選択しない
+		// プラン間で重複が発生しないように、すでに選択された場所から500m（徒歩の場合）以内の場所は選択しない
 		placesFiltered = array.Filter(placesFiltered, func(place models.Place) bool {
 			_, isDistanceWithIn := array.Find(placesSelected, func(p models.Place) bool {
-				return p.Location.DistanceInMeter(place.Location) < 500
+				return p.Location.DistanceInMeter(place.Location) < input.TravelMode.ScaleDistance(500)
 			})
 			return !isDistanceWithIn
 		})
 		s.logger.Debug("Places after filtering by distance from selected places", zap.Int("Places", len(placesFiltered)))
 
-		// レビューの最も高い場所を選択する
-		placesSortedByRating := models.SortPlacesByRating(placesFiltered)
-		if len(placesSortedByRating) == 0 {
+		// 行きたいカテゴリの場所が残っている場合はその中から、そうでなければすべての場所からスコアの最も高い場所を選択する
+		placesCandidate := placesFiltered
+		placesPreferred := filterPlacesByCategoryNames(placesFiltered, input.CategoryNamesPreferred)
+		if len(placesPreferred) > 0 {
+			placesCandidate = placesPreferred
+		}
+
+		placesSortedByRanking := models.SortPlacesByRanking(placesCandidate)
+		if len(placesSortedByRanking) == 0 {
 			break
 		}
 
-		placesSelected = append(placesSelected, placesSortedByRating[0])
+		placesSelected = append(placesSelected, placesSortedByRanking[0])
 	}
 
 	if len(placesSelected) > input.MaxBasePlaceCount {
@@ -101,3 +125,17 @@ func (s Service) SelectBasePlace(input SelectBasePlaceInput) []models.Place {
 
 	return placesSelected
 }
+
+// filterPlacesByCategoryNames categoryNames のいずれかのカテゴリに含まれる場所を返す
+func filterPlacesByCategoryNames(places []models.Place, categoryNames *[]string) []models.Place {
+	if categoryNames == nil || len(*categoryNames) == 0 {
+		return nil
+	}
+
+	return array.Filter(places, func(place models.Place) bool {
+		_, isPreferred := array.Find(place.Categories(), func(category models.LocationCategory) bool {
+			return array.IsContain(*categoryNames, category.Name)
+		})
+		return isPreferred
+	})
+}
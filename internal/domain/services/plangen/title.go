@@ -1,17 +1,17 @@
 package plangen
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"unicode/utf8"
 
 	"poroto.app/poroto/planner/internal/domain/models"
-	"poroto.app/poroto/planner/internal/infrastructure/api/openai"
 )
 
 // GeneratePlanTitle プランのタイトルを生成する
 // タイトルが生成できなかった場合は、nilを返す
-func (s Service) GeneratePlanTitle(places []models.Place) (*string, error) {
+func (s Service) GeneratePlanTitle(ctx context.Context, places []models.Place) (*string, error) {
 	placeNames := make([]string, len(places))
 	for i, place := range places {
 		var categoryNames []string
@@ -23,11 +23,10 @@ func (s Service) GeneratePlanTitle(places []models.Place) (*string, error) {
 	}
 
 	nGenerate := 5
-	response, err := s.openaiChatCompletionClient.Complete(openai.ChatCompletionRequest{
-		Model: openai.ModelGPT3Turbo,
-		Messages: []openai.ChatCompletionMessage{
+	response, err := s.chatCompletionClient.Complete(ctx, models.ChatCompletionRequest{
+		Messages: []models.ChatMessage{
 			{
-				Role: "system",
+				Role: models.ChatMessageRoleSystem,
 				Content: "あなたはコピーライトを生成するアシスタントです" +
 					"例：相模原図書館（図書館）とスターバックスコーヒー（カフェ）を含むプラン" +
 					"生成するコピーライト：新しい本を買って、カフェでゆっくり読書しませんか" +
@@ -35,11 +34,11 @@ func (s Service) GeneratePlanTitle(places []models.Place) (*string, error) {
 					"最大文字数: 20文字",
 			},
 			{
-				Role:    "system",
+				Role:    models.ChatMessageRoleSystem,
 				Content: fmt.Sprintf("%sを含むプラン", strings.Join(placeNames, "と")),
 			},
 		},
-		N: &nGenerate,
+		N: nGenerate,
 	})
 	if err != nil {
 		return nil, err
@@ -49,12 +48,7 @@ func (s Service) GeneratePlanTitle(places []models.Place) (*string, error) {
 		return nil, fmt.Errorf("response.Choices is empty")
 	}
 
-	choices := make([]openai.ChatCompletionMessage, len(response.Choices))
-	for i, choice := range response.Choices {
-		choices[i] = choice.Message
-	}
-
-	choices = replaceMessageContent(choices)
+	choices := replaceMessageContent(response.Choices)
 
 	choices = filterByMessageLength(choices, 30)
 	if len(choices) == 0 {
@@ -67,7 +61,7 @@ func (s Service) GeneratePlanTitle(places []models.Place) (*string, error) {
 }
 
 // replaceMessageContent メッセージの内容から不要な文字を削除する
-func replaceMessageContent(choices []openai.ChatCompletionMessage) []openai.ChatCompletionMessage {
+func replaceMessageContent(choices []models.ChatCompletionChoice) []models.ChatCompletionChoice {
 	deleteCharacters := []string{"\n", "「", "」", "'", "’", "\"", "”", "：", ":"}
 	for i, choice := range choices {
 		content := choice.Content
@@ -80,8 +74,8 @@ func replaceMessageContent(choices []openai.ChatCompletionMessage) []openai.Chat
 	return choices
 }
 
-func filterByMessageLength(choices []openai.ChatCompletionMessage, length int) []openai.ChatCompletionMessage {
-	filteredChoices := make([]openai.ChatCompletionMessage, 0)
+func filterByMessageLength(choices []models.ChatCompletionChoice, length int) []models.ChatCompletionChoice {
+	filteredChoices := make([]models.ChatCompletionChoice, 0)
 	for _, choice := range choices {
 		// MEMO: len(string) で得られるのはバイト数であり、文字数ではない
 		messageLength := utf8.RuneCountInString(choice.Content)
@@ -92,7 +86,7 @@ func filterByMessageLength(choices []openai.ChatCompletionMessage, length int) [
 	return filteredChoices
 }
 
-func indexOfMaxMessageLength(choices []openai.ChatCompletionMessage) int {
+func indexOfMaxMessageLength(choices []models.ChatCompletionChoice) int {
 	maxLength := 0
 	indexOfMaxLength := 0
 	for i, choice := range choices {
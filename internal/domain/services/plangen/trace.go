@@ -0,0 +1,154 @@
+package plangen
+
+import (
+	"context"
+	"sync"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
+)
+
+type generationTracerKey struct{}
+
+type basePlaceTraceKey struct{}
+
+// GenerationTracer はプランを作成する過程を記録する
+// ctx に設定されていない場合は何も記録しない
+type GenerationTracer struct {
+	mu         sync.Mutex
+	basePlaces []*models.PlanGenerationTraceBasePlace
+}
+
+// WithGenerationTracer は ctx に GenerationTracer を設定する
+// ctx を引き継いで作成されたプランの過程は、返り値の GenerationTracer に記録される
+// フィルタで取り除かれた場所を記録するため、パイプラインの結果に取り除かれた場所を含めるようにする
+func WithGenerationTracer(ctx context.Context) (context.Context, *GenerationTracer) {
+	tracer := &GenerationTracer{}
+	ctx = placefilter.WithPlacesDropped(ctx)
+	return context.WithValue(ctx, generationTracerKey{}, tracer), tracer
+}
+
+func (t *GenerationTracer) Trace() models.PlanGenerationTrace {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	basePlaces := make([]models.PlanGenerationTraceBasePlace, len(t.basePlaces))
+	for i, basePlace := range t.basePlaces {
+		basePlaces[i] = *basePlace
+		basePlaces[i].RejectedPlaces = append([]models.PlanGenerationTraceRejectedPlace{}, basePlace.RejectedPlaces...)
+	}
+	return models.PlanGenerationTrace{BasePlaces: basePlaces}
+}
+
+func generationTracerFromContext(ctx context.Context) *GenerationTracer {
+	tracer, ok := ctx.Value(generationTracerKey{}).(*GenerationTracer)
+	if !ok {
+		return nil
+	}
+	return tracer
+}
+
+// traceBasePlace は場所がプランの起点として検討されたことを記録し、
+// 以降に除外された場所をその起点に紐づけるための ctx を返す
+func traceBasePlace(ctx context.Context, place models.Place, searchRadius *int) context.Context {
+	tracer := generationTracerFromContext(ctx)
+	if tracer == nil {
+		return ctx
+	}
+
+	basePlace := &models.PlanGenerationTraceBasePlace{
+		PlaceId:        place.Id,
+		PlaceName:      place.Google.Name,
+		SearchRadius:   searchRadius,
+		RejectedPlaces: []models.PlanGenerationTraceRejectedPlace{},
+	}
+
+	tracer.mu.Lock()
+	tracer.basePlaces = append(tracer.basePlaces, basePlace)
+	tracer.mu.Unlock()
+
+	return context.WithValue(ctx, basePlaceTraceKey{}, basePlace)
+}
+
+// updateBasePlaceTrace は ctx に紐づく起点の記録を更新する
+func updateBasePlaceTrace(ctx context.Context, update func(basePlace *models.PlanGenerationTraceBasePlace)) {
+	tracer := generationTracerFromContext(ctx)
+	if tracer == nil {
+		return
+	}
+
+	basePlace, ok := ctx.Value(basePlaceTraceKey{}).(*models.PlanGenerationTraceBasePlace)
+	if !ok {
+		return
+	}
+
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	update(basePlace)
+}
+
+func tracePlanCreated(ctx context.Context, numPlacesInPlan int) {
+	updateBasePlaceTrace(ctx, func(basePlace *models.PlanGenerationTraceBasePlace) {
+		basePlace.PlanCreated = true
+		basePlace.NumPlacesInPlan = numPlacesInPlan
+	})
+}
+
+func tracePlanFailed(ctx context.Context, err error) {
+	updateBasePlaceTrace(ctx, func(basePlace *models.PlanGenerationTraceBasePlace) {
+		message := err.Error()
+		basePlace.Error = &message
+	})
+}
+
+// traceRejectedPlace は場所がプランに含められなかった理由を記録する
+// 同じ起点で同じ場所が同じ理由で除外された場合は一度だけ記録する
+func traceRejectedPlace(ctx context.Context, place models.Place, reason models.PlanGenerationRejectReason) {
+	updateBasePlaceTrace(ctx, func(basePlace *models.PlanGenerationTraceBasePlace) {
+		for _, rejected := range basePlace.RejectedPlaces {
+			if rejected.PlaceId == place.Id && rejected.Reason == reason {
+				return
+			}
+		}
+		basePlace.RejectedPlaces = append(basePlace.RejectedPlaces, models.PlanGenerationTraceRejectedPlace{
+			PlaceId:   place.Id,
+			PlaceName: place.Google.Name,
+			Reason:    reason,
+		})
+	})
+}
+
+// traceRejectedPlacesByStages はフィルタのステージで取り除かれた場所のうち、理由を説明できるものを記録する
+func traceRejectedPlacesByStages(ctx context.Context, results []placefilter.StageResult, reasons map[string]models.PlanGenerationRejectReason) {
+	if generationTracerFromContext(ctx) == nil {
+		return
+	}
+
+	for _, result := range results {
+		reason, ok := reasons[result.Name]
+		if !ok {
+			continue
+		}
+		for _, place := range result.PlacesDropped {
+			traceRejectedPlace(ctx, place, reason)
+		}
+	}
+}
+
+// traceBasePlaceSelected は placeId を起点としたプランが採用されたことを記録する
+// 検索範囲を広げて同じ場所が再び検討された場合は、最後に検討されたものを採用されたものとする
+func traceBasePlaceSelected(ctx context.Context, placeId string) {
+	tracer := generationTracerFromContext(ctx)
+	if tracer == nil {
+		return
+	}
+
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+	for i := len(tracer.basePlaces) - 1; i >= 0; i-- {
+		if tracer.basePlaces[i].PlaceId == placeId && tracer.basePlaces[i].PlanCreated {
+			tracer.basePlaces[i].Selected = true
+			return
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package plangen
+
+import (
+	"context"
+	"fmt"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"testing"
+)
+
+func TestGenerationTracer(t *testing.T) {
+	basePlace := models.Place{Id: "base", Google: models.GooglePlace{Name: "Base"}}
+	cafe := models.Place{Id: "cafe", Google: models.GooglePlace{Name: "Cafe"}}
+	spa := models.Place{Id: "spa", Google: models.GooglePlace{Name: "Spa"}}
+
+	ctx, tracer := WithGenerationTracer(context.Background())
+
+	// 起点として検討されたが、プランを作成できなかった場合
+	ctxFailed := traceBasePlace(ctx, basePlace, utils.ToPointer(500))
+	tracePlanFailed(ctxFailed, fmt.Errorf("place start is over budget"))
+
+	// 範囲を広げて再び検討され、プランが作成された場合
+	ctxCreated := traceBasePlace(ctx, basePlace, utils.ToPointer(900))
+	traceRejectedPlace(ctxCreated, cafe, models.PlanGenerationRejectReasonOverTime)
+	traceRejectedPlace(ctxCreated, cafe, models.PlanGenerationRejectReasonOverTime)
+	traceRejectedPlacesByStages(ctxCreated, []placefilter.StageResult{
+		{Name: placefilter.StageNameCategoriesDisliked, PlacesDropped: []models.Place{spa}},
+		{Name: placefilter.StageNameHasPhoto, PlacesDropped: []models.Place{cafe}},
+	}, map[string]models.PlanGenerationRejectReason{
+		placefilter.StageNameCategoriesDisliked: models.PlanGenerationRejectReasonDislikedCategory,
+	})
+	tracePlanCreated(ctxCreated, 3)
+	traceBasePlaceSelected(ctx, basePlace.Id)
+
+	expected := models.PlanGenerationTrace{
+		BasePlaces: []models.PlanGenerationTraceBasePlace{
+			{
+				PlaceId:        "base",
+				PlaceName:      "Base",
+				SearchRadius:   utils.ToPointer(500),
+				Error:          utils.StrPointer("place start is over budget"),
+				RejectedPlaces: []models.PlanGenerationTraceRejectedPlace{},
+			},
+			{
+				PlaceId:         "base",
+				PlaceName:       "Base",
+				SearchRadius:    utils.ToPointer(900),
+				PlanCreated:     true,
+				Selected:        true,
+				NumPlacesInPlan: 3,
+				RejectedPlaces: []models.PlanGenerationTraceRejectedPlace{
+					{PlaceId: "cafe", PlaceName: "Cafe", Reason: models.PlanGenerationRejectReasonOverTime},
+					{PlaceId: "spa", PlaceName: "Spa", Reason: models.PlanGenerationRejectReasonDislikedCategory},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, tracer.Trace()); diff != "" {
+		t.Errorf("Trace() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestGenerationTracer_WithoutTracer(t *testing.T) {
+	// ctx に GenerationTracer が設定されていない場合は何も記録しない
+	ctx := traceBasePlace(context.Background(), models.Place{Id: "base"}, nil)
+	traceRejectedPlace(ctx, models.Place{Id: "cafe"}, models.PlanGenerationRejectReasonOverTime)
+	tracePlanCreated(ctx, 1)
+	traceBasePlaceSelected(ctx, "base")
+
+	if generationTracerFromContext(ctx) != nil {
+		t.Errorf("tracer should not be set")
+	}
+}
@@ -0,0 +1,41 @@
+package plangen
+
+import (
+	"context"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"time"
+)
+
+// FetchWeatherForecast プランを巡る時間帯の天気予報を取得する
+// startTime が指定されていない場合は現在時刻から、freeTime が指定されていない場合は defaultMaxPlanDuration 分の天気予報を取得する
+// 天気予報が取得できない場合は nil を返す（天気を考慮せずにプランを作成する）
+func (s Service) FetchWeatherForecast(ctx context.Context, location models.GeoLocation, startTime *time.Time, freeTime *int) *models.WeatherForecast {
+	startAt := s.clock.Now().In(location.TimeZone())
+	if startTime != nil {
+		startAt = *startTime
+	}
+
+	duration := defaultMaxPlanDuration
+	if freeTime != nil {
+		duration = *freeTime
+	}
+
+	endAt := startAt.Add(time.Duration(duration) * time.Minute)
+	forecast, err := s.weatherProvider.Forecast(ctx, location, startAt, endAt)
+	if err != nil {
+		s.logger.Warn("error while fetching weather forecast", zap.Error(err))
+		return nil
+	}
+
+	if forecast != nil {
+		s.logger.Debug(
+			"weather forecast fetched",
+			zap.Int("precipitationProbability", forecast.PrecipitationProbability),
+			zap.Float64("precipitationInMm", forecast.PrecipitationInMm),
+			zap.Bool("isRainy", forecast.IsRainy()),
+		)
+	}
+
+	return forecast
+}
@@ -34,5 +34,10 @@ func (s Service) BindPlanCandidateSetToUser(ctx context.Context, input BindPlanC
 		return nil, fmt.Errorf("error while updating author of plan candidate to user: %v", err)
 	}
 
+	// 期限切れのプラン候補を削除するときに、ユーザーに紐付けられたものを残す
+	if err := s.planCandidateRepository.BindToUser(ctx, input.UserId, input.PlanCandidateSetIds); err != nil {
+		return nil, fmt.Errorf("error while binding plan candidate set to user: %v", err)
+	}
+
 	return &checkAuthStateResult.User, nil
 }
@@ -2,45 +2,39 @@ package user
 
 import (
 	"context"
-	"database/sql"
-	"fmt"
+
+	firebaseauth "firebase.google.com/go/v4/auth"
 	"poroto.app/poroto/planner/internal/domain/repository"
-	"poroto.app/poroto/planner/internal/infrastructure/auth"
-	"poroto.app/poroto/planner/internal/infrastructure/rdb"
 )
 
-type Service struct {
-	userRepository  repository.UserRepository
-	placeRepository repository.PlaceRepository
-	planRepository  repository.PlanRepository
-	firebaseAuth    *auth.FirebaseAuth
+// FirebaseAuth Firebase Authentication によるユーザーの認証
+type FirebaseAuth interface {
+	// Verify firebaseUid と tokenId から取得されるユーザーが同一であるかを確認する
+	Verify(ctx context.Context, firebaseUid string, tokenId string) (bool, error)
+	GetFirebaseUIDFromTokenId(ctx context.Context, tokenId string) (*string, error)
+	GetUser(ctx context.Context, firebaseUid string) (*firebaseauth.UserRecord, error)
 }
 
-func NewService(ctx context.Context, db *sql.DB) (*Service, error) {
-	userRepository, err := rdb.NewUserRepository(db)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing user repository: %v", err)
-	}
-
-	placeRepository, err := rdb.NewPlaceRepository(db)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing place repository: %v", err)
-	}
-
-	planRepository, err := rdb.NewPlanRepository(db)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing plan repository: %v", err)
-	}
-
-	firebaseAuth, err := auth.NewFirebaseAuth(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing firebase auth: %v", err)
-	}
+type Service struct {
+	userRepository          repository.UserRepository
+	placeRepository         repository.PlaceRepository
+	planRepository          repository.PlanRepository
+	planCandidateRepository repository.PlanCandidateRepository
+	firebaseAuth            FirebaseAuth
+}
 
+func NewService(
+	userRepository repository.UserRepository,
+	placeRepository repository.PlaceRepository,
+	planRepository repository.PlanRepository,
+	planCandidateRepository repository.PlanCandidateRepository,
+	firebaseAuth FirebaseAuth,
+) *Service {
 	return &Service{
-		userRepository:  userRepository,
-		placeRepository: placeRepository,
-		planRepository:  planRepository,
-		firebaseAuth:    firebaseAuth,
-	}, nil
+		userRepository:          userRepository,
+		placeRepository:         placeRepository,
+		planRepository:          planRepository,
+		planCandidateRepository: planCandidateRepository,
+		firebaseAuth:            firebaseAuth,
+	}
 }
@@ -13,6 +13,8 @@ type UpdateUserProfileInput struct {
 	UserId          string
 	Name            string
 	ProfileImageUrl string
+	// PersonalizationDisabled が nil の場合は更新しない
+	PersonalizationDisabled *bool
 }
 
 // UpdateUserProfile ユーザーのプロフィールを更新する
@@ -33,6 +35,12 @@ func (s Service) UpdateUserProfile(ctx context.Context, input UpdateUserProfileI
 		return nil, fmt.Errorf("failed to update user profile: %w", err)
 	}
 
+	if input.PersonalizationDisabled != nil {
+		if err := s.userRepository.UpdatePersonalizationDisabled(ctx, input.UserId, *input.PersonalizationDisabled); err != nil {
+			return nil, fmt.Errorf("failed to update user personalization: %w", err)
+		}
+	}
+
 	user, err := s.userRepository.Find(ctx, input.UserId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find user: %w", err)
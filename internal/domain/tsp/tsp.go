@@ -0,0 +1,334 @@
+package tsp
+
+import "math"
+
+const (
+	// heldKarpMaxNodes Held-Karp 法で厳密解を求めるノード数の上限
+	// 計算量が O(2^n * n^2) となるため、これを超える場合は局所探索で近似解を求める
+	heldKarpMaxNodes = 12
+
+	// permutationMaxNodes 制約付きの問題で全探索を行うノード数の上限
+	permutationMaxNodes = 8
+
+	// orOptMaxSegmentLength Or-opt 法で移動させる区間の長さの上限
+	orOptMaxSegmentLength = 3
+)
+
+// Input 巡回経路を求める問題
+// Cost[i][j] はノード i から j へ移動するときのコスト（非対称でもよい）
+// Start が指定された場合は、経路の最初のノードを固定する
+// End が指定された場合は、経路の最後のノードを固定する
+// IsFeasible が指定された場合は、条件を満たす経路のみを解とする（営業時間などの時間枠制約に用いる）
+type Input struct {
+	Cost       [][]float64
+	Start      *int
+	End        *int
+	IsFeasible func(route []int) bool
+}
+
+// Solve すべてのノードを一度ずつ訪れる経路のうち、コストが最小のものを求める
+// ノード数が少ない場合は厳密解を、多い場合は 2-opt 法・Or-opt 法で改善した近似解を返す
+// IsFeasible を満たす経路が見つからなかった場合は、false を返す
+func Solve(input Input) ([]int, bool) {
+	n := len(input.Cost)
+	if n == 0 {
+		return []int{}, true
+	}
+
+	var route []int
+	if n <= heldKarpMaxNodes {
+		route = input.heldKarp()
+	} else {
+		route = input.improve(input.nearestNeighbour())
+	}
+
+	if input.isFeasible(route) {
+		return route, true
+	}
+
+	// 最短経路が制約を満たさない場合は、制約を満たす経路の中から最短のものを探す
+	if n <= permutationMaxNodes {
+		return input.searchAllFeasible()
+	}
+
+	return input.searchFeasibleByLocalSearch(route)
+}
+
+// RouteCost 経路の総コストを求める
+func RouteCost(cost [][]float64, route []int) float64 {
+	var total float64
+	for i := 0; i < len(route)-1; i++ {
+		total += cost[route[i]][route[i+1]]
+	}
+	return total
+}
+
+func (input Input) isFeasible(route []int) bool {
+	if input.IsFeasible == nil {
+		return true
+	}
+	return input.IsFeasible(route)
+}
+
+// isValidEndpoints 経路が最初と最後のノードの指定を満たしているかを判定する
+func (input Input) isValidEndpoints(route []int) bool {
+	if len(route) == 0 {
+		return true
+	}
+
+	if input.Start != nil && route[0] != *input.Start {
+		return false
+	}
+
+	if input.End != nil && route[len(route)-1] != *input.End {
+		return false
+	}
+
+	return true
+}
+
+// heldKarp 動的計画法で厳密解を求める
+// dp[visited][last] は visited のノードを訪れて last で終わる経路の最小コスト
+func (input Input) heldKarp() []int {
+	n := len(input.Cost)
+	numSubsets := 1 << n
+
+	dp := make([][]float64, numSubsets)
+	parent := make([][]int, numSubsets)
+	for visited := range dp {
+		dp[visited] = make([]float64, n)
+		parent[visited] = make([]int, n)
+		for last := range dp[visited] {
+			dp[visited][last] = math.Inf(1)
+			parent[visited][last] = -1
+		}
+	}
+
+	for i := 0; i < n; i++ {
+		if input.Start != nil && i != *input.Start {
+			continue
+		}
+		if input.End != nil && i == *input.End && n > 1 {
+			continue
+		}
+		dp[1<<i][i] = 0
+	}
+
+	for visited := 1; visited < numSubsets; visited++ {
+		for last := 0; last < n; last++ {
+			if visited&(1<<last) == 0 || math.IsInf(dp[visited][last], 1) {
+				continue
+			}
+
+			for next := 0; next < n; next++ {
+				if visited&(1<<next) != 0 {
+					continue
+				}
+
+				// 最後のノードが固定されている場合は、すべてのノードを訪れるまで訪問しない
+				nextVisited := visited | (1 << next)
+				if input.End != nil && next == *input.End && nextVisited != numSubsets-1 {
+					continue
+				}
+
+				cost := dp[visited][last] + input.Cost[last][next]
+				if cost < dp[nextVisited][next] {
+					dp[nextVisited][next] = cost
+					parent[nextVisited][next] = last
+				}
+			}
+		}
+	}
+
+	all := numSubsets - 1
+	last := -1
+	for i := 0; i < n; i++ {
+		if input.End != nil && i != *input.End {
+			continue
+		}
+		if last == -1 || dp[all][i] < dp[all][last] {
+			last = i
+		}
+	}
+
+	route := make([]int, n)
+	visited := all
+	for i := n - 1; i >= 0; i-- {
+		route[i] = last
+		prev := parent[visited][last]
+		visited &^= 1 << last
+		last = prev
+	}
+
+	return route
+}
+
+// nearestNeighbour 直前のノードから最も近いノードを順に選んで初期解を作る
+func (input Input) nearestNeighbour() []int {
+	n := len(input.Cost)
+	visited := make([]bool, n)
+	route := make([]int, 0, n)
+
+	current := 0
+	if input.Start != nil {
+		current = *input.Start
+	} else if input.End != nil && *input.End == 0 && n > 1 {
+		current = 1
+	}
+	route = append(route, current)
+	visited[current] = true
+
+	for len(route) < n {
+		next := -1
+		for i := 0; i < n; i++ {
+			if visited[i] {
+				continue
+			}
+
+			// 最後のノードが固定されている場合は、最後まで残しておく
+			if input.End != nil && i == *input.End && len(route) < n-1 {
+				continue
+			}
+
+			if next == -1 || input.Cost[current][i] < input.Cost[current][next] {
+				next = i
+			}
+		}
+
+		route = append(route, next)
+		visited[next] = true
+		current = next
+	}
+
+	return route
+}
+
+// improve 2-opt 法と Or-opt 法で改善できなくなるまで経路を改善する
+// 制約を満たす経路は、改善後も制約を満たすものだけを採用する
+func (input Input) improve(route []int) []int {
+	requireFeasible := input.isFeasible(route)
+	accept := func(candidate []int) bool {
+		if !input.isValidEndpoints(candidate) {
+			return false
+		}
+		return !requireFeasible || input.isFeasible(candidate)
+	}
+
+	best := append([]int{}, route...)
+	bestCost := RouteCost(input.Cost, best)
+	for improved := true; improved; {
+		improved = false
+
+		for _, candidate := range input.neighbours(best) {
+			cost := RouteCost(input.Cost, candidate)
+			if cost < bestCost && accept(candidate) {
+				best = candidate
+				bestCost = cost
+				improved = true
+				break
+			}
+		}
+	}
+
+	return best
+}
+
+// neighbours 2-opt 法（区間の反転）と Or-opt 法（区間の移動）で得られる近傍の経路を列挙する
+func (input Input) neighbours(route []int) [][]int {
+	n := len(route)
+
+	// 固定されたノードは移動させない
+	from, to := 0, n
+	if input.Start != nil {
+		from = 1
+	}
+	if input.End != nil {
+		to = n - 1
+	}
+
+	var candidates [][]int
+
+	// 2-opt
+	for i := from; i < to-1; i++ {
+		for j := i + 1; j < to; j++ {
+			candidate := append([]int{}, route...)
+			for l, r := i, j; l < r; l, r = l+1, r-1 {
+				candidate[l], candidate[r] = candidate[r], candidate[l]
+			}
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// Or-opt
+	for length := 1; length <= orOptMaxSegmentLength; length++ {
+		for i := from; i+length <= to; i++ {
+			segment := route[i : i+length]
+			rest := append(append([]int{}, route[:i]...), route[i+length:]...)
+			for j := from; j <= to-length; j++ {
+				if j == i {
+					continue
+				}
+
+				candidate := make([]int, 0, n)
+				candidate = append(candidate, rest[:j]...)
+				candidate = append(candidate, segment...)
+				candidate = append(candidate, rest[j:]...)
+				candidates = append(candidates, candidate)
+			}
+		}
+	}
+
+	return candidates
+}
+
+// searchAllFeasible すべての経路を列挙し、制約を満たすもののうち最短のものを求める
+func (input Input) searchAllFeasible() ([]int, bool) {
+	n := len(input.Cost)
+	route := make([]int, n)
+	for i := range route {
+		route[i] = i
+	}
+
+	var best []int
+	bestCost := math.Inf(1)
+
+	var permute func(k int)
+	permute = func(k int) {
+		if k == n {
+			if !input.isValidEndpoints(route) {
+				return
+			}
+
+			cost := RouteCost(input.Cost, route)
+			if cost < bestCost && input.isFeasible(route) {
+				best = append([]int{}, route...)
+				bestCost = cost
+			}
+			return
+		}
+
+		for i := k; i < n; i++ {
+			route[k], route[i] = route[i], route[k]
+			permute(k + 1)
+			route[k], route[i] = route[i], route[k]
+		}
+	}
+	permute(0)
+
+	if best == nil {
+		return nil, false
+	}
+
+	return best, true
+}
+
+// searchFeasibleByLocalSearch 近傍の経路から制約を満たすものを探し、見つかった場合はさらに改善する
+func (input Input) searchFeasibleByLocalSearch(route []int) ([]int, bool) {
+	for _, candidate := range input.neighbours(route) {
+		if input.isValidEndpoints(candidate) && input.isFeasible(candidate) {
+			return input.improve(candidate), true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,133 @@
+package tsp
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// costOnLine 数直線上の点の間の距離をコストとする
+func costOnLine(points ...float64) [][]float64 {
+	cost := make([][]float64, len(points))
+	for i := range points {
+		cost[i] = make([]float64, len(points))
+		for j := range points {
+			cost[i][j] = math.Abs(points[i] - points[j])
+		}
+	}
+	return cost
+}
+
+func TestSolve(t *testing.T) {
+	intPtr := func(i int) *int { return &i }
+
+	cases := []struct {
+		name       string
+		input      Input
+		expected   []int
+		expectedOk bool
+	}{
+		{
+			name:       "empty",
+			input:      Input{Cost: [][]float64{}},
+			expected:   []int{},
+			expectedOk: true,
+		},
+		{
+			name: "should avoid zig-zag that nearest neighbour produces",
+			input: Input{
+				Cost:  costOnLine(0, 1, -1.5, 3),
+				Start: intPtr(0),
+			},
+			// 貪欲法では 0 -> 1 -> -1.5 -> 3 となる
+			expected:   []int{0, 2, 1, 3},
+			expectedOk: true,
+		},
+		{
+			name: "should end with fixed last node",
+			input: Input{
+				Cost:  costOnLine(0, 1, 2, 3),
+				Start: intPtr(2),
+				End:   intPtr(0),
+			},
+			expected:   []int{2, 3, 1, 0},
+			expectedOk: true,
+		},
+		{
+			name: "should return to start when start and end are the same location",
+			input: Input{
+				Cost:  costOnLine(0, 2, -1, 0),
+				Start: intPtr(0),
+				End:   intPtr(3),
+			},
+			expected:   []int{0, 2, 1, 3},
+			expectedOk: true,
+		},
+		{
+			name: "should choose shortest route satisfying constraint",
+			input: Input{
+				Cost:  costOnLine(0, 1, 2, 4),
+				Start: intPtr(0),
+				IsFeasible: func(route []int) bool {
+					// 最初に 3 を訪れる必要がある
+					return route[1] == 3
+				},
+			},
+			expected:   []int{0, 3, 2, 1},
+			expectedOk: true,
+		},
+		{
+			name: "should return false when no route satisfies constraint",
+			input: Input{
+				Cost:  costOnLine(0, 1, 2),
+				Start: intPtr(0),
+				IsFeasible: func(route []int) bool {
+					return false
+				},
+			},
+			expected:   nil,
+			expectedOk: false,
+		},
+		{
+			name: "should improve route by local search when there are many nodes",
+			input: Input{
+				Cost:  costOnLine(0, 7, 3, 12, 1, 9, 14, 5, 2, 11, 8, 13, 4, 10, 6),
+				Start: intPtr(0),
+			},
+			expected:   []int{0, 4, 8, 2, 12, 7, 14, 1, 10, 5, 13, 9, 3, 11, 6},
+			expectedOk: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, ok := Solve(c.input)
+			if ok != c.expectedOk {
+				t.Fatalf("expected: %v, actual: %v", c.expectedOk, ok)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("(-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSolve_ShouldNotBeWorseThanNearestNeighbour(t *testing.T) {
+	points := []float64{0, 5, -3, 8, -1, 2, -6, 10, 4, -9, 7, 1, -4, 12, -2, 6}
+	input := Input{Cost: costOnLine(points...), Start: func(i int) *int { return &i }(0)}
+
+	route, ok := Solve(input)
+	if !ok {
+		t.Fatalf("expected route to be found")
+	}
+
+	if len(route) != len(points) {
+		t.Fatalf("expected all nodes to be visited, actual: %v", route)
+	}
+
+	if RouteCost(input.Cost, route) > RouteCost(input.Cost, input.nearestNeighbour()) {
+		t.Errorf("expected route not to be worse than nearest neighbour, actual: %v", route)
+	}
+}
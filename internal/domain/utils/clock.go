@@ -0,0 +1,29 @@
+package utils
+
+import "time"
+
+// Clock 現在時刻を返す
+// テストでは固定した時刻を返す FixedClock に差し替える
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock システムの時刻を返す
+type SystemClock struct{}
+
+func NewSystemClock() SystemClock {
+	return SystemClock{}
+}
+
+func (c SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock 常に同じ時刻を返す
+type FixedClock struct {
+	Time time.Time
+}
+
+func (c FixedClock) Now() time.Time {
+	return c.Time
+}
@@ -2,11 +2,14 @@ package openai
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"time"
+
+	"poroto.app/poroto/planner/internal/domain/models"
 )
 
 type ChatCompletionClient struct {
@@ -30,17 +33,78 @@ SEE: https://platform.openai.com/docs/api-reference/chat
 
 const (
 	ModelGPT3Turbo = "gpt-3.5-turbo"
+	ModelGPT4oMini = "gpt-4o-mini"
 )
 
 type ChatCompletionRequest struct {
-	Model    string                  `json:"model"`
-	Messages []ChatCompletionMessage `json:"messages"`
-	N        *int                    `json:"n,omitempty"`
+	Model      string                    `json:"model"`
+	Messages   []ChatCompletionMessage   `json:"messages"`
+	N          *int                      `json:"n,omitempty"`
+	Tools      []ChatCompletionTool      `json:"tools,omitempty"`
+	ToolChoice *ChatCompletionToolChoice `json:"tool_choice,omitempty"`
 }
 
 type ChatCompletionMessage struct {
-	Role    string `json:"role"`
-	Content string `json:"content"`
+	Role      string                   `json:"role"`
+	Content   string                   `json:"content"`
+	ToolCalls []ChatCompletionToolCall `json:"tool_calls,omitempty"`
+}
+
+// ChatCompletionTool モデルが呼び出すことのできる関数
+// SEE: https://platform.openai.com/docs/guides/function-calling
+type ChatCompletionTool struct {
+	Type     string                 `json:"type"`
+	Function ChatCompletionFunction `json:"function"`
+}
+
+// ChatCompletionFunction Parameters には引数の JSON Schema を指定する
+type ChatCompletionFunction struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Parameters  interface{} `json:"parameters"`
+}
+
+// ChatCompletionToolChoice 指定した関数を必ず呼び出すようにする
+type ChatCompletionToolChoice struct {
+	Type     string                           `json:"type"`
+	Function ChatCompletionToolChoiceFunction `json:"function"`
+}
+
+type ChatCompletionToolChoiceFunction struct {
+	Name string `json:"name"`
+}
+
+// ChatCompletionToolCall モデルによる関数の呼び出し
+// Function.Arguments には JSON 形式の引数が文字列として含まれる
+type ChatCompletionToolCall struct {
+	ID       string                         `json:"id"`
+	Type     string                         `json:"type"`
+	Function ChatCompletionToolCallFunction `json:"function"`
+}
+
+type ChatCompletionToolCallFunction struct {
+	Name      string `json:"name"`
+	Arguments string `json:"arguments"`
+}
+
+// NewFunctionTool name の関数を呼び出すことのできるツールを作成する
+func NewFunctionTool(name string, description string, parameters interface{}) ChatCompletionTool {
+	return ChatCompletionTool{
+		Type: "function",
+		Function: ChatCompletionFunction{
+			Name:        name,
+			Description: description,
+			Parameters:  parameters,
+		},
+	}
+}
+
+// NewFunctionToolChoice name の関数を必ず呼び出すようにする
+func NewFunctionToolChoice(name string) *ChatCompletionToolChoice {
+	return &ChatCompletionToolChoice{
+		Type:     "function",
+		Function: ChatCompletionToolChoiceFunction{Name: name},
+	}
 }
 
 type ChatCompletionResponse struct {
@@ -63,7 +127,68 @@ type ChatCompletionChoice struct {
 	Message ChatCompletionMessage `json:"message"`
 }
 
-func (c *ChatCompletionClient) Complete(request ChatCompletionRequest) (*ChatCompletionResponse, error) {
+// Complete models.ChatCompletionRequest を OpenAI の API の形式に変換して文章を生成する
+func (c *ChatCompletionClient) Complete(ctx context.Context, request models.ChatCompletionRequest) (*models.ChatCompletionResponse, error) {
+	response, err := c.complete(ctx, newChatCompletionRequest(request))
+	if err != nil {
+		return nil, err
+	}
+
+	return response.toDomainModel(), nil
+}
+
+// newChatCompletionRequest 関数の呼び出しを行う場合は、関数の呼び出しに対応したモデルを用いる
+func newChatCompletionRequest(request models.ChatCompletionRequest) ChatCompletionRequest {
+	messages := make([]ChatCompletionMessage, len(request.Messages))
+	for i, message := range request.Messages {
+		messages[i] = ChatCompletionMessage{
+			Role:    string(message.Role),
+			Content: message.Content,
+		}
+	}
+
+	chatCompletionRequest := ChatCompletionRequest{
+		Model:    ModelGPT3Turbo,
+		Messages: messages,
+	}
+
+	if request.N > 0 {
+		n := request.N
+		chatCompletionRequest.N = &n
+	}
+
+	if request.Tool != nil {
+		chatCompletionRequest.Model = ModelGPT4oMini
+		chatCompletionRequest.Tools = []ChatCompletionTool{
+			NewFunctionTool(request.Tool.Name, request.Tool.Description, request.Tool.Parameters),
+		}
+		chatCompletionRequest.ToolChoice = NewFunctionToolChoice(request.Tool.Name)
+	}
+
+	return chatCompletionRequest
+}
+
+func (r ChatCompletionResponse) toDomainModel() *models.ChatCompletionResponse {
+	choices := make([]models.ChatCompletionChoice, len(r.Choices))
+	for i, choice := range r.Choices {
+		toolCalls := make([]models.ChatCompletionToolCall, len(choice.Message.ToolCalls))
+		for j, toolCall := range choice.Message.ToolCalls {
+			toolCalls[j] = models.ChatCompletionToolCall{
+				Name:      toolCall.Function.Name,
+				Arguments: toolCall.Function.Arguments,
+			}
+		}
+
+		choices[i] = models.ChatCompletionChoice{
+			Content:   choice.Message.Content,
+			ToolCalls: toolCalls,
+		}
+	}
+
+	return &models.ChatCompletionResponse{Choices: choices}
+}
+
+func (c *ChatCompletionClient) complete(ctx context.Context, request ChatCompletionRequest) (*ChatCompletionResponse, error) {
 	body := request
 
 	var buf bytes.Buffer
@@ -71,7 +196,7 @@ func (c *ChatCompletionClient) Complete(request ChatCompletionRequest) (*ChatCom
 		return nil, fmt.Errorf("error while encoding body: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", "https://api.openai.com/v1/chat/completions", &buf)
+	req, err := http.NewRequestWithContext(ctx, "POST", "https://api.openai.com/v1/chat/completions", &buf)
 	if err != nil {
 		return nil, fmt.Errorf("error while creating request: %v", err)
 	}
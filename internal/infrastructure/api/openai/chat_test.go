@@ -0,0 +1,85 @@
+package openai
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func TestNewChatCompletionRequest(t *testing.T) {
+	parameters := map[string]interface{}{"type": "object"}
+
+	cases := []struct {
+		name     string
+		request  models.ChatCompletionRequest
+		expected ChatCompletionRequest
+	}{
+		{
+			name: "request with multiple choices",
+			request: models.ChatCompletionRequest{
+				Messages: []models.ChatMessage{{Role: models.ChatMessageRoleSystem, Content: "content"}},
+				N:        5,
+			},
+			expected: ChatCompletionRequest{
+				Model:    ModelGPT3Turbo,
+				Messages: []ChatCompletionMessage{{Role: "system", Content: "content"}},
+				N:        utils.ToPointer(5),
+			},
+		},
+		{
+			name: "request with tool",
+			request: models.ChatCompletionRequest{
+				Messages: []models.ChatMessage{{Role: models.ChatMessageRoleUser, Content: "prompt"}},
+				Tool: &models.ChatCompletionTool{
+					Name:        "function",
+					Description: "description",
+					Parameters:  parameters,
+				},
+			},
+			expected: ChatCompletionRequest{
+				Model:      ModelGPT4oMini,
+				Messages:   []ChatCompletionMessage{{Role: "user", Content: "prompt"}},
+				Tools:      []ChatCompletionTool{NewFunctionTool("function", "description", parameters)},
+				ToolChoice: NewFunctionToolChoice("function"),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := newChatCompletionRequest(c.request)
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("newChatCompletionRequest() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestChatCompletionResponse_ToDomainModel(t *testing.T) {
+	response := ChatCompletionResponse{
+		Choices: []ChatCompletionChoice{
+			{
+				Message: ChatCompletionMessage{
+					Role: "assistant",
+					ToolCalls: []ChatCompletionToolCall{
+						{ID: "call", Type: "function", Function: ChatCompletionToolCallFunction{Name: "function", Arguments: `{}`}},
+					},
+				},
+			},
+			{Message: ChatCompletionMessage{Role: "assistant", Content: "content"}},
+		},
+	}
+
+	expected := &models.ChatCompletionResponse{
+		Choices: []models.ChatCompletionChoice{
+			{ToolCalls: []models.ChatCompletionToolCall{{Name: "function", Arguments: `{}`}}},
+			{Content: "content", ToolCalls: []models.ChatCompletionToolCall{}},
+		},
+	}
+
+	if diff := cmp.Diff(expected, response.toDomainModel()); diff != "" {
+		t.Errorf("toDomainModel() mismatch (-want +got):\n%s", diff)
+	}
+}
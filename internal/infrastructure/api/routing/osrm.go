@@ -0,0 +1,162 @@
+package routing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"math"
+	"net/http"
+	"net/url"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"strings"
+	"time"
+)
+
+const (
+	// osrmCacheSize キャッシュする区間の数
+	osrmCacheSize = 10000
+	// osrmCacheCoordinatePrecision キャッシュのキーとする座標の精度（小数点以下5桁で約1メートル）
+	osrmCacheCoordinatePrecision = 1e5
+)
+
+// OsrmRoutingProvider OSRM の Route API と互換性のあるサーバーを用いて移動時間を求める
+// SEE: https://project-osrm.org/docs/v5.24.0/api/#route-service
+// Valhalla 等を用いる場合は OSRM 互換のエンドポイントを baseUrl に指定する
+type OsrmRoutingProvider struct {
+	baseUrl    string
+	httpClient *http.Client
+	fallback   models.RoutingProvider
+
+	// 同じ区間の移動時間を何度も問い合わせないように、最近使われた区間のみをキャッシュする
+	cache *lru.Cache[osrmCacheKey, uint]
+}
+
+// osrmCacheKey 座標を丸めた区間と移動手段
+// 浮動小数点数の誤差程度しか違わない区間を同じものとして扱う
+type osrmCacheKey struct {
+	fromLatitude  int64
+	fromLongitude int64
+	toLatitude    int64
+	toLongitude   int64
+	mode          models.TravelMode
+}
+
+type osrmRouteResponse struct {
+	Code    string      `json:"code"`
+	Message string      `json:"message"`
+	Routes  []osrmRoute `json:"routes"`
+}
+
+type osrmRoute struct {
+	// Duration 移動時間（秒）
+	Duration float64 `json:"duration"`
+	// Distance 移動距離（メートル）
+	Distance float64 `json:"distance"`
+}
+
+func NewOsrmRoutingProvider(baseUrl string) (*OsrmRoutingProvider, error) {
+	if baseUrl == "" {
+		return nil, fmt.Errorf("base url is empty")
+	}
+
+	cache, err := lru.New[osrmCacheKey, uint](osrmCacheSize)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing cache: %v", err)
+	}
+
+	return &OsrmRoutingProvider{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+		fallback:   models.NewHaversineRoutingProvider(),
+		cache:      cache,
+	}, nil
+}
+
+func (o *OsrmRoutingProvider) TravelTimeInMinutes(ctx context.Context, from models.GeoLocation, to models.GeoLocation, mode models.TravelMode) (uint, error) {
+	// OSRM は公共交通機関による経路探索に対応していないため、直線距離から推定する
+	profile, ok := osrmProfileOfTravelMode(mode)
+	if !ok {
+		return o.fallback.TravelTimeInMinutes(ctx, from, to, mode)
+	}
+
+	if from.Equal(to) {
+		return 0, nil
+	}
+
+	key := newOsrmCacheKey(from, to, mode)
+	if duration, found := o.cache.Get(key); found {
+		return duration, nil
+	}
+
+	route, err := o.fetchRoute(ctx, profile, from, to)
+	if err != nil {
+		return 0, err
+	}
+
+	duration := uint(math.Round(route.Duration / 60))
+	o.cache.Add(key, duration)
+
+	return duration, nil
+}
+
+func newOsrmCacheKey(from models.GeoLocation, to models.GeoLocation, mode models.TravelMode) osrmCacheKey {
+	round := func(coordinate float64) int64 {
+		return int64(math.Round(coordinate * osrmCacheCoordinatePrecision))
+	}
+
+	return osrmCacheKey{
+		fromLatitude:  round(from.Latitude),
+		fromLongitude: round(from.Longitude),
+		toLatitude:    round(to.Latitude),
+		toLongitude:   round(to.Longitude),
+		mode:          mode,
+	}
+}
+
+func (o *OsrmRoutingProvider) fetchRoute(ctx context.Context, profile string, from models.GeoLocation, to models.GeoLocation) (*osrmRoute, error) {
+	// 座標は {経度},{緯度} の順で指定する
+	coordinates := fmt.Sprintf("%f,%f;%f,%f", from.Longitude, from.Latitude, to.Longitude, to.Latitude)
+	requestUrl := fmt.Sprintf("%s/route/v1/%s/%s?%s", o.baseUrl, profile, coordinates, url.Values{
+		"overview": []string{"false"},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating request: %v", err)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error while sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var response osrmRouteResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error while decoding response: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || response.Code != "Ok" {
+		return nil, fmt.Errorf("unexpected response(%v): %s %s", resp.StatusCode, response.Code, response.Message)
+	}
+
+	if len(response.Routes) == 0 {
+		return nil, fmt.Errorf("no route found")
+	}
+
+	return &response.Routes[0], nil
+}
+
+func osrmProfileOfTravelMode(mode models.TravelMode) (string, bool) {
+	switch mode {
+	case models.TravelModeWalking:
+		return "foot", true
+	case models.TravelModeCycling:
+		return "bike", true
+	case models.TravelModeDriving:
+		return "car", true
+	default:
+		return "", false
+	}
+}
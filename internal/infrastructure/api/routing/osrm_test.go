@@ -0,0 +1,141 @@
+package routing
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/api/routing/routingtest"
+	"testing"
+)
+
+func TestOsrmRoutingProvider_TravelTimeInMinutes(t *testing.T) {
+	// 東京タワー
+	from := models.GeoLocation{Latitude: 35.658581, Longitude: 139.745433}
+	// 東京スカイツリー
+	to := models.GeoLocation{Latitude: 35.710063, Longitude: 139.8107}
+
+	cases := []struct {
+		name     string
+		from     models.GeoLocation
+		to       models.GeoLocation
+		mode     models.TravelMode
+		expected uint
+	}{
+		{
+			name:     "walking",
+			from:     from,
+			to:       to,
+			mode:     models.TravelModeWalking,
+			expected: 137,
+		},
+		{
+			name:     "driving",
+			from:     from,
+			to:       to,
+			mode:     models.TravelModeDriving,
+			expected: 14,
+		},
+		{
+			name:     "transit is estimated from straight distance",
+			from:     from,
+			to:       to,
+			mode:     models.TravelModeTransit,
+			expected: from.TravelTimeTo(to, models.TravelModeTransit.MeterPerMinute()),
+		},
+		{
+			name:     "same location",
+			from:     from,
+			to:       from,
+			mode:     models.TravelModeWalking,
+			expected: 0,
+		},
+	}
+
+	server := routingtest.NewServer()
+	defer server.Close()
+
+	provider, err := NewOsrmRoutingProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create osrm routing provider: %v", err)
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := provider.TravelTimeInMinutes(context.Background(), c.from, c.to, c.mode)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("TravelTimeInMinutes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOsrmRoutingProvider_TravelTimeInMinutes_Cache(t *testing.T) {
+	server := routingtest.NewServer()
+	defer server.Close()
+
+	provider, err := NewOsrmRoutingProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create osrm routing provider: %v", err)
+	}
+
+	from := models.GeoLocation{Latitude: 35.658581, Longitude: 139.745433}
+	to := models.GeoLocation{Latitude: 35.710063, Longitude: 139.8107}
+	for i := 0; i < 3; i++ {
+		if _, err := provider.TravelTimeInMinutes(context.Background(), from, to, models.TravelModeWalking); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	// 浮動小数点数の誤差程度しか違わない区間はキャッシュを用いる
+	fromWithError := models.GeoLocation{Latitude: from.Latitude + 1e-9, Longitude: from.Longitude - 1e-9}
+	if _, err := provider.TravelTimeInMinutes(context.Background(), fromWithError, to, models.TravelModeWalking); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(1, server.RequestCount()); diff != "" {
+		t.Errorf("RequestCount() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestOsrmRoutingProvider_TravelTimeInMinutes_Error(t *testing.T) {
+	server := routingtest.NewServer()
+	serverUrl := server.URL
+	server.Close()
+
+	provider, err := NewOsrmRoutingProvider(serverUrl)
+	if err != nil {
+		t.Fatalf("failed to create osrm routing provider: %v", err)
+	}
+
+	from := models.GeoLocation{Latitude: 35.658581, Longitude: 139.745433}
+	to := models.GeoLocation{Latitude: 35.710063, Longitude: 139.8107}
+	if _, err := provider.TravelTimeInMinutes(context.Background(), from, to, models.TravelModeWalking); err == nil {
+		t.Errorf("expected error but got nil")
+	}
+}
+
+func TestOsrmRoutingProvider_TravelTimeInMinutes_CacheIsBounded(t *testing.T) {
+	server := routingtest.NewServer()
+	defer server.Close()
+
+	provider, err := NewOsrmRoutingProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create osrm routing provider: %v", err)
+	}
+
+	to := models.GeoLocation{Latitude: 35.710063, Longitude: 139.8107}
+	for i := 0; i < osrmCacheSize+10; i++ {
+		from := models.GeoLocation{Latitude: 35.6 + float64(i)*1e-4, Longitude: 139.7}
+		if _, err := provider.TravelTimeInMinutes(context.Background(), from, to, models.TravelModeWalking); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if diff := cmp.Diff(osrmCacheSize, provider.cache.Len()); diff != "" {
+		t.Errorf("cache size mismatch (-want +got):\n%s", diff)
+	}
+}
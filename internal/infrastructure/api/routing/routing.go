@@ -0,0 +1,23 @@
+package routing
+
+import (
+	"fmt"
+	"os"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// NewRoutingProvider 環境変数 ROUTING_API_BASE_URL が指定されている場合は OSRM 互換のサーバーを用いる
+// 指定されていない場合は直線距離から移動時間を推定する
+func NewRoutingProvider() (models.RoutingProvider, error) {
+	baseUrl := os.Getenv("ROUTING_API_BASE_URL")
+	if baseUrl == "" {
+		return models.NewHaversineRoutingProvider(), nil
+	}
+
+	provider, err := NewOsrmRoutingProvider(baseUrl)
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing osrm routing provider: %v", err)
+	}
+
+	return provider, nil
+}
@@ -0,0 +1,107 @@
+// Package routingtest はテストで用いる OSRM 互換のスタブサーバーを提供する
+package routingtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Server OSRM の Route API を模したサーバー
+// 移動時間は直線距離と profile ごとの速度から計算する
+type Server struct {
+	*httptest.Server
+
+	mu           sync.Mutex
+	requestCount int
+}
+
+// MeterPerSecondOfProfile profile ごとの移動速度（メートル/秒）
+var MeterPerSecondOfProfile = map[string]float64{
+	"foot": 1.0,
+	"bike": 4.0,
+	"car":  10.0,
+}
+
+func NewServer() *Server {
+	s := &Server{}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handleRoute))
+	return s
+}
+
+// RequestCount サーバーが受け付けたリクエストの数
+func (s *Server) RequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requestCount
+}
+
+// handleRoute /route/v1/{profile}/{lng},{lat};{lng},{lat} へのリクエストを処理する
+func (s *Server) handleRoute(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requestCount++
+	s.mu.Unlock()
+
+	segments := strings.Split(strings.TrimPrefix(r.URL.Path, "/"), "/")
+	if len(segments) != 4 || segments[0] != "route" || segments[1] != "v1" {
+		writeResponse(w, http.StatusBadRequest, map[string]interface{}{"code": "InvalidUrl", "message": "invalid url"})
+		return
+	}
+
+	meterPerSecond, ok := MeterPerSecondOfProfile[segments[2]]
+	if !ok {
+		writeResponse(w, http.StatusBadRequest, map[string]interface{}{"code": "InvalidValue", "message": "invalid profile"})
+		return
+	}
+
+	locations, err := parseCoordinates(segments[3])
+	if err != nil || len(locations) != 2 {
+		writeResponse(w, http.StatusBadRequest, map[string]interface{}{"code": "InvalidQuery", "message": "invalid coordinates"})
+		return
+	}
+
+	distance := locations[0].DistanceInMeter(locations[1])
+	writeResponse(w, http.StatusOK, map[string]interface{}{
+		"code": "Ok",
+		"routes": []map[string]float64{
+			{
+				"distance": distance,
+				"duration": distance / meterPerSecond,
+			},
+		},
+	})
+}
+
+func parseCoordinates(coordinates string) ([]models.GeoLocation, error) {
+	var locations []models.GeoLocation
+	for _, coordinate := range strings.Split(coordinates, ";") {
+		lngLat := strings.Split(coordinate, ",")
+		if len(lngLat) != 2 {
+			return nil, fmt.Errorf("invalid coordinate: %s", coordinate)
+		}
+
+		longitude, err := strconv.ParseFloat(lngLat[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid longitude: %v", err)
+		}
+
+		latitude, err := strconv.ParseFloat(lngLat[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latitude: %v", err)
+		}
+
+		locations = append(locations, models.GeoLocation{Latitude: latitude, Longitude: longitude})
+	}
+	return locations, nil
+}
+
+func writeResponse(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,40 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"time"
+)
+
+// FileWeatherProvider Open-Meteo のレスポンスと同じ形式の JSON ファイルから天気予報を取得する
+// 地点によらず同じ天気予報を返すため、テストや開発環境で用いる
+type FileWeatherProvider struct {
+	path string
+}
+
+func NewFileWeatherProvider(path string) (*FileWeatherProvider, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, fmt.Errorf("error while opening weather forecast file: %v", err)
+	}
+
+	return &FileWeatherProvider{
+		path: path,
+	}, nil
+}
+
+func (f FileWeatherProvider) Forecast(ctx context.Context, location models.GeoLocation, startAt time.Time, endAt time.Time) (*models.WeatherForecast, error) {
+	content, err := os.ReadFile(f.path)
+	if err != nil {
+		return nil, fmt.Errorf("error while reading weather forecast file: %v", err)
+	}
+
+	var response openMeteoForecastResponse
+	if err := json.Unmarshal(content, &response); err != nil {
+		return nil, fmt.Errorf("error while decoding weather forecast file: %v", err)
+	}
+
+	return forecastFromResponse(response, startAt, endAt)
+}
@@ -0,0 +1,37 @@
+package weather
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"testing"
+	"time"
+)
+
+func TestFileWeatherProvider_Forecast(t *testing.T) {
+	provider, err := NewFileWeatherProvider("testdata/rainy.json")
+	if err != nil {
+		t.Fatalf("failed to create file weather provider: %v", err)
+	}
+
+	actual, err := provider.Forecast(
+		context.Background(),
+		models.GeoLocation{Latitude: 0, Longitude: 0},
+		time.Date(2024, 7, 1, 3, 0, 0, 0, time.UTC),
+		time.Date(2024, 7, 1, 5, 0, 0, 0, time.UTC),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := &models.WeatherForecast{PrecipitationProbability: 90, PrecipitationInMm: 4.0}
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("Forecast() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewFileWeatherProvider_NotFound(t *testing.T) {
+	if _, err := NewFileWeatherProvider("testdata/not_found.json"); err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
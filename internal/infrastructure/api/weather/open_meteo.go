@@ -0,0 +1,112 @@
+package weather
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"strings"
+	"time"
+)
+
+// openMeteoTimeLayout Open-Meteo の時刻の形式（timezone に UTC を指定する）
+const openMeteoTimeLayout = "2006-01-02T15:04"
+
+// OpenMeteoWeatherProvider Open-Meteo の Forecast API と互換性のあるサーバーを用いて天気予報を取得する
+// SEE: https://open-meteo.com/en/docs
+type OpenMeteoWeatherProvider struct {
+	baseUrl    string
+	httpClient *http.Client
+}
+
+type openMeteoForecastResponse struct {
+	Error  bool                  `json:"error"`
+	Reason string                `json:"reason"`
+	Hourly openMeteoHourlyValues `json:"hourly"`
+}
+
+type openMeteoHourlyValues struct {
+	Time []string `json:"time"`
+	// PrecipitationProbability 降水確率（%）
+	PrecipitationProbability []*int `json:"precipitation_probability"`
+	// Precipitation 降水量（mm）
+	Precipitation []*float64 `json:"precipitation"`
+}
+
+func NewOpenMeteoWeatherProvider(baseUrl string) (*OpenMeteoWeatherProvider, error) {
+	if baseUrl == "" {
+		return nil, fmt.Errorf("base url is empty")
+	}
+
+	return &OpenMeteoWeatherProvider{
+		baseUrl:    strings.TrimSuffix(baseUrl, "/"),
+		httpClient: &http.Client{Timeout: 5 * time.Second},
+	}, nil
+}
+
+func (o OpenMeteoWeatherProvider) Forecast(ctx context.Context, location models.GeoLocation, startAt time.Time, endAt time.Time) (*models.WeatherForecast, error) {
+	requestUrl := fmt.Sprintf("%s/v1/forecast?%s", o.baseUrl, url.Values{
+		"latitude":   []string{fmt.Sprintf("%f", location.Latitude)},
+		"longitude":  []string{fmt.Sprintf("%f", location.Longitude)},
+		"hourly":     []string{"precipitation_probability,precipitation"},
+		"timezone":   []string{"UTC"},
+		"start_hour": []string{startAt.UTC().Truncate(time.Hour).Format(openMeteoTimeLayout)},
+		"end_hour":   []string{endAt.UTC().Format(openMeteoTimeLayout)},
+	}.Encode())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, requestUrl, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error while creating request: %v", err)
+	}
+
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error while sending request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var response openMeteoForecastResponse
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, fmt.Errorf("error while decoding response: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || response.Error {
+		return nil, fmt.Errorf("unexpected response(%v): %s", resp.StatusCode, response.Reason)
+	}
+
+	return forecastFromResponse(response, startAt, endAt)
+}
+
+// forecastFromResponse startAt から endAt までの時刻の値を集計する
+// 該当する時刻がない場合は nil を返す
+func forecastFromResponse(response openMeteoForecastResponse, startAt time.Time, endAt time.Time) (*models.WeatherForecast, error) {
+	startHour := startAt.UTC().Truncate(time.Hour)
+
+	var forecast *models.WeatherForecast
+	for i, timeValue := range response.Hourly.Time {
+		hour, err := time.Parse(openMeteoTimeLayout, timeValue)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing time %s: %v", timeValue, err)
+		}
+
+		if hour.Before(startHour) || hour.After(endAt) {
+			continue
+		}
+
+		if forecast == nil {
+			forecast = &models.WeatherForecast{}
+		}
+
+		if i < len(response.Hourly.PrecipitationProbability) && response.Hourly.PrecipitationProbability[i] != nil {
+			forecast.PrecipitationProbability = max(forecast.PrecipitationProbability, *response.Hourly.PrecipitationProbability[i])
+		}
+
+		if i < len(response.Hourly.Precipitation) && response.Hourly.Precipitation[i] != nil {
+			forecast.PrecipitationInMm = max(forecast.PrecipitationInMm, *response.Hourly.Precipitation[i])
+		}
+	}
+
+	return forecast, nil
+}
@@ -0,0 +1,98 @@
+package weather
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"testing"
+	"time"
+)
+
+func TestOpenMeteoWeatherProvider_Forecast(t *testing.T) {
+	cases := []struct {
+		name     string
+		startAt  time.Time
+		endAt    time.Time
+		expected *models.WeatherForecast
+	}{
+		{
+			name:     "rain is forecast during plan",
+			startAt:  time.Date(2024, 7, 1, 2, 30, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 4, 0, 0, 0, time.UTC),
+			expected: &models.WeatherForecast{PrecipitationProbability: 90, PrecipitationInMm: 4.0},
+		},
+		{
+			name:     "no rain is forecast during plan",
+			startAt:  time.Date(2024, 7, 1, 1, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 1, 2, 0, 0, 0, time.UTC),
+			expected: &models.WeatherForecast{PrecipitationProbability: 20, PrecipitationInMm: 0.0},
+		},
+		{
+			name:     "start time is given in other time zone",
+			startAt:  time.Date(2024, 7, 1, 14, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)),
+			endAt:    time.Date(2024, 7, 1, 14, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)),
+			expected: &models.WeatherForecast{PrecipitationProbability: 40, PrecipitationInMm: 0.3},
+		},
+		{
+			name:     "forecast is not available",
+			startAt:  time.Date(2024, 7, 2, 1, 0, 0, 0, time.UTC),
+			endAt:    time.Date(2024, 7, 2, 2, 0, 0, 0, time.UTC),
+			expected: nil,
+		},
+	}
+
+	response, err := os.ReadFile("testdata/rainy.json")
+	if err != nil {
+		t.Fatalf("failed to read testdata: %v", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/forecast" || r.URL.Query().Get("timezone") != "UTC" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error": true, "reason": "invalid request"}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(response)
+	}))
+	defer server.Close()
+
+	provider, err := NewOpenMeteoWeatherProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create open-meteo weather provider: %v", err)
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual, err := provider.Forecast(context.Background(), models.GeoLocation{Latitude: 35.66, Longitude: 139.74}, c.startAt, c.endAt)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("Forecast() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOpenMeteoWeatherProvider_Forecast_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error": true, "reason": "Latitude must be in range of -90 to 90°."}`))
+	}))
+	defer server.Close()
+
+	provider, err := NewOpenMeteoWeatherProvider(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create open-meteo weather provider: %v", err)
+	}
+
+	startAt := time.Date(2024, 7, 1, 1, 0, 0, 0, time.UTC)
+	if _, err := provider.Forecast(context.Background(), models.GeoLocation{Latitude: 100, Longitude: 0}, startAt, startAt.Add(time.Hour)); err == nil {
+		t.Errorf("expected error, but got nil")
+	}
+}
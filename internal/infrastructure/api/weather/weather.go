@@ -0,0 +1,30 @@
+package weather
+
+import (
+	"fmt"
+	"os"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// NewWeatherProvider 環境変数 WEATHER_API_BASE_URL が指定されている場合は Open-Meteo 互換のサーバーを用いる
+// WEATHER_FORECAST_FILE が指定されている場合は、ファイルに記録された天気予報を用いる
+// どちらも指定されていない場合は天気予報を考慮しない
+func NewWeatherProvider() (models.WeatherProvider, error) {
+	if baseUrl := os.Getenv("WEATHER_API_BASE_URL"); baseUrl != "" {
+		provider, err := NewOpenMeteoWeatherProvider(baseUrl)
+		if err != nil {
+			return nil, fmt.Errorf("error while initializing open-meteo weather provider: %v", err)
+		}
+		return provider, nil
+	}
+
+	if path := os.Getenv("WEATHER_FORECAST_FILE"); path != "" {
+		provider, err := NewFileWeatherProvider(path)
+		if err != nil {
+			return nil, fmt.Errorf("error while initializing file weather provider: %v", err)
+		}
+		return provider, nil
+	}
+
+	return models.NewUnknownWeatherProvider(), nil
+}
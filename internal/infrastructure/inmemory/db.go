@@ -0,0 +1,60 @@
+package inmemory
+
+import (
+	"sync"
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// DB はリポジトリが共有するデータをメモリ上に保持する
+// rdb パッケージのテーブルに対応するデータを保持し、外部キー制約や一意制約も同じように検査する
+// MySQL を用意せずにサービスをテストするときに用いる
+type DB struct {
+	mu sync.Mutex
+
+	// now は作成日時などを記録するときに用いる（MySQL の TIMESTAMP 型と同じく秒単位に切り捨てる）
+	now func() time.Time
+
+	users                      []models.User
+	places                     []*placeRecord
+	placePhotos                []models.PlacePhoto
+	placeRecommendations       []string
+	placeRankingScores         map[string]models.PlaceRankingScore
+	userLikePlaces             []userLikePlaceRecord
+	planCandidateSetLikePlaces []planCandidateSetLikePlaceRecord
+	plans                      []*planRecord
+	planCollages               map[string][]planCollagePhotoRecord
+	trips                      []*tripRecord
+	planCandidateSets          []*planCandidateSetRecord
+}
+
+func NewDB() *DB {
+	return &DB{
+		now:                time.Now,
+		placeRankingScores: make(map[string]models.PlaceRankingScore),
+		planCollages:       make(map[string][]planCollagePhotoRecord),
+	}
+}
+
+// SavePlaceRecommendations は場所を指定してプランを作成するときに、おすすめとして表示する場所を並び順通りに保存する
+// （rdb では place_recommendations テーブルに直接保存されるため、リポジトリには対応するメソッドがない）
+func (db *DB) SavePlaceRecommendations(placeIds ...string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.placeRecommendations = append(db.placeRecommendations, placeIds...)
+}
+
+func (db *DB) currentTime() time.Time {
+	return db.now().Truncate(time.Second)
+}
+
+func (db *DB) findUser(userId string) *models.User {
+	for i := range db.users {
+		if db.users[i].Id == userId {
+			return &db.users[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// FindStaleGooglePlaces は policy のもとで古い情報を持つ場所を、取得し直す優先度の高い順に最大 limit 件取得する
+func (p PlaceRepository) FindStaleGooglePlaces(ctx context.Context, policy models.GooglePlaceRefreshPolicy, now time.Time, limit int) ([]models.GooglePlaceFreshness, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	// rdb と同じく、優先度が同じ場合は取得日時が古い順にする
+	records := append([]*placeRecord{}, p.db.places...)
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].fetchedAt.Before(records[j].fetchedAt)
+	})
+
+	freshnesses := array.Map(records, func(record *placeRecord) models.GooglePlaceFreshness {
+		return models.GooglePlaceFreshness{
+			GooglePlaceId:           record.google.PlaceId,
+			UserRatingsTotal:        record.google.UserRatingsTotal,
+			FetchedAt:               record.fetchedAt,
+			OpeningPeriodsFetchedAt: record.openingPeriodsFetchedAt,
+			ReviewsFetchedAt:        record.reviewsFetchedAt,
+		}
+	})
+
+	return models.SelectGooglePlacesToRefresh(freshnesses, policy, now, limit), nil
+}
+
+// RefreshGooglePlace は Google Places API から取得し直した情報で、保存されている場所の情報を更新する
+// googlePlace.PlaceDetail が nil の場合は、営業時間とレビューは更新しない
+func (p PlaceRepository) RefreshGooglePlace(ctx context.Context, googlePlace models.GooglePlace, fetchedAt time.Time) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlaceByGooglePlaceId(googlePlace.PlaceId)
+	if record == nil {
+		return fmt.Errorf("failed to find google place: %s", googlePlace.PlaceId)
+	}
+
+	record.google.Name = googlePlace.Name
+	record.google.PriceLevel = googlePlace.PriceLevel
+	record.google.Rating = googlePlace.Rating
+	record.google.UserRatingsTotal = googlePlace.UserRatingsTotal
+	record.google.Location = googlePlace.Location
+	record.fetchedAt = fetchedAt
+
+	// Place Detail API では住所を取得していないため、取得できた場合のみ更新する
+	if googlePlace.FormattedAddress != nil {
+		record.google.FormattedAddress = googlePlace.FormattedAddress
+	}
+	if googlePlace.Vicinity != nil {
+		record.google.Vicinity = googlePlace.Vicinity
+	}
+
+	if !slices.Equal(record.google.Types, googlePlace.Types) {
+		record.google.Types = slices.Clone(googlePlace.Types)
+	}
+
+	if googlePlace.PlaceDetail == nil {
+		return nil
+	}
+
+	var openingPeriods []models.GooglePlaceOpeningPeriod
+	if googlePlace.PlaceDetail.OpeningHours != nil {
+		openingPeriods = googlePlace.PlaceDetail.OpeningHours.Periods
+	}
+	record.openingPeriods = append(
+		array.Filter(record.openingPeriods, func(savedOpeningPeriod models.GooglePlaceOpeningPeriod) bool {
+			return slices.Contains(openingPeriods, savedOpeningPeriod)
+		}),
+		array.Filter(openingPeriods, func(openingPeriod models.GooglePlaceOpeningPeriod) bool {
+			return !slices.Contains(record.openingPeriods, openingPeriod)
+		})...,
+	)
+
+	// 投稿者と投稿日時が同じレビューを同じものとみなし、内容を更新する
+	reviewKey := func(review models.GooglePlaceReview) string {
+		return fmt.Sprintf("%s-%d", review.AuthorName, review.Time)
+	}
+	fetchedReviewKeys := array.Map(googlePlace.PlaceDetail.Reviews, reviewKey)
+	savedReviewKeys := array.Map(record.reviews, reviewKey)
+	reviews := array.MapAndFilter(record.reviews, func(savedReview models.GooglePlaceReview) (models.GooglePlaceReview, bool) {
+		i := slices.Index(fetchedReviewKeys, reviewKey(savedReview))
+		if i < 0 {
+			return models.GooglePlaceReview{}, false
+		}
+		return googlePlace.PlaceDetail.Reviews[i], true
+	})
+	record.reviews = append(reviews, array.Filter(googlePlace.PlaceDetail.Reviews, func(review models.GooglePlaceReview) bool {
+		return !array.IsContain(savedReviewKeys, reviewKey(review))
+	})...)
+
+	record.openingPeriodsFetchedAt = &fetchedAt
+	record.reviewsFetchedAt = &fetchedAt
+
+	return nil
+}
@@ -0,0 +1,495 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// placeRecord は場所と、それに対応する Google Places API の情報
+// google には基本情報と種類のみを保持し、写真・レビュー・営業時間はそれぞれのフィールドで保持する
+type placeRecord struct {
+	id                      string
+	name                    string
+	google                  models.GooglePlace
+	photoReferences         []models.GooglePlacePhotoReference
+	photos                  []googlePlacePhotoRecord
+	reviews                 []models.GooglePlaceReview
+	openingPeriods          []models.GooglePlaceOpeningPeriod
+	fetchedAt               time.Time
+	openingPeriodsFetchedAt *time.Time
+	reviewsFetchedAt        *time.Time
+}
+
+// googlePlacePhotoRecord は写真をあるサイズで取得したときの画像
+type googlePlacePhotoRecord struct {
+	photoReference string
+	image          models.Image
+}
+
+type userLikePlaceRecord struct {
+	userId    string
+	placeId   string
+	updatedAt time.Time
+}
+
+type PlaceRepository struct {
+	db *DB
+}
+
+func NewPlaceRepository(db *DB) (*PlaceRepository, error) {
+	return &PlaceRepository{
+		db: db,
+	}, nil
+}
+
+func (p PlaceRepository) SavePlacesFromGooglePlaces(ctx context.Context, googlePlaces ...models.GooglePlace) (*[]models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	googlePlaceIds := array.Map(googlePlaces, func(googlePlace models.GooglePlace) string {
+		return googlePlace.PlaceId
+	})
+
+	fetchedAt := p.db.currentTime()
+	for _, googlePlace := range googlePlaces {
+		if p.db.findPlaceByGooglePlaceId(googlePlace.PlaceId) != nil {
+			continue
+		}
+
+		record := &placeRecord{
+			id:        uuid.New().String(),
+			name:      googlePlace.Name,
+			google:    newGooglePlaceBasicInformation(googlePlace),
+			fetchedAt: fetchedAt,
+		}
+
+		photoReferences := googlePlace.PhotoReferences
+		if googlePlace.PlaceDetail != nil {
+			photoReferences = append(slices.Clone(photoReferences), googlePlace.PlaceDetail.PhotoReferences...)
+		}
+		record.photoReferences = array.DistinctBy(photoReferences, func(photoReference models.GooglePlacePhotoReference) string {
+			return photoReference.PhotoReference
+		})
+
+		if googlePlace.Photos != nil {
+			for _, photo := range *googlePlace.Photos {
+				record.photos = append(record.photos, newGooglePlacePhotoRecords(photo)...)
+			}
+		}
+
+		if googlePlace.PlaceDetail != nil {
+			record.reviews = slices.Clone(googlePlace.PlaceDetail.Reviews)
+			if googlePlace.PlaceDetail.OpeningHours != nil {
+				record.openingPeriods = slices.Clone(googlePlace.PlaceDetail.OpeningHours.Periods)
+			}
+			record.openingPeriodsFetchedAt = &fetchedAt
+			record.reviewsFetchedAt = &fetchedAt
+		}
+
+		p.db.places = append(p.db.places, record)
+	}
+
+	places := make([]models.Place, 0, len(googlePlaceIds))
+	for _, googlePlaceId := range array.DistinctBy(googlePlaceIds, func(googlePlaceId string) string { return googlePlaceId }) {
+		places = append(places, p.db.newPlace(*p.db.findPlaceByGooglePlaceId(googlePlaceId)))
+	}
+
+	return &places, nil
+}
+
+func (p PlaceRepository) Find(ctx context.Context, placeId string) (*models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlace(placeId)
+	if record == nil {
+		return nil, nil
+	}
+
+	place := p.db.newPlace(*record)
+	return &place, nil
+}
+
+func (p PlaceRepository) FindByLocation(ctx context.Context, location models.GeoLocation, radius float64) ([]models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	var places []models.Place
+	for _, record := range p.db.places {
+		if location.DistanceInMeter(record.google.Location) > radius {
+			continue
+		}
+		places = append(places, p.db.newPlace(*record))
+	}
+
+	return places, nil
+}
+
+func (p PlaceRepository) FindByGooglePlaceType(ctx context.Context, googlePlaceType string, baseLocation models.GeoLocation, radius float64) (*[]models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.places, func(record *placeRecord) bool {
+		return array.IsContain(record.google.Types, googlePlaceType) && baseLocation.DistanceInMeter(record.google.Location) <= radius
+	})
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].google.UserRatingsTotal > records[j].google.UserRatingsTotal
+	})
+
+	if len(records) > 100 {
+		records = records[:100]
+	}
+
+	places := array.Map(records, func(record *placeRecord) models.Place {
+		return p.db.newPlace(*record)
+	})
+	return &places, nil
+}
+
+func (p PlaceRepository) FindByGooglePlaceID(ctx context.Context, googlePlaceID string) (*models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlaceByGooglePlaceId(googlePlaceID)
+	if record == nil {
+		return nil, nil
+	}
+
+	place := p.db.newPlace(*record)
+	return &place, nil
+}
+
+func (p PlaceRepository) FindLikePlacesByUserId(ctx context.Context, userId string) (*[]models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	userLikePlaces := array.Filter(p.db.userLikePlaces, func(userLikePlace userLikePlaceRecord) bool {
+		return userLikePlace.userId == userId
+	})
+
+	// いいねした日時の新しい順に並べる
+	sort.SliceStable(userLikePlaces, func(i, j int) bool {
+		return userLikePlaces[i].updatedAt.After(userLikePlaces[j].updatedAt)
+	})
+
+	places := array.MapAndFilter(userLikePlaces, func(userLikePlace userLikePlaceRecord) (models.Place, bool) {
+		record := p.db.findPlace(userLikePlace.placeId)
+		if record == nil {
+			return models.Place{}, false
+		}
+		return p.db.newPlace(*record), true
+	})
+	return &places, nil
+}
+
+func (p PlaceRepository) FindRecommendPlacesForCreatePlan(ctx context.Context) (*[]models.Place, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	places := array.MapAndFilter(p.db.placeRecommendations, func(placeId string) (models.Place, bool) {
+		record := p.db.findPlace(placeId)
+		if record == nil {
+			return models.Place{}, false
+		}
+		return p.db.newPlace(*record), true
+	})
+	return &places, nil
+}
+
+func (p PlaceRepository) SaveGooglePlacePhotos(ctx context.Context, googlePlaceId string, photos []models.GooglePlacePhoto) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlaceByGooglePlaceId(googlePlaceId)
+	if record == nil {
+		return fmt.Errorf("failed to find google place: %s", googlePlaceId)
+	}
+
+	if len(record.photoReferences) == 0 {
+		return fmt.Errorf("google place photo reference is empty")
+	}
+
+	var photoRecordsToSave []googlePlacePhotoRecord
+	for _, photo := range photos {
+		// すでに保存されている場合はスキップ
+		if _, found := array.Find(record.photos, func(photoRecord googlePlacePhotoRecord) bool {
+			return int(photoRecord.image.Width) == photo.Width && int(photoRecord.image.Height) == photo.Height
+		}); found {
+			continue
+		}
+
+		photoRecordsToSave = append(photoRecordsToSave, newGooglePlacePhotoRecords(photo)...)
+	}
+
+	record.photos = append(record.photos, photoRecordsToSave...)
+	return nil
+}
+
+func (p PlaceRepository) SaveGooglePlaceDetail(ctx context.Context, googlePlaceId string, detail models.GooglePlaceDetail) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlaceByGooglePlaceId(googlePlaceId)
+	if record == nil {
+		return fmt.Errorf("failed to find google place: %s", googlePlaceId)
+	}
+
+	fetchedAt := p.db.currentTime()
+
+	// レビュー・営業時間は保存されていない場合のみ保存する
+	if len(record.reviews) == 0 {
+		record.reviews = slices.Clone(detail.Reviews)
+		record.reviewsFetchedAt = &fetchedAt
+	}
+
+	if len(record.openingPeriods) == 0 {
+		if detail.OpeningHours != nil {
+			record.openingPeriods = slices.Clone(detail.OpeningHours.Periods)
+		}
+		record.openingPeriodsFetchedAt = &fetchedAt
+	}
+
+	for _, photoReference := range detail.PhotoReferences {
+		i := slices.IndexFunc(record.photoReferences, func(savedPhotoReference models.GooglePlacePhotoReference) bool {
+			return savedPhotoReference.PhotoReference == photoReference.PhotoReference
+		})
+		if i < 0 {
+			record.photoReferences = append(record.photoReferences, photoReference)
+			continue
+		}
+
+		// すでに紐付けがある場合はスキップ
+		if len(record.photoReferences[i].HTMLAttributions) == 0 {
+			record.photoReferences[i].HTMLAttributions = slices.Clone(photoReference.HTMLAttributions)
+		}
+	}
+
+	return nil
+}
+
+func (p PlaceRepository) SavePlacePhotos(ctx context.Context, photos []models.PlacePhoto) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	for _, photo := range photos {
+		if p.db.findPlace(photo.PlaceId) == nil {
+			return fmt.Errorf("failed to save place photos: place not found: %s", photo.PlaceId)
+		}
+		if p.db.findUser(photo.UserId) == nil {
+			return fmt.Errorf("failed to save place photos: user not found: %s", photo.UserId)
+		}
+	}
+
+	now := p.db.currentTime()
+	for _, photo := range photos {
+		if _, found := array.Find(p.db.placePhotos, func(savedPhoto models.PlacePhoto) bool {
+			return savedPhoto.PhotoUrl == photo.PhotoUrl
+		}); found {
+			continue
+		}
+
+		photo.CreatedAt = now
+		photo.UpdatedAt = now
+		p.db.placePhotos = append(p.db.placePhotos, photo)
+	}
+
+	return nil
+}
+
+func (p PlaceRepository) UpdateLikeByUserId(ctx context.Context, userId string, placeId string, like bool) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	if !like {
+		// いいねを取り消す
+		p.db.userLikePlaces = array.Filter(p.db.userLikePlaces, func(userLikePlace userLikePlaceRecord) bool {
+			return userLikePlace.userId != userId || userLikePlace.placeId != placeId
+		})
+		return nil
+	}
+
+	if p.db.findUser(userId) == nil {
+		return fmt.Errorf("failed to insert place like: user not found: %s", userId)
+	}
+
+	if p.db.findPlace(placeId) == nil {
+		return fmt.Errorf("failed to insert place like: place not found: %s", placeId)
+	}
+
+	if _, found := array.Find(p.db.userLikePlaces, func(userLikePlace userLikePlaceRecord) bool {
+		return userLikePlace.userId == userId && userLikePlace.placeId == placeId
+	}); found {
+		return fmt.Errorf("failed to insert place like: place %s is already liked by user %s", placeId, userId)
+	}
+
+	p.db.userLikePlaces = append(p.db.userLikePlaces, userLikePlaceRecord{
+		userId:    userId,
+		placeId:   placeId,
+		updatedAt: p.db.now(),
+	})
+	return nil
+}
+
+func (p PlaceRepository) UpdateLikeByPlanCandidateSetToUser(ctx context.Context, userId string, planCandidateSetIds []string) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	likePlacesByPlanCandidateSet := array.Filter(p.db.planCandidateSetLikePlaces, func(likePlace planCandidateSetLikePlaceRecord) bool {
+		return array.IsContain(planCandidateSetIds, likePlace.planCandidateSetId)
+	})
+	likePlacesByPlanCandidateSet = array.DistinctBy(likePlacesByPlanCandidateSet, func(likePlace planCandidateSetLikePlaceRecord) string {
+		return likePlace.placeId
+	})
+
+	if len(likePlacesByPlanCandidateSet) > 0 && p.db.findUser(userId) == nil {
+		return fmt.Errorf("failed to insert user like places: user not found: %s", userId)
+	}
+
+	// ユーザーとしていいねを登録
+	for _, likePlace := range likePlacesByPlanCandidateSet {
+		// すでにいいね済みの場合はスキップ
+		if _, found := array.Find(p.db.userLikePlaces, func(userLikePlace userLikePlaceRecord) bool {
+			return userLikePlace.userId == userId && userLikePlace.placeId == likePlace.placeId
+		}); found {
+			continue
+		}
+
+		p.db.userLikePlaces = append(p.db.userLikePlaces, userLikePlaceRecord{
+			userId:    userId,
+			placeId:   likePlace.placeId,
+			updatedAt: p.db.now(),
+		})
+	}
+
+	// プラン候補セットとしていいねした記録を削除
+	p.db.planCandidateSetLikePlaces = array.Filter(p.db.planCandidateSetLikePlaces, func(likePlace planCandidateSetLikePlaceRecord) bool {
+		return !array.IsContain(planCandidateSetIds, likePlace.planCandidateSetId)
+	})
+
+	return nil
+}
+
+func (db *DB) findPlace(placeId string) *placeRecord {
+	for _, record := range db.places {
+		if record.id == placeId {
+			return record
+		}
+	}
+	return nil
+}
+
+func (db *DB) findPlaceByGooglePlaceId(googlePlaceId string) *placeRecord {
+	for _, record := range db.places {
+		if record.google.PlaceId == googlePlaceId {
+			return record
+		}
+	}
+	return nil
+}
+
+// newPlace は rdb と同じく、保存されている情報から models.Place を組み立てる
+func (db *DB) newPlace(record placeRecord) models.Place {
+	googlePlace := record.google
+	googlePlace.Types = slices.Clone(record.google.Types)
+	googlePlace.PhotoReferences = slices.Clone(record.photoReferences)
+
+	// 写真は写真ごとに、最も小さい画像を Small に、最も大きい画像を Large に設定する
+	if len(record.photoReferences) > 0 {
+		photos := make([]models.GooglePlacePhoto, 0, len(record.photoReferences))
+		for _, photoReference := range record.photoReferences {
+			photoRecords := array.Filter(record.photos, func(photoRecord googlePlacePhotoRecord) bool {
+				return photoRecord.photoReference == photoReference.PhotoReference
+			})
+			if len(photoRecords) == 0 {
+				continue
+			}
+
+			sort.SliceStable(photoRecords, func(i, j int) bool {
+				return photoRecords[i].image.Width < photoRecords[j].image.Width
+			})
+			imageSmall := photoRecords[0].image
+			imageSmall.IsGooglePhotos = true
+			imageLarge := photoRecords[len(photoRecords)-1].image
+			imageLarge.IsGooglePhotos = true
+			photos = append(photos, models.GooglePlacePhoto{
+				PhotoReference:   photoReference.PhotoReference,
+				Width:            photoReference.Width,
+				Height:           photoReference.Height,
+				HTMLAttributions: slices.Clone(photoReference.HTMLAttributions),
+				Small:            &imageSmall,
+				Large:            &imageLarge,
+			})
+		}
+		googlePlace.Photos = &photos
+	}
+
+	if len(record.reviews) > 0 || len(record.openingPeriods) > 0 || len(record.photoReferences) > 0 {
+		googlePlace.PlaceDetail = &models.GooglePlaceDetail{
+			OpeningHours:    &models.GooglePlaceOpeningHours{Periods: slices.Clone(record.openingPeriods)},
+			Reviews:         slices.Clone(record.reviews),
+			PhotoReferences: slices.Clone(record.photoReferences),
+		}
+	}
+
+	return models.Place{
+		Id:       record.id,
+		Name:     record.name,
+		Location: googlePlace.Location,
+		Address:  googlePlace.Vicinity,
+		Google:   googlePlace,
+		PlacePhotos: array.Filter(db.placePhotos, func(photo models.PlacePhoto) bool {
+			return photo.PlaceId == record.id
+		}),
+		LikeCount: db.countPlaceLikes(record.id),
+	}
+}
+
+// countPlaceLikes はプラン候補とユーザーによるいいねの総数をカウントする
+func (db *DB) countPlaceLikes(placeId string) int {
+	likeCount := 0
+	for _, likePlace := range db.planCandidateSetLikePlaces {
+		if likePlace.placeId == placeId {
+			likeCount++
+		}
+	}
+	for _, userLikePlace := range db.userLikePlaces {
+		if userLikePlace.placeId == placeId {
+			likeCount++
+		}
+	}
+	return likeCount
+}
+
+// newGooglePlaceBasicInformation は写真・詳細情報を除いた Google Places API の情報を返す
+func newGooglePlaceBasicInformation(googlePlace models.GooglePlace) models.GooglePlace {
+	return models.GooglePlace{
+		PlaceId:          googlePlace.PlaceId,
+		Name:             googlePlace.Name,
+		Types:            slices.Clone(googlePlace.Types),
+		Location:         googlePlace.Location,
+		PriceLevel:       googlePlace.PriceLevel,
+		Rating:           googlePlace.Rating,
+		UserRatingsTotal: googlePlace.UserRatingsTotal,
+		Vicinity:         googlePlace.Vicinity,
+		FormattedAddress: googlePlace.FormattedAddress,
+	}
+}
+
+func newGooglePlacePhotoRecords(photo models.GooglePlacePhoto) []googlePlacePhotoRecord {
+	var photoRecords []googlePlacePhotoRecord
+	if photo.Small != nil {
+		photoRecords = append(photoRecords, googlePlacePhotoRecord{photoReference: photo.PhotoReference, image: *photo.Small})
+	}
+	if photo.Large != nil {
+		photoRecords = append(photoRecords, googlePlacePhotoRecord{photoReference: photo.PhotoReference, image: *photo.Large})
+	}
+	return photoRecords
+}
@@ -0,0 +1,83 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func (p PlaceRepository) FindRankingSignals(ctx context.Context) ([]models.PlaceRankingSignals, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	signals := make([]models.PlaceRankingSignals, 0, len(p.db.places))
+	for _, record := range p.db.places {
+		signals = append(signals, models.PlaceRankingSignals{
+			PlaceId:                record.id,
+			GoogleRating:           record.google.Rating,
+			GoogleUserRatingsTotal: record.google.UserRatingsTotal,
+			LikeCount:              p.db.countPlaceLikes(record.id),
+			SavedPlanCount:         p.db.countSavedPlansIncludingPlace(record.id),
+		})
+	}
+
+	return signals, nil
+}
+
+func (p PlaceRepository) SaveRankingScores(ctx context.Context, scores []models.PlaceRankingScore) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	for _, score := range scores {
+		if p.db.findPlace(score.PlaceId) == nil {
+			return fmt.Errorf("failed to upsert place ranking score: place not found: %s", score.PlaceId)
+		}
+	}
+
+	for _, score := range scores {
+		p.db.placeRankingScores[score.PlaceId] = score
+	}
+
+	return nil
+}
+
+func (p PlaceRepository) FindRankingScoresByPlaceIds(ctx context.Context, placeIds []string) (map[string]float64, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	scores := make(map[string]float64, len(placeIds))
+	for _, placeId := range placeIds {
+		score, ok := p.db.placeRankingScores[placeId]
+		if !ok {
+			continue
+		}
+		scores[placeId] = score.Score
+	}
+
+	return scores, nil
+}
+
+func (p PlaceRepository) CountSavedPlansByPlaceIds(ctx context.Context, placeIds []string) (map[string]int, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	savedPlanCounts := make(map[string]int, len(placeIds))
+	for _, placeId := range placeIds {
+		savedPlanCount := p.db.countSavedPlansIncludingPlace(placeId)
+		if savedPlanCount == 0 {
+			continue
+		}
+		savedPlanCounts[placeId] = savedPlanCount
+	}
+
+	return savedPlanCounts, nil
+}
+
+// countSavedPlansIncludingPlace は場所が保存されたプランに含まれている回数をカウントする
+func (db *DB) countSavedPlansIncludingPlace(placeId string) int {
+	return len(array.Filter(db.plans, func(record *planRecord) bool {
+		return array.IsContain(record.placeIds, placeId)
+	}))
+}
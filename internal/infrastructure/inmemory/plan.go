@@ -0,0 +1,350 @@
+package inmemory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/repository"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+// planRecord は保存されたプラン
+// location はプランの最初の場所の位置
+type planRecord struct {
+	id           string
+	name         string
+	description  *string
+	authorId     *string
+	parentPlanId *string
+	placeIds     []string
+	location     models.GeoLocation
+	version      int
+	visibility   models.PlanVisibility
+	createdAt    time.Time
+}
+
+type planCollagePhotoRecord struct {
+	placeId       string
+	placePhotoUrl string
+}
+
+type PlanRepository struct {
+	db *DB
+}
+
+func NewPlanRepository(db *DB) (*PlanRepository, error) {
+	return &PlanRepository{
+		db: db,
+	}, nil
+}
+
+func (p PlanRepository) Save(ctx context.Context, plan *models.Plan) error {
+	// TODO: ポインタ型の引数にしない
+	if plan == nil {
+		return nil
+	}
+
+	if len(plan.Places) == 0 {
+		return fmt.Errorf("plan places is empty")
+	}
+
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record, err := p.db.newPlanRecord(*plan)
+	if err != nil {
+		return err
+	}
+
+	p.db.plans = append(p.db.plans, record)
+	return nil
+}
+
+// newPlanRecord は保存するプランを検証し、planRecord を作成する
+func (db *DB) newPlanRecord(plan models.Plan) (*planRecord, error) {
+	if plan.Id == "" {
+		plan.Id = uuid.New().String()
+	}
+
+	if db.findPlan(plan.Id) != nil {
+		return nil, fmt.Errorf("failed to insert plan: plan %s already exists", plan.Id)
+	}
+
+	var authorId *string
+	if plan.Author != nil {
+		if db.findUser(plan.Author.Id) == nil {
+			return nil, fmt.Errorf("failed to insert plan: user not found: %s", plan.Author.Id)
+		}
+		authorId = utils.ToPointer(plan.Author.Id)
+	}
+
+	for _, place := range plan.Places {
+		if db.findPlace(place.Id) == nil {
+			return nil, fmt.Errorf("failed to insert plan places: place not found: %s", place.Id)
+		}
+	}
+
+	// Visibility が空文字の場合は rdb のカラムのデフォルト値と同じく公開とする
+	visibility := plan.Visibility
+	if visibility == "" {
+		visibility = models.PlanVisibilityPublic
+	}
+
+	return &planRecord{
+		id:           plan.Id,
+		name:         plan.Name,
+		description:  utils.StrCopyPointerValue(plan.Description),
+		authorId:     authorId,
+		parentPlanId: utils.StrCopyPointerValue(plan.ParentPlanId),
+		placeIds: array.Map(plan.Places, func(place models.Place) string {
+			return place.Id
+		}),
+		location:   plan.Places[0].Location,
+		version:    plan.Version,
+		visibility: visibility,
+		createdAt:  db.currentTime(),
+	}, nil
+}
+
+func (p PlanRepository) SortedByCreatedAt(ctx context.Context, queryCursor *repository.SortedByCreatedAtQueryCursor, limit int) (*[]models.Plan, *repository.SortedByCreatedAtQueryCursor, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.plans, func(record *planRecord) bool {
+		return record.visibility == models.PlanVisibilityPublic
+	})
+
+	if queryCursor != nil {
+		unixTime, err := strconv.ParseInt(string(*queryCursor), 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid query cursor: %s", *queryCursor)
+		}
+		createdBefore := time.Unix(unixTime, 0)
+		records = array.Filter(records, func(record *planRecord) bool {
+			return record.createdAt.Before(createdBefore)
+		})
+	}
+
+	sortPlanRecordsByCreatedAtDesc(records)
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	if len(records) == 0 {
+		return &[]models.Plan{}, nil, nil
+	}
+
+	plans := p.db.newPlans(records)
+
+	var nextQueryCursor *repository.SortedByCreatedAtQueryCursor
+	if len(plans) == limit {
+		qc := repository.SortedByCreatedAtQueryCursor(fmt.Sprintf("%d", records[limit-1].createdAt.Unix()))
+		nextQueryCursor = &qc
+	}
+
+	return &plans, nextQueryCursor, nil
+}
+
+func (p PlanRepository) Find(ctx context.Context, planId string, viewerId *string) (*models.Plan, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlan(planId)
+	if record == nil || !record.isVisibleTo(viewerId) {
+		return nil, fmt.Errorf("failed to find plan: %w", sql.ErrNoRows)
+	}
+
+	plan := p.db.newPlan(*record)
+	return &plan, nil
+}
+
+func (p PlanRepository) FindByAuthorId(ctx context.Context, authorId string, publicOnly bool) (*[]models.Plan, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.plans, func(record *planRecord) bool {
+		if record.authorId == nil || *record.authorId != authorId {
+			return false
+		}
+		return !publicOnly || record.visibility == models.PlanVisibilityPublic
+	})
+	sortPlanRecordsByCreatedAtDesc(records)
+
+	plans := p.db.newPlans(records)
+	return &plans, nil
+}
+
+func (p PlanRepository) FindRecentByAuthorId(ctx context.Context, authorId string, limit int) (*[]models.Plan, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.plans, func(record *planRecord) bool {
+		return record.authorId != nil && *record.authorId == authorId
+	})
+	sortPlanRecordsByCreatedAtDesc(records)
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	plans := p.db.newPlans(records)
+	return &plans, nil
+}
+
+func (p PlanRepository) FindByLocation(ctx context.Context, location models.GeoLocation, limit int, searchRange int) (*[]models.Plan, *string, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.plans, func(record *planRecord) bool {
+		return record.visibility == models.PlanVisibilityPublic && location.DistanceInMeter(record.location) <= float64(searchRange)
+	})
+	sortPlanRecordsByCreatedAtDesc(records)
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	plans := p.db.newPlans(records)
+	return &plans, nil, nil
+}
+
+func (p PlanRepository) UpdatePlanAuthorUserByPlanCandidateSet(ctx context.Context, userId string, planCandidateSetIds []string) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	var planCandidateIds []string
+	for _, planCandidateSet := range p.db.planCandidateSets {
+		if !array.IsContain(planCandidateSetIds, planCandidateSet.id) {
+			continue
+		}
+		for _, planCandidate := range planCandidateSet.plans {
+			planCandidateIds = append(planCandidateIds, planCandidate.id)
+		}
+	}
+
+	savedPlanWoAuthorRecords := array.Filter(p.db.plans, func(record *planRecord) bool {
+		return array.IsContain(planCandidateIds, record.id) && record.authorId == nil
+	})
+
+	if len(savedPlanWoAuthorRecords) > 0 && p.db.findUser(userId) == nil {
+		return fmt.Errorf("failed to update plans: user not found: %s", userId)
+	}
+
+	for _, record := range savedPlanWoAuthorRecords {
+		record.authorId = utils.ToPointer(userId)
+	}
+
+	return nil
+}
+
+func (p PlanRepository) FindCollage(ctx context.Context, planId string) (*models.PlanCollage, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	planCollagePhotos, ok := p.db.planCollages[planId]
+	if !ok {
+		return nil, nil
+	}
+
+	var images []models.PlanCollageImage
+	for _, planCollagePhoto := range planCollagePhotos {
+		images = append(images, models.PlanCollageImage{
+			PlaceId: planCollagePhoto.placeId,
+			Image: models.ImageSmallLarge{
+				Small:          utils.ToPointer(planCollagePhoto.placePhotoUrl),
+				Large:          utils.ToPointer(planCollagePhoto.placePhotoUrl),
+				IsGooglePhotos: false,
+			},
+		})
+	}
+	return &models.PlanCollage{
+		Images: images,
+	}, nil
+}
+
+func (p PlanRepository) UpdateCollageImage(ctx context.Context, planId string, placeId string, placePhotoUrl string) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	if p.db.findPlan(planId) == nil {
+		return fmt.Errorf("failed to insert plan collage: plan not found: %s", planId)
+	}
+
+	if _, found := array.Find(p.db.placePhotos, func(photo models.PlacePhoto) bool {
+		return photo.PlaceId == placeId && photo.PhotoUrl == placePhotoUrl
+	}); !found {
+		return fmt.Errorf("place photo should be saved before updating plan collage: %w", sql.ErrNoRows)
+	}
+
+	// すでに登録されている場合は置き換える
+	planCollagePhotos := array.Filter(p.db.planCollages[planId], func(planCollagePhoto planCollagePhotoRecord) bool {
+		return planCollagePhoto.placeId != placeId
+	})
+	p.db.planCollages[planId] = append(planCollagePhotos, planCollagePhotoRecord{
+		placeId:       placeId,
+		placePhotoUrl: placePhotoUrl,
+	})
+
+	return nil
+}
+
+// isVisibleTo 非公開のプランは作者のみが閲覧できる
+func (r planRecord) isVisibleTo(viewerId *string) bool {
+	if r.visibility != models.PlanVisibilityPrivate {
+		return true
+	}
+	return viewerId != nil && r.authorId != nil && *r.authorId == *viewerId
+}
+
+func (db *DB) findPlan(planId string) *planRecord {
+	for _, record := range db.plans {
+		if record.id == planId {
+			return record
+		}
+	}
+	return nil
+}
+
+func (db *DB) newPlans(records []*planRecord) []models.Plan {
+	return array.Map(records, func(record *planRecord) models.Plan {
+		return db.newPlan(*record)
+	})
+}
+
+// newPlan は rdb と同じく、親プランの ID を含めずに models.Plan を組み立てる
+func (db *DB) newPlan(record planRecord) models.Plan {
+	var author *models.User
+	if record.authorId != nil {
+		if user := db.findUser(*record.authorId); user != nil {
+			userCopied := *user
+			author = &userCopied
+		}
+	}
+
+	return models.Plan{
+		Id:          record.id,
+		Name:        record.name,
+		Description: utils.StrCopyPointerValue(record.description),
+		Places: array.Map(record.placeIds, func(placeId string) models.Place {
+			return db.newPlace(*db.findPlace(placeId))
+		}),
+		Author:     author,
+		Version:    record.version,
+		Visibility: record.visibility,
+	}
+}
+
+// sortPlanRecordsByCreatedAtDesc は作成日時の降順に並び替える（作成日時が同じ場合は ID の降順）
+func sortPlanRecordsByCreatedAtDesc(records []*planRecord) {
+	sort.SliceStable(records, func(i, j int) bool {
+		if !records[i].createdAt.Equal(records[j].createdAt) {
+			return records[i].createdAt.After(records[j].createdAt)
+		}
+		return records[i].id > records[j].id
+	})
+}
@@ -0,0 +1,337 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+// planCandidateSetRecord はプラン候補と、それに含まれるプラン・編集履歴・グループ
+// metaData は UpdatePlanCandidateMetaData が呼ばれるまで nil
+type planCandidateSetRecord struct {
+	id              string
+	expiresAt       time.Time
+	isPlaceSearched bool
+	userId          *string
+	metaData        *models.PlanCandidateMetaData
+	plans           []*planCandidateRecord
+	editHistories   models.PlanCandidateEditHistories
+	group           *models.PlanCandidateGroup
+}
+
+type planCandidateRecord struct {
+	id           string
+	name         string
+	parentPlanId *string
+	placeIds     []string
+}
+
+type planCandidateSetLikePlaceRecord struct {
+	planCandidateSetId string
+	placeId            string
+}
+
+type PlanCandidateRepository struct {
+	db *DB
+}
+
+func NewPlanCandidateRepository(db *DB) (*PlanCandidateRepository, error) {
+	return &PlanCandidateRepository{
+		db: db,
+	}, nil
+}
+
+func (p PlanCandidateRepository) Create(cxt context.Context, planCandidateSetId string, expiresAt time.Time) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	if p.db.findPlanCandidateSet(planCandidateSetId) != nil {
+		return fmt.Errorf("failed to insert plan candidate: plan candidate set %s already exists", planCandidateSetId)
+	}
+
+	p.db.planCandidateSets = append(p.db.planCandidateSets, &planCandidateSetRecord{
+		id:        planCandidateSetId,
+		expiresAt: expiresAt,
+	})
+	return nil
+}
+
+func (p PlanCandidateRepository) Find(ctx context.Context, planCandidateSetId string, now time.Time) (*models.PlanCandidateSet, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil || !record.expiresAt.After(now) {
+		return nil, nil
+	}
+
+	metaData := models.PlanCandidateMetaData{}
+	if record.metaData != nil {
+		metaData = *record.metaData
+	}
+
+	likedPlaceIds := array.MapAndFilter(p.db.planCandidateSetLikePlaces, func(likePlace planCandidateSetLikePlaceRecord) (string, bool) {
+		return likePlace.placeId, likePlace.planCandidateSetId == planCandidateSetId
+	})
+
+	return &models.PlanCandidateSet{
+		Id: record.id,
+		Plans: array.Map(record.plans, func(planCandidate *planCandidateRecord) models.Plan {
+			return p.db.newPlanCandidate(*planCandidate)
+		}),
+		MetaData:        metaData,
+		IsPlaceSearched: record.isPlaceSearched,
+		ExpiresAt:       record.expiresAt,
+		LikedPlaceIds:   likedPlaceIds,
+	}, nil
+}
+
+// FindPlan は rdb と同じく、プラン候補の ID によらずプランの ID で検索する
+func (p PlanCandidateRepository) FindPlan(ctx context.Context, planCandidateSetId string, planId string) (*models.Plan, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	for _, planCandidateSet := range p.db.planCandidateSets {
+		for _, planCandidate := range planCandidateSet.plans {
+			if planCandidate.id == planId {
+				plan := p.db.newPlanCandidate(*planCandidate)
+				return &plan, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func (p PlanCandidateRepository) AddPlan(ctx context.Context, planCandidateSetId string, plans ...models.Plan) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return fmt.Errorf("failed to insert plan candidate: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	for _, plan := range plans {
+		for _, place := range plan.Places {
+			if p.db.findPlace(place.Id) == nil {
+				return fmt.Errorf("failed to insert plan candidate place: place not found: %s", place.Id)
+			}
+		}
+	}
+
+	for _, plan := range plans {
+		record.plans = append(record.plans, &planCandidateRecord{
+			id:           plan.Id,
+			name:         plan.Name,
+			parentPlanId: utils.StrCopyPointerValue(plan.ParentPlanId),
+			placeIds: array.Map(plan.Places, func(place models.Place) string {
+				return place.Id
+			}),
+		})
+	}
+
+	return nil
+}
+
+func (p PlanCandidateRepository) AddPlaceToPlan(ctx context.Context, planCandidateSetId string, planId string, previousPlaceId string, place models.Place) error {
+	return p.editPlaces(planCandidateSetId, planId, models.PlanCandidateEditTypeAddPlace, func(placeIds []string) ([]string, error) {
+		if p.db.findPlace(place.Id) == nil {
+			return nil, fmt.Errorf("failed to insert plan candidate place: place not found: %s", place.Id)
+		}
+
+		// rdb と同じく、直前の場所が見つからない場合は先頭に追加する
+		i := slices.Index(placeIds, previousPlaceId)
+		return slices.Insert(placeIds, i+1, place.Id), nil
+	})
+}
+
+func (p PlanCandidateRepository) RemovePlaceFromPlan(ctx context.Context, planCandidateSetId string, planId string, placeId string) error {
+	return p.editPlaces(planCandidateSetId, planId, models.PlanCandidateEditTypeRemovePlace, func(placeIds []string) ([]string, error) {
+		// もともと存在しない場所を削除しようとした場合は何もしない
+		if !array.IsContain(placeIds, placeId) {
+			return nil, nil
+		}
+
+		return slices.DeleteFunc(placeIds, func(id string) bool {
+			return id == placeId
+		}), nil
+	})
+}
+
+func (p PlanCandidateRepository) UpdatePlacesOrder(ctx context.Context, planId string, planCandidateSetId string, placeIdsOrdered []string) error {
+	return p.editPlaces(planCandidateSetId, planId, models.PlanCandidateEditTypeReorderPlaces, func(placeIds []string) ([]string, error) {
+		// 場所のID一覧に過不足がないかを確認
+		if len(placeIdsOrdered) != len(placeIds) {
+			return nil, fmt.Errorf("invalid placeIdsOrdered length")
+		}
+
+		// すべての場所のIDが存在するかを確認
+		for _, placeId := range placeIdsOrdered {
+			if !array.IsContain(placeIds, placeId) {
+				return nil, fmt.Errorf("invalid placeId %s", placeId)
+			}
+		}
+
+		return slices.Clone(placeIdsOrdered), nil
+	})
+}
+
+func (p PlanCandidateRepository) ReplacePlace(ctx context.Context, planCandidateSetId string, planId string, placeIdToBeReplaced string, placeToReplace models.Place) error {
+	return p.editPlaces(planCandidateSetId, planId, models.PlanCandidateEditTypeReplacePlace, func(placeIds []string) ([]string, error) {
+		if !array.IsContain(placeIds, placeIdToBeReplaced) {
+			return nil, fmt.Errorf("failed to get plan candidate place: place %s not found in plan", placeIdToBeReplaced)
+		}
+
+		if p.db.findPlace(placeToReplace.Id) == nil {
+			return nil, fmt.Errorf("failed to update plan candidate place: place not found: %s", placeToReplace.Id)
+		}
+
+		return array.Map(placeIds, func(placeId string) string {
+			if placeId == placeIdToBeReplaced {
+				return placeToReplace.Id
+			}
+			return placeId
+		}), nil
+	})
+}
+
+func (p PlanCandidateRepository) UpdatePlanCandidateMetaData(ctx context.Context, planCandidateSetId string, meta models.PlanCandidateMetaData) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return fmt.Errorf("failed to insert plan candidate set meta data: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	// rdb と同じく、カテゴリは指定された場合のみ更新する
+	if record.metaData != nil {
+		if meta.CategoriesPreferred == nil && meta.CategoriesRejected == nil {
+			meta.CategoriesPreferred = record.metaData.CategoriesPreferred
+			meta.CategoriesRejected = record.metaData.CategoriesRejected
+		}
+		if meta.CreateByCategoryMetaData == nil {
+			meta.CreateByCategoryMetaData = record.metaData.CreateByCategoryMetaData
+		}
+	}
+
+	// 保存されたメタデータでは、移動手段と出発地点が常に設定される
+	meta.TravelMode = meta.GetTravelMode()
+	if meta.LocationStart == nil {
+		meta.LocationStart = &models.GeoLocation{}
+	}
+
+	record.metaData = &meta
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdateIsPlaceSearched(ctx context.Context, planCandidateSetId string, isPlaceSearched bool) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return fmt.Errorf("failed to get plan candidate set: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	record.isPlaceSearched = isPlaceSearched
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdateLikeToPlaceInPlanCandidateSet(ctx context.Context, planCandidateSetId string, placeId string, like bool) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	isLiked := func(likePlace planCandidateSetLikePlaceRecord) bool {
+		return likePlace.planCandidateSetId == planCandidateSetId && likePlace.placeId == placeId
+	}
+
+	if !like {
+		p.db.planCandidateSetLikePlaces = array.Filter(p.db.planCandidateSetLikePlaces, func(likePlace planCandidateSetLikePlaceRecord) bool {
+			return !isLiked(likePlace)
+		})
+		return nil
+	}
+
+	if _, found := array.Find(p.db.planCandidateSetLikePlaces, isLiked); found {
+		// すでに存在する場合は何もしない
+		return nil
+	}
+
+	if p.db.findPlanCandidateSet(planCandidateSetId) == nil {
+		return fmt.Errorf("failed to insert plan candidate set like place: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	if p.db.findPlace(placeId) == nil {
+		return fmt.Errorf("failed to insert plan candidate set like place: place not found: %s", placeId)
+	}
+
+	p.db.planCandidateSetLikePlaces = append(p.db.planCandidateSetLikePlaces, planCandidateSetLikePlaceRecord{
+		planCandidateSetId: planCandidateSetId,
+		placeId:            placeId,
+	})
+	return nil
+}
+
+func (p PlanCandidateRepository) FindCategoriesRejectedByUserId(ctx context.Context, userId string) ([]models.LocationCategory, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	categories := make([]models.LocationCategory, 0)
+	for _, record := range p.db.planCandidateSets {
+		if record.metaData == nil || record.metaData.CategoriesRejected == nil {
+			continue
+		}
+
+		// ユーザーが保存したプランを含むプラン候補のみを対象とする
+		if _, found := array.Find(record.plans, func(planCandidate *planCandidateRecord) bool {
+			plan := p.db.findPlan(planCandidate.id)
+			return plan != nil && plan.authorId != nil && *plan.authorId == userId
+		}); !found {
+			continue
+		}
+
+		categoriesRejected := array.DistinctBy(*record.metaData.CategoriesRejected, func(category models.LocationCategory) string {
+			return category.Name
+		})
+		for _, category := range categoriesRejected {
+			if c := models.GetCategoryOfName(category.Name); c != nil {
+				categories = append(categories, *c)
+			}
+		}
+	}
+
+	return categories, nil
+}
+
+func (db *DB) findPlanCandidateSet(planCandidateSetId string) *planCandidateSetRecord {
+	for _, record := range db.planCandidateSets {
+		if record.id == planCandidateSetId {
+			return record
+		}
+	}
+	return nil
+}
+
+// newPlanCandidate は rdb と同じく、作者を含めずに models.Plan を組み立てる
+// 保存されていない場所は含めない
+func (db *DB) newPlanCandidate(record planCandidateRecord) models.Plan {
+	return models.Plan{
+		Id:   record.id,
+		Name: record.name,
+		Places: array.MapAndFilter(record.placeIds, func(placeId string) (models.Place, bool) {
+			placeRecord := db.findPlace(placeId)
+			if placeRecord == nil {
+				return models.Place{}, false
+			}
+			return db.newPlace(*placeRecord), true
+		}),
+		ParentPlanId: utils.StrCopyPointerValue(record.parentPlanId),
+	}
+}
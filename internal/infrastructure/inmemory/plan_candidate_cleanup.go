@@ -0,0 +1,96 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func (p PlanCandidateRepository) BindToUser(ctx context.Context, userId string, planCandidateSetIds []string) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.planCandidateSets, func(record *planCandidateSetRecord) bool {
+		return array.IsContain(planCandidateSetIds, record.id) && record.userId == nil
+	})
+
+	if len(records) > 0 && p.db.findUser(userId) == nil {
+		return fmt.Errorf("failed to bind plan candidate sets to user: user not found: %s", userId)
+	}
+
+	for _, record := range records {
+		record.userId = utils.ToPointer(userId)
+	}
+
+	return nil
+}
+
+func (p PlanCandidateRepository) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	records := array.Filter(p.db.planCandidateSets, func(record *planCandidateSetRecord) bool {
+		return record.expiresAt.Before(expiredBefore) && p.db.isPlanCandidateSetDeletable(*record)
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].expiresAt.Before(records[j].expiresAt)
+	})
+	if len(records) > limit {
+		records = records[:limit]
+	}
+
+	if len(records) == 0 {
+		return 0, nil
+	}
+
+	planCandidateSetIds := array.Map(records, func(record *planCandidateSetRecord) string {
+		return record.id
+	})
+
+	// 編集履歴・グループ・メタデータはプラン候補とともに保持しているため、いいねのみを別に削除する
+	p.db.planCandidateSetLikePlaces = array.Filter(p.db.planCandidateSetLikePlaces, func(likePlace planCandidateSetLikePlaceRecord) bool {
+		return !array.IsContain(planCandidateSetIds, likePlace.planCandidateSetId)
+	})
+	p.db.planCandidateSets = array.Filter(p.db.planCandidateSets, func(record *planCandidateSetRecord) bool {
+		return !array.IsContain(planCandidateSetIds, record.id)
+	})
+
+	return len(records), nil
+}
+
+func (p PlanCandidateRepository) CountExpiredRetained(ctx context.Context, expiredBefore time.Time) (int, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	return len(array.Filter(p.db.planCandidateSets, func(record *planCandidateSetRecord) bool {
+		return record.expiresAt.Before(expiredBefore) && !p.db.isPlanCandidateSetDeletable(*record)
+	})), nil
+}
+
+// isPlanCandidateSetDeletable はユーザーに紐付けられておらず、プランとして保存されておらず、
+// ログインしたユーザーがグループに参加していないかを判定する
+func (db *DB) isPlanCandidateSetDeletable(record planCandidateSetRecord) bool {
+	if record.userId != nil {
+		return false
+	}
+
+	for _, planCandidate := range record.plans {
+		if db.findPlan(planCandidate.id) != nil {
+			return false
+		}
+	}
+
+	if record.group != nil {
+		for _, participant := range record.group.Participants {
+			if participant.UserId != nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
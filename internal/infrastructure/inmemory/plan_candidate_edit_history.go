@@ -0,0 +1,137 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func (p PlanCandidateRepository) FindEditHistories(ctx context.Context, planCandidateSetId string) (models.PlanCandidateEditHistories, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	histories := make(models.PlanCandidateEditHistories, 0)
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return histories, nil
+	}
+
+	for _, history := range record.editHistories {
+		histories = append(histories, copyPlanCandidateEditHistory(history))
+	}
+	return histories, nil
+}
+
+func (p PlanCandidateRepository) UndoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error) {
+	return p.applyEditHistory(planCandidateSetId, true)
+}
+
+func (p PlanCandidateRepository) RedoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error) {
+	return p.applyEditHistory(planCandidateSetId, false)
+}
+
+// applyEditHistory は undo が true の場合は最新の編集を取り消し、false の場合は取り消した編集をやり直す
+func (p PlanCandidateRepository) applyEditHistory(planCandidateSetId string, undo bool) (*models.PlanCandidateEditHistory, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return nil, nil
+	}
+
+	var history *models.PlanCandidateEditHistory
+	var placeIds []string
+	if undo {
+		history = record.editHistories.NextToUndo()
+		if history != nil {
+			placeIds = history.PlaceIdsBefore
+		}
+	} else {
+		history = record.editHistories.NextToRedo()
+		if history != nil {
+			placeIds = history.PlaceIdsAfter
+		}
+	}
+
+	if history == nil {
+		return nil, nil
+	}
+
+	planCandidate := record.findPlan(history.PlanId)
+	if planCandidate == nil {
+		return nil, fmt.Errorf("failed to replace places of plan candidate: plan not found: %s", history.PlanId)
+	}
+
+	planCandidate.placeIds = slices.Clone(placeIds)
+	history.IsUndone = undo
+
+	historyApplied := copyPlanCandidateEditHistory(*history)
+	return &historyApplied, nil
+}
+
+// editPlaces は edit で求めた場所の一覧でプランに含まれる場所を置き換え、編集前後の場所を編集履歴として保存する
+// edit が nil を返した場合は、何も変更しない
+// 新しく編集した時点で、取り消された編集はやり直せなくなるため破棄する
+func (p PlanCandidateRepository) editPlaces(planCandidateSetId string, planId string, editType models.PlanCandidateEditType, edit func(placeIds []string) ([]string, error)) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return fmt.Errorf("failed to get plan candidate: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	planCandidate := record.findPlan(planId)
+	if planCandidate == nil {
+		return fmt.Errorf("failed to get plan candidate: plan not found: %s", planId)
+	}
+
+	placeIdsBefore := slices.Clone(planCandidate.placeIds)
+	placeIdsAfter, err := edit(slices.Clone(planCandidate.placeIds))
+	if err != nil {
+		return err
+	}
+
+	if placeIdsAfter == nil {
+		return nil
+	}
+
+	planCandidate.placeIds = placeIdsAfter
+
+	editHistories := make(models.PlanCandidateEditHistories, 0, len(record.editHistories)+1)
+	for _, history := range record.editHistories {
+		if !history.IsUndone {
+			editHistories = append(editHistories, history)
+		}
+	}
+	record.editHistories = append(editHistories, models.PlanCandidateEditHistory{
+		Id:                 uuid.New().String(),
+		PlanCandidateSetId: planCandidateSetId,
+		PlanId:             planId,
+		Type:               editType,
+		PlaceIdsBefore:     placeIdsBefore,
+		PlaceIdsAfter:      slices.Clone(placeIdsAfter),
+		CreatedAt:          p.db.currentTime(),
+	})
+
+	return nil
+}
+
+func (r *planCandidateSetRecord) findPlan(planId string) *planCandidateRecord {
+	for _, planCandidate := range r.plans {
+		if planCandidate.id == planId {
+			return planCandidate
+		}
+	}
+	return nil
+}
+
+func copyPlanCandidateEditHistory(history models.PlanCandidateEditHistory) models.PlanCandidateEditHistory {
+	history.PlaceIdsBefore = slices.Clone(history.PlaceIdsBefore)
+	history.PlaceIdsAfter = slices.Clone(history.PlaceIdsAfter)
+	return history
+}
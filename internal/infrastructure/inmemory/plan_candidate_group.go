@@ -0,0 +1,191 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func (p PlanCandidateRepository) CreateGroup(ctx context.Context, planCandidateSetId string, shareToken string) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil {
+		return fmt.Errorf("failed to insert plan candidate set group: plan candidate set not found: %s", planCandidateSetId)
+	}
+
+	if record.group != nil {
+		return nil
+	}
+
+	if p.db.findGroupByShareToken(shareToken) != nil {
+		return fmt.Errorf("failed to insert plan candidate set group: share token already exists")
+	}
+
+	record.group = &models.PlanCandidateGroup{
+		PlanCandidateSetId: planCandidateSetId,
+		ShareToken:         shareToken,
+		Participants:       make([]models.PlanCandidateGroupParticipant, 0),
+		PlanVotes:          make([]models.PlanCandidateGroupPlanVote, 0),
+		PlaceVotes:         make([]models.PlanCandidateGroupPlaceVote, 0),
+	}
+	return nil
+}
+
+func (p PlanCandidateRepository) FindGroup(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateGroup, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil || record.group == nil {
+		return nil, nil
+	}
+
+	group := copyPlanCandidateGroup(*record.group)
+	return &group, nil
+}
+
+func (p PlanCandidateRepository) FindGroupByShareToken(ctx context.Context, shareToken string) (*models.PlanCandidateGroup, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	group := p.db.findGroupByShareToken(shareToken)
+	if group == nil {
+		return nil, nil
+	}
+
+	groupCopied := copyPlanCandidateGroup(*group)
+	return &groupCopied, nil
+}
+
+func (p PlanCandidateRepository) AddGroupParticipant(ctx context.Context, planCandidateSetId string, participant models.PlanCandidateGroupParticipant) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	group, err := p.db.findGroup(planCandidateSetId)
+	if err != nil {
+		return fmt.Errorf("failed to insert plan candidate set participant: %w", err)
+	}
+
+	if participant.UserId != nil && p.db.findUser(*participant.UserId) == nil {
+		return fmt.Errorf("failed to insert plan candidate set participant: user not found: %s", *participant.UserId)
+	}
+
+	participant.UserId = utils.StrCopyPointerValue(participant.UserId)
+	group.Participants = append(group.Participants, participant)
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdatePlanVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, planId string, vote bool) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	group, err := p.db.findGroup(planCandidateSetId)
+	if err != nil {
+		return fmt.Errorf("failed to update plan candidate set plan vote: %w", err)
+	}
+
+	isVoted := func(planVote models.PlanCandidateGroupPlanVote) bool {
+		return planVote.ParticipantId == participantId && planVote.PlanId == planId
+	}
+
+	if !vote {
+		group.PlanVotes = slices.DeleteFunc(group.PlanVotes, isVoted)
+		return nil
+	}
+
+	if slices.ContainsFunc(group.PlanVotes, isVoted) {
+		// すでに投票している場合は何もしない
+		return nil
+	}
+
+	if !slices.ContainsFunc(group.Participants, func(participant models.PlanCandidateGroupParticipant) bool {
+		return participant.Id == participantId
+	}) {
+		return fmt.Errorf("failed to insert plan candidate set plan vote: participant not found: %s", participantId)
+	}
+
+	if p.db.findPlanCandidateSet(planCandidateSetId).findPlan(planId) == nil {
+		return fmt.Errorf("failed to insert plan candidate set plan vote: plan not found: %s", planId)
+	}
+
+	group.PlanVotes = append(group.PlanVotes, models.PlanCandidateGroupPlanVote{
+		ParticipantId: participantId,
+		PlanId:        planId,
+	})
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdatePlaceVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, placeId string, vote bool) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	group, err := p.db.findGroup(planCandidateSetId)
+	if err != nil {
+		return fmt.Errorf("failed to update plan candidate set place vote: %w", err)
+	}
+
+	isVoted := func(placeVote models.PlanCandidateGroupPlaceVote) bool {
+		return placeVote.ParticipantId == participantId && placeVote.PlaceId == placeId
+	}
+
+	if !vote {
+		group.PlaceVotes = slices.DeleteFunc(group.PlaceVotes, isVoted)
+		return nil
+	}
+
+	if slices.ContainsFunc(group.PlaceVotes, isVoted) {
+		// すでに投票している場合は何もしない
+		return nil
+	}
+
+	if !slices.ContainsFunc(group.Participants, func(participant models.PlanCandidateGroupParticipant) bool {
+		return participant.Id == participantId
+	}) {
+		return fmt.Errorf("failed to insert plan candidate set place vote: participant not found: %s", participantId)
+	}
+
+	if p.db.findPlace(placeId) == nil {
+		return fmt.Errorf("failed to insert plan candidate set place vote: place not found: %s", placeId)
+	}
+
+	group.PlaceVotes = append(group.PlaceVotes, models.PlanCandidateGroupPlaceVote{
+		ParticipantId: participantId,
+		PlaceId:       placeId,
+	})
+	return nil
+}
+
+// findGroup はプラン候補のグループを取得する（グループが作成されていない場合はエラーを返す）
+func (db *DB) findGroup(planCandidateSetId string) (*models.PlanCandidateGroup, error) {
+	record := db.findPlanCandidateSet(planCandidateSetId)
+	if record == nil || record.group == nil {
+		return nil, fmt.Errorf("plan candidate set group not found: %s", planCandidateSetId)
+	}
+	return record.group, nil
+}
+
+func (db *DB) findGroupByShareToken(shareToken string) *models.PlanCandidateGroup {
+	record, found := array.Find(db.planCandidateSets, func(record *planCandidateSetRecord) bool {
+		return record.group != nil && record.group.ShareToken == shareToken
+	})
+	if !found {
+		return nil
+	}
+	return record.group
+}
+
+func copyPlanCandidateGroup(group models.PlanCandidateGroup) models.PlanCandidateGroup {
+	group.Participants = array.Map(group.Participants, func(participant models.PlanCandidateGroupParticipant) models.PlanCandidateGroupParticipant {
+		participant.UserId = utils.StrCopyPointerValue(participant.UserId)
+		return participant
+	})
+	group.PlanVotes = slices.Clone(group.PlanVotes)
+	group.PlaceVotes = slices.Clone(group.PlaceVotes)
+	return group
+}
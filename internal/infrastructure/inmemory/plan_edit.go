@@ -0,0 +1,156 @@
+package inmemory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+func (p PlanRepository) UpdateTitleAndDescription(ctx context.Context, planId string, version int, title string, description *string) error {
+	return p.editPlan(planId, version, func(record *planRecord) error {
+		record.name = title
+		record.description = utils.StrCopyPointerValue(description)
+		return nil
+	})
+}
+
+func (p PlanRepository) UpdateVisibility(ctx context.Context, planId string, version int, visibility models.PlanVisibility) error {
+	return p.editPlan(planId, version, func(record *planRecord) error {
+		record.visibility = visibility
+		return nil
+	})
+}
+
+func (p PlanRepository) UpdatePlacesOrder(ctx context.Context, planId string, version int, placeIdsOrdered []string) error {
+	return p.editPlaces(planId, version, func(placeIds []string) ([]string, error) {
+		// 場所のID一覧に過不足がないかを確認
+		if len(placeIdsOrdered) != len(placeIds) {
+			return nil, fmt.Errorf("invalid placeIdsOrdered length")
+		}
+
+		for _, placeId := range placeIdsOrdered {
+			if !array.IsContain(placeIds, placeId) {
+				return nil, fmt.Errorf("invalid placeId %s", placeId)
+			}
+		}
+
+		return placeIdsOrdered, nil
+	})
+}
+
+func (p PlanRepository) AddPlaceToPlan(ctx context.Context, planId string, version int, previousPlaceId string, place models.Place) error {
+	return p.editPlaces(planId, version, func(placeIds []string) ([]string, error) {
+		if array.IsContain(placeIds, place.Id) {
+			return nil, fmt.Errorf("place %s is already in plan", place.Id)
+		}
+
+		var placeIdsUpdated []string
+		for _, placeId := range placeIds {
+			placeIdsUpdated = append(placeIdsUpdated, placeId)
+			if placeId == previousPlaceId {
+				placeIdsUpdated = append(placeIdsUpdated, place.Id)
+			}
+		}
+
+		if len(placeIdsUpdated) == len(placeIds) {
+			return nil, fmt.Errorf("previous place %s not found in plan", previousPlaceId)
+		}
+
+		return placeIdsUpdated, nil
+	})
+}
+
+func (p PlanRepository) RemovePlaceFromPlan(ctx context.Context, planId string, version int, placeId string) error {
+	return p.editPlaces(planId, version, func(placeIds []string) ([]string, error) {
+		placeIdsUpdated := array.Filter(placeIds, func(id string) bool {
+			return id != placeId
+		})
+
+		if len(placeIdsUpdated) == len(placeIds) {
+			return nil, fmt.Errorf("place %s not found in plan", placeId)
+		}
+
+		// 少なくとも1つの場所がプランに含まれるようにする
+		if len(placeIdsUpdated) == 0 {
+			return nil, fmt.Errorf("plan must have at least one place")
+		}
+
+		return placeIdsUpdated, nil
+	})
+}
+
+func (p PlanRepository) ReplacePlace(ctx context.Context, planId string, version int, placeIdToBeReplaced string, placeToReplace models.Place) error {
+	return p.editPlaces(planId, version, func(placeIds []string) ([]string, error) {
+		if !array.IsContain(placeIds, placeIdToBeReplaced) {
+			return nil, fmt.Errorf("place %s not found in plan", placeIdToBeReplaced)
+		}
+
+		if array.IsContain(placeIds, placeToReplace.Id) {
+			return nil, fmt.Errorf("place %s is already in plan", placeToReplace.Id)
+		}
+
+		return array.Map(placeIds, func(placeId string) string {
+			if placeId == placeIdToBeReplaced {
+				return placeToReplace.Id
+			}
+			return placeId
+		}), nil
+	})
+}
+
+// editPlaces は edit で求めた場所の一覧でプランに含まれる場所を置き換え、プランの位置を最初の場所の位置に更新する
+func (p PlanRepository) editPlaces(planId string, version int, edit func(placeIds []string) ([]string, error)) error {
+	return p.editPlan(planId, version, func(record *planRecord) error {
+		placeIdsUpdated, err := edit(append([]string{}, record.placeIds...))
+		if err != nil {
+			return err
+		}
+
+		for _, placeId := range placeIdsUpdated {
+			if p.db.findPlace(placeId) == nil {
+				return fmt.Errorf("failed to insert plan places: place not found: %s", placeId)
+			}
+		}
+
+		record.placeIds = placeIdsUpdated
+		record.location = p.db.findPlace(placeIdsUpdated[0]).google.Location
+
+		// プランから取り除かれた場所の写真はコラージュからも削除する
+		if planCollagePhotos, ok := p.db.planCollages[planId]; ok {
+			p.db.planCollages[planId] = array.Filter(planCollagePhotos, func(planCollagePhoto planCollagePhotoRecord) bool {
+				return array.IsContain(placeIdsUpdated, planCollagePhoto.placeId)
+			})
+		}
+		return nil
+	})
+}
+
+// editPlan は version が保存されているプランのバージョンと一致する場合のみ edit でプランを編集し、バージョンを1つ増やす
+// edit がエラーを返した場合は、プランを変更しない
+func (p PlanRepository) editPlan(planId string, version int, edit func(record *planRecord) error) error {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record := p.db.findPlan(planId)
+	if record == nil {
+		return fmt.Errorf("plan not found: %s: %w", planId, sql.ErrNoRows)
+	}
+
+	if record.version != version {
+		return fmt.Errorf("plan %s is already updated: %w", planId, apperrors.ErrVersionConflict)
+	}
+
+	recordEdited := *record
+	if err := edit(&recordEdited); err != nil {
+		return err
+	}
+
+	recordEdited.version = version + 1
+	*record = recordEdited
+	return nil
+}
@@ -0,0 +1,52 @@
+package inmemory
+
+import (
+	"testing"
+
+	"poroto.app/poroto/planner/internal/domain/repository"
+	"poroto.app/poroto/planner/internal/domain/repository/repositorytest"
+)
+
+var (
+	_ repository.PlaceRepository         = (*PlaceRepository)(nil)
+	_ repository.PlanRepository          = (*PlanRepository)(nil)
+	_ repository.PlanCandidateRepository = (*PlanCandidateRepository)(nil)
+	_ repository.UserRepository          = (*UserRepository)(nil)
+)
+
+func TestRepositories(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		return newRepositories(t, NewDB())
+	})
+}
+
+func newRepositories(t *testing.T, db *DB) repositorytest.Repositories {
+	t.Helper()
+
+	placeRepository, err := NewPlaceRepository(db)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	planRepository, err := NewPlanRepository(db)
+	if err != nil {
+		t.Fatalf("error while initializing plan repository: %v", err)
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(db)
+	if err != nil {
+		t.Fatalf("error while initializing plan candidate repository: %v", err)
+	}
+
+	userRepository, err := NewUserRepository(db)
+	if err != nil {
+		t.Fatalf("error while initializing user repository: %v", err)
+	}
+
+	return repositorytest.Repositories{
+		Place:         placeRepository,
+		Plan:          planRepository,
+		PlanCandidate: planCandidateRepository,
+		User:          userRepository,
+	}
+}
@@ -0,0 +1,125 @@
+package inmemory
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+// tripRecord は旅行と、日付順に並んだ1日ごとのプランの ID
+type tripRecord struct {
+	id         string
+	name       string
+	authorId   *string
+	planIds    []string
+	startTime  *time.Time
+	travelMode models.TravelMode
+}
+
+func (p PlanRepository) SaveTrip(ctx context.Context, trip models.Trip) error {
+	if len(trip.Plans) == 0 {
+		return fmt.Errorf("trip plans is empty")
+	}
+
+	for _, plan := range trip.Plans {
+		if len(plan.Places) == 0 {
+			return fmt.Errorf("plan places is empty")
+		}
+	}
+
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	if trip.Id == "" {
+		trip.Id = uuid.New().String()
+	}
+
+	if _, found := array.Find(p.db.trips, func(record *tripRecord) bool {
+		return record.id == trip.Id
+	}); found {
+		return fmt.Errorf("failed to insert trip: trip %s already exists", trip.Id)
+	}
+
+	var authorId *string
+	if trip.Author != nil {
+		if p.db.findUser(trip.Author.Id) == nil {
+			return fmt.Errorf("failed to insert trip: user not found: %s", trip.Author.Id)
+		}
+		authorId = utils.ToPointer(trip.Author.Id)
+	}
+
+	planRecords := make([]*planRecord, 0, len(trip.Plans))
+	for _, plan := range trip.Plans {
+		record, err := p.db.newPlanRecord(plan)
+		if err != nil {
+			return err
+		}
+		planRecords = append(planRecords, record)
+	}
+
+	travelMode := trip.TravelMode
+	if !travelMode.IsValid() {
+		travelMode = models.TravelModeWalking
+	}
+
+	p.db.plans = append(p.db.plans, planRecords...)
+	p.db.trips = append(p.db.trips, &tripRecord{
+		id:       trip.Id,
+		name:     trip.Name,
+		authorId: authorId,
+		planIds: array.Map(planRecords, func(record *planRecord) string {
+			return record.id
+		}),
+		startTime:  trip.StartTime,
+		travelMode: travelMode,
+	})
+
+	return nil
+}
+
+func (p PlanRepository) FindTrip(ctx context.Context, tripId string, viewerId *string) (*models.Trip, error) {
+	p.db.mu.Lock()
+	defer p.db.mu.Unlock()
+
+	record, found := array.Find(p.db.trips, func(record *tripRecord) bool {
+		return record.id == tripId
+	})
+	if !found {
+		return nil, nil
+	}
+
+	plans := make([]models.Plan, 0, len(record.planIds))
+	for _, planId := range record.planIds {
+		planRecord := p.db.findPlan(planId)
+		if planRecord == nil {
+			return nil, fmt.Errorf("failed to find plan of trip: %w", sql.ErrNoRows)
+		}
+		if !planRecord.isVisibleTo(viewerId) {
+			continue
+		}
+		plans = append(plans, p.db.newPlan(*planRecord))
+	}
+
+	var author *models.User
+	if record.authorId != nil {
+		if user := p.db.findUser(*record.authorId); user != nil {
+			userCopied := *user
+			author = &userCopied
+		}
+	}
+
+	return &models.Trip{
+		Id:         record.id,
+		Name:       record.name,
+		Plans:      plans,
+		Author:     author,
+		StartTime:  record.startTime,
+		TravelMode: record.travelMode,
+	}, nil
+}
@@ -0,0 +1,98 @@
+package inmemory
+
+import (
+	"context"
+	"fmt"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+)
+
+type UserRepository struct {
+	db *DB
+}
+
+func NewUserRepository(db *DB) (*UserRepository, error) {
+	return &UserRepository{
+		db: db,
+	}, nil
+}
+
+func (u UserRepository) Create(ctx context.Context, user models.User) error {
+	u.db.mu.Lock()
+	defer u.db.mu.Unlock()
+
+	for _, savedUser := range u.db.users {
+		if savedUser.FirebaseUID == user.FirebaseUID {
+			return fmt.Errorf("user with same firebase id already exists")
+		}
+		if savedUser.Id == user.Id {
+			return fmt.Errorf("user with same id already exists")
+		}
+	}
+
+	// rdb と同じく、空文字は保存されていないものとして扱う
+	user.PhotoUrl = utils.StrOmitEmpty(utils.StrEmptyIfNil(user.PhotoUrl))
+	user.Email = utils.StrOmitEmpty(utils.StrEmptyIfNil(user.Email))
+
+	u.db.users = append(u.db.users, user)
+	return nil
+}
+
+func (u UserRepository) Find(ctx context.Context, id string) (*models.User, error) {
+	u.db.mu.Lock()
+	defer u.db.mu.Unlock()
+
+	user := u.db.findUser(id)
+	if user == nil {
+		return nil, nil
+	}
+
+	userCopied := *user
+	return &userCopied, nil
+}
+
+func (u UserRepository) FindByFirebaseUID(ctx context.Context, firebaseUID string) (*models.User, error) {
+	u.db.mu.Lock()
+	defer u.db.mu.Unlock()
+
+	for _, user := range u.db.users {
+		if user.FirebaseUID == firebaseUID {
+			return &user, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (u UserRepository) UpdateProfile(ctx context.Context, userId string, name *string, photoUrl *string) error {
+	u.db.mu.Lock()
+	defer u.db.mu.Unlock()
+
+	user := u.db.findUser(userId)
+	if user == nil {
+		return fmt.Errorf("error while updating user profile: user not found")
+	}
+
+	if name != nil {
+		user.Name = *name
+	}
+	if photoUrl != nil {
+		user.PhotoUrl = utils.StrCopyPointerValue(photoUrl)
+	}
+
+	return nil
+}
+
+func (u UserRepository) UpdatePersonalizationDisabled(ctx context.Context, userId string, disabled bool) error {
+	u.db.mu.Lock()
+	defer u.db.mu.Unlock()
+
+	user := u.db.findUser(userId)
+	if user == nil {
+		return fmt.Errorf("error while updating user personalization: user not found")
+	}
+
+	user.PersonalizationDisabled = disabled
+	return nil
+}
@@ -0,0 +1,80 @@
+package pubsub
+
+import (
+	"context"
+	"sync"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+// defaultSubscriberBufferSize は購読者ごとに受信を待たずに送信できるイベントの数
+const defaultSubscriberBufferSize = 16
+
+// InMemoryPlanCandidateEventBroker は同じプロセス内の購読者にイベントを配信する
+type InMemoryPlanCandidateEventBroker struct {
+	mu          sync.RWMutex
+	subscribers map[string]map[*inMemorySubscriber]struct{}
+	bufferSize  int
+}
+
+type inMemorySubscriber struct {
+	events chan models.PlanCandidateEvent
+}
+
+func NewInMemoryPlanCandidateEventBroker(bufferSize int) *InMemoryPlanCandidateEventBroker {
+	return &InMemoryPlanCandidateEventBroker{
+		subscribers: make(map[string]map[*inMemorySubscriber]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+// Publish はイベントを購読者に配信する
+// 購読者のバッファが埋まっている場合は、編集を行ったリクエストを待たせないようにイベントを破棄する
+func (b *InMemoryPlanCandidateEventBroker) Publish(ctx context.Context, event models.PlanCandidateEvent) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for subscriber := range b.subscribers[event.PlanCandidateSetId] {
+		select {
+		case subscriber.events <- event:
+		default:
+		}
+	}
+
+	return nil
+}
+
+func (b *InMemoryPlanCandidateEventBroker) Subscribe(ctx context.Context, planCandidateSetId string) (<-chan models.PlanCandidateEvent, error) {
+	subscriber := &inMemorySubscriber{
+		events: make(chan models.PlanCandidateEvent, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	if _, ok := b.subscribers[planCandidateSetId]; !ok {
+		b.subscribers[planCandidateSetId] = make(map[*inMemorySubscriber]struct{})
+	}
+	b.subscribers[planCandidateSetId][subscriber] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		b.mu.Lock()
+		defer b.mu.Unlock()
+
+		delete(b.subscribers[planCandidateSetId], subscriber)
+		if len(b.subscribers[planCandidateSetId]) == 0 {
+			delete(b.subscribers, planCandidateSetId)
+		}
+		close(subscriber.events)
+	}()
+
+	return subscriber.events, nil
+}
+
+// numSubscribers は planCandidateSetId を購読している数を返す
+func (b *InMemoryPlanCandidateEventBroker) numSubscribers(planCandidateSetId string) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers[planCandidateSetId])
+}
@@ -0,0 +1,117 @@
+package pubsub
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func TestInMemoryPlanCandidateEventBroker_Publish(t *testing.T) {
+	cases := []struct {
+		name               string
+		planCandidateSetId string
+		events             []models.PlanCandidateEvent
+		expected           []models.PlanCandidateEvent
+	}{
+		{
+			name:               "receive events of subscribed plan candidate set",
+			planCandidateSetId: "plan-candidate-set-1",
+			events: []models.PlanCandidateEvent{
+				{PlanCandidateSetId: "plan-candidate-set-1", Type: models.PlanCandidateEventTypePlaceAdded},
+				{PlanCandidateSetId: "plan-candidate-set-2", Type: models.PlanCandidateEventTypePlaceAdded},
+				{PlanCandidateSetId: "plan-candidate-set-1", Type: models.PlanCandidateEventTypePlaceDeleted},
+			},
+			expected: []models.PlanCandidateEvent{
+				{PlanCandidateSetId: "plan-candidate-set-1", Type: models.PlanCandidateEventTypePlaceAdded},
+				{PlanCandidateSetId: "plan-candidate-set-1", Type: models.PlanCandidateEventTypePlaceDeleted},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			broker := NewInMemoryPlanCandidateEventBroker(defaultSubscriberBufferSize)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			events, err := broker.Subscribe(ctx, c.planCandidateSetId)
+			if err != nil {
+				t.Fatalf("error while subscribing: %v", err)
+			}
+
+			for _, event := range c.events {
+				if err := broker.Publish(context.Background(), event); err != nil {
+					t.Fatalf("error while publishing: %v", err)
+				}
+			}
+
+			var actual []models.PlanCandidateEvent
+			for range c.expected {
+				select {
+				case event := <-events:
+					actual = append(actual, event)
+				case <-time.After(time.Second):
+					t.Fatalf("timeout while receiving events")
+				}
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("received events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestInMemoryPlanCandidateEventBroker_Publish_ShouldNotBlock(t *testing.T) {
+	broker := NewInMemoryPlanCandidateEventBroker(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := broker.Subscribe(ctx, "plan-candidate-set"); err != nil {
+		t.Fatalf("error while subscribing: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 3; i++ {
+			_ = broker.Publish(context.Background(), models.PlanCandidateEvent{PlanCandidateSetId: "plan-candidate-set"})
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("publish should not block when subscriber does not receive events")
+	}
+}
+
+func TestInMemoryPlanCandidateEventBroker_Subscribe_ShouldCloseWhenContextDone(t *testing.T) {
+	broker := NewInMemoryPlanCandidateEventBroker(defaultSubscriberBufferSize)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := broker.Subscribe(ctx, "plan-candidate-set")
+	if err != nil {
+		t.Fatalf("error while subscribing: %v", err)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Fatalf("channel should be closed")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("timeout while waiting for channel to be closed")
+	}
+
+	if n := broker.numSubscribers("plan-candidate-set"); n != 0 {
+		t.Errorf("subscriber should be removed but got %d", n)
+	}
+}
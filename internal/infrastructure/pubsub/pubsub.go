@@ -0,0 +1,9 @@
+package pubsub
+
+import "poroto.app/poroto/planner/internal/domain/models"
+
+// NewPlanCandidateEventBroker はプラン候補のイベントを配信する models.PlanCandidateEventBroker を作成する
+// 現時点ではサーバー内でのみ配信するため、複数のインスタンスで動かす場合は Redis などを用いた実装に差し替える
+func NewPlanCandidateEventBroker() (models.PlanCandidateEventBroker, error) {
+	return NewInMemoryPlanCandidateEventBroker(defaultSubscriberBufferSize), nil
+}
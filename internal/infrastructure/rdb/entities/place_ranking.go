@@ -0,0 +1,15 @@
+package entities
+
+// PlaceSavedPlanCount は場所が保存されたプランに含まれている回数
+type PlaceSavedPlanCount struct {
+	PlaceId        string `boil:"place_id"`
+	SavedPlanCount int    `boil:"saved_plan_count"`
+}
+
+var PlaceSavedPlanCountColumns = struct {
+	PlaceId        string
+	SavedPlanCount string
+}{
+	PlaceId:        "place_id",
+	SavedPlanCount: "saved_plan_count",
+}
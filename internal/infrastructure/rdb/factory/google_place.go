@@ -82,6 +82,7 @@ func NewGooglePlaceFromEntity(
 }
 
 func NewGooglePlaceEntityFromGooglePlace(googlePlace models.GooglePlace, placeId string) generated.GooglePlace {
+	s2CellIds := googlePlace.Location.S2CellIds()
 	return generated.GooglePlace{
 		GooglePlaceID:    googlePlace.PlaceId,
 		PlaceID:          placeId,
@@ -93,5 +94,18 @@ func NewGooglePlaceEntityFromGooglePlace(googlePlace models.GooglePlace, placeId
 		UserRatingsTotal: null.IntFrom(googlePlace.UserRatingsTotal),
 		Vicinity:         null.StringFromPtr(googlePlace.Vicinity),
 		FormattedAddress: null.StringFromPtr(googlePlace.FormattedAddress),
+		S2CellIDLevel10:  null.Uint64From(s2CellIds.Level10),
+		S2CellIDLevel13:  null.Uint64From(s2CellIds.Level13),
+		S2CellIDLevel16:  null.Uint64From(s2CellIds.Level16),
+	}
+}
+
+func NewGooglePlaceFreshnessFromEntity(googlePlaceEntity generated.GooglePlace) models.GooglePlaceFreshness {
+	return models.GooglePlaceFreshness{
+		GooglePlaceId:           googlePlaceEntity.GooglePlaceID,
+		UserRatingsTotal:        googlePlaceEntity.UserRatingsTotal.Int,
+		FetchedAt:               googlePlaceEntity.FetchedAt,
+		OpeningPeriodsFetchedAt: googlePlaceEntity.OpeningPeriodsFetchedAt.Ptr(),
+		ReviewsFetchedAt:        googlePlaceEntity.ReviewsFetchedAt.Ptr(),
 	}
 }
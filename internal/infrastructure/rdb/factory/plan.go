@@ -17,17 +17,30 @@ func NewPlanEntityFromDomainModel(plan models.Plan) generated.Plan {
 		userId = &plan.Author.Id
 	}
 
+	// 場所が含まれない場合は、位置を含む S2 セルの ID を NULL とする
 	var startLocation models.GeoLocation
+	var s2CellIdLevel10, s2CellIdLevel13, s2CellIdLevel16 null.Uint64
 	if len(plan.Places) > 0 {
 		startLocation = plan.Places[0].Location
+		s2CellIds := startLocation.S2CellIds()
+		s2CellIdLevel10 = null.Uint64From(s2CellIds.Level10)
+		s2CellIdLevel13 = null.Uint64From(s2CellIds.Level13)
+		s2CellIdLevel16 = null.Uint64From(s2CellIds.Level16)
 	}
 
+	// Visibility が空文字の場合はカラムのデフォルト値（PUBLIC）で保存される
 	return generated.Plan{
-		ID:        plan.Id,
-		UserID:    null.StringFromPtr(userId),
-		Name:      plan.Name,
-		Latitude:  startLocation.Latitude,
-		Longitude: startLocation.Longitude,
+		ID:              plan.Id,
+		UserID:          null.StringFromPtr(userId),
+		Name:            plan.Name,
+		Description:     null.StringFromPtr(plan.Description),
+		Latitude:        startLocation.Latitude,
+		Longitude:       startLocation.Longitude,
+		Version:         plan.Version,
+		Visibility:      string(plan.Visibility),
+		S2CellIDLevel10: s2CellIdLevel10,
+		S2CellIDLevel13: s2CellIdLevel13,
+		S2CellIDLevel16: s2CellIdLevel16,
 	}
 }
 
@@ -43,9 +56,12 @@ func NewPlanFromEntity(
 	}
 
 	return &models.Plan{
-		Id:     planEntity.ID,
-		Name:   planEntity.Name,
-		Places: *planPlaces,
-		Author: author,
+		Id:          planEntity.ID,
+		Name:        planEntity.Name,
+		Description: planEntity.Description.Ptr(),
+		Places:      *planPlaces,
+		Author:      author,
+		Version:     planEntity.Version,
+		Visibility:  models.PlanVisibility(planEntity.Visibility),
 	}, nil
 }
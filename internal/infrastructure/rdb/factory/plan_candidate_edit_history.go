@@ -0,0 +1,55 @@
+package factory
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/types"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func NewPlanCandidateEditHistoryEntityFromDomainModel(history models.PlanCandidateEditHistory, sortOrder int) generated.PlanCandidateEditHistory {
+	return generated.PlanCandidateEditHistory{
+		ID:                 history.Id,
+		PlanCandidateSetID: history.PlanCandidateSetId,
+		PlanCandidateID:    history.PlanId,
+		SortOrder:          sortOrder,
+		EditType:           string(history.Type),
+		PlaceIdsBefore:     newPlaceIdsJSON(history.PlaceIdsBefore),
+		PlaceIdsAfter:      newPlaceIdsJSON(history.PlaceIdsAfter),
+		IsUndone:           history.IsUndone,
+	}
+}
+
+func NewPlanCandidateEditHistoryFromEntity(entity generated.PlanCandidateEditHistory) (*models.PlanCandidateEditHistory, error) {
+	var placeIdsBefore []string
+	if err := entity.PlaceIdsBefore.Unmarshal(&placeIdsBefore); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal place ids before edit: %w", err)
+	}
+
+	var placeIdsAfter []string
+	if err := entity.PlaceIdsAfter.Unmarshal(&placeIdsAfter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal place ids after edit: %w", err)
+	}
+
+	return &models.PlanCandidateEditHistory{
+		Id:                 entity.ID,
+		PlanCandidateSetId: entity.PlanCandidateSetID,
+		PlanId:             entity.PlanCandidateID,
+		Type:               models.PlanCandidateEditType(entity.EditType),
+		PlaceIdsBefore:     placeIdsBefore,
+		PlaceIdsAfter:      placeIdsAfter,
+		IsUndone:           entity.IsUndone,
+		CreatedAt:          entity.CreatedAt,
+	}, nil
+}
+
+func newPlaceIdsJSON(placeIds []string) types.JSON {
+	if placeIds == nil {
+		placeIds = []string{}
+	}
+	// []string は JSON に変換できない値を含まないため、エラーは発生しない
+	placeIdsJSON, _ := json.Marshal(placeIds)
+	return placeIdsJSON
+}
@@ -0,0 +1,66 @@
+package factory
+
+import (
+	"github.com/volatiletech/null/v8"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func NewPlanCandidateSetParticipantEntityFromDomainModel(planCandidateSetId string, participant models.PlanCandidateGroupParticipant) generated.PlanCandidateSetParticipant {
+	return generated.PlanCandidateSetParticipant{
+		ID:                 participant.Id,
+		PlanCandidateSetID: planCandidateSetId,
+		UserID:             null.StringFromPtr(participant.UserId),
+		ParticipantToken:   participant.Token,
+		DisplayName:        participant.DisplayName,
+	}
+}
+
+// NewPlanCandidateGroupFromEntity はグループの参加者と投票を読み込んだ generated.PlanCandidateSetGroup から models.PlanCandidateGroup を作成する
+func NewPlanCandidateGroupFromEntity(groupEntity generated.PlanCandidateSetGroup) models.PlanCandidateGroup {
+	group := models.PlanCandidateGroup{
+		PlanCandidateSetId: groupEntity.PlanCandidateSetID,
+		ShareToken:         groupEntity.ShareToken,
+		Participants:       make([]models.PlanCandidateGroupParticipant, 0),
+		PlanVotes:          make([]models.PlanCandidateGroupPlanVote, 0),
+		PlaceVotes:         make([]models.PlanCandidateGroupPlaceVote, 0),
+	}
+
+	if groupEntity.R == nil {
+		return group
+	}
+
+	for _, participantEntity := range groupEntity.R.PlanCandidateSetPlanCandidateSetParticipants {
+		if participantEntity == nil {
+			continue
+		}
+		group.Participants = append(group.Participants, models.PlanCandidateGroupParticipant{
+			Id:          participantEntity.ID,
+			UserId:      participantEntity.UserID.Ptr(),
+			DisplayName: participantEntity.DisplayName,
+			Token:       participantEntity.ParticipantToken,
+		})
+	}
+
+	for _, planVoteEntity := range groupEntity.R.PlanCandidateSetPlanCandidateSetPlanVotes {
+		if planVoteEntity == nil {
+			continue
+		}
+		group.PlanVotes = append(group.PlanVotes, models.PlanCandidateGroupPlanVote{
+			ParticipantId: planVoteEntity.ParticipantID,
+			PlanId:        planVoteEntity.PlanCandidateID,
+		})
+	}
+
+	for _, placeVoteEntity := range groupEntity.R.PlanCandidateSetPlanCandidateSetPlaceVotes {
+		if placeVoteEntity == nil {
+			continue
+		}
+		group.PlaceVotes = append(group.PlaceVotes, models.PlanCandidateGroupPlaceVote{
+			ParticipantId: placeVoteEntity.ParticipantID,
+			PlaceId:       placeVoteEntity.PlaceID,
+		})
+	}
+
+	return group
+}
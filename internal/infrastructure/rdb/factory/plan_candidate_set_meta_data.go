@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
@@ -10,14 +11,36 @@ import (
 )
 
 func NewPlanCandidateMetaDataFromDomainModel(planCandidateSetMetaData models.PlanCandidateMetaData, planCandidateSetId string) generated.PlanCandidateSetMetaDatum {
-	return generated.PlanCandidateSetMetaDatum{
+	entity := generated.PlanCandidateSetMetaDatum{
 		ID:                           uuid.New().String(),
 		PlanCandidateSetID:           planCandidateSetId,
 		IsCreatedFromCurrentLocation: planCandidateSetMetaData.CreatedBasedOnCurrentLocation,
 		LatitudeStart:                planCandidateSetMetaData.LocationStart.Latitude,
 		LongitudeStart:               planCandidateSetMetaData.LocationStart.Longitude,
 		PlanDurationMinutes:          null.IntFromPtr(planCandidateSetMetaData.FreeTime),
+		StartAt:                      null.TimeFromPtr(planCandidateSetMetaData.StartTime),
+		TravelMode:                   string(planCandidateSetMetaData.GetTravelMode()),
+		BudgetMax:                    null.IntFromPtr(planCandidateSetMetaData.BudgetMax),
+		NumberOfPeople:               null.IntFromPtr(planCandidateSetMetaData.NumberOfPeople),
 	}
+
+	if planCandidateSetMetaData.LocationEnd != nil {
+		entity.LatitudeEnd = null.Float64From(planCandidateSetMetaData.LocationEnd.Latitude)
+		entity.LongitudeEnd = null.Float64From(planCandidateSetMetaData.LocationEnd.Longitude)
+	}
+
+	if planCandidateSetMetaData.Weather != nil {
+		entity.WeatherPrecipitationProbability = null.IntFrom(planCandidateSetMetaData.Weather.PrecipitationProbability)
+		entity.WeatherPrecipitationMM = null.Float64From(planCandidateSetMetaData.Weather.PrecipitationInMm)
+	}
+
+	if planCandidateSetMetaData.PromptIntent != nil {
+		// PlanPromptIntent は JSON に変換できない値を含まないため、エラーは発生しない
+		promptIntent, _ := json.Marshal(planCandidateSetMetaData.PromptIntent)
+		entity.PromptIntent = null.JSONFrom(promptIntent)
+	}
+
+	return entity
 }
 
 func NewPlanCandidateMetaDataFromEntity(
@@ -36,6 +59,11 @@ func NewPlanCandidateMetaDataFromEntity(
 		return nil, fmt.Errorf("failed to find plan candidate set meta data")
 	}
 
+	promptIntent, err := newPromptIntentFromEntity(*planCandidateSetMetaData)
+	if err != nil {
+		return nil, err
+	}
+
 	return &models.PlanCandidateMetaData{
 		CreatedBasedOnCurrentLocation: planCandidateSetMetaData.IsCreatedFromCurrentLocation,
 		CategoriesPreferred:           newPlanCandidateSetMetaDataPreferredCategoriesFromEntity(planCandidateSetCategorySlice, planCandidateSetId),
@@ -44,7 +72,52 @@ func NewPlanCandidateMetaDataFromEntity(
 			Latitude:  planCandidateSetMetaData.LatitudeStart,
 			Longitude: planCandidateSetMetaData.LongitudeStart,
 		},
+		LocationEnd:              newLocationEndFromEntity(*planCandidateSetMetaData),
 		FreeTime:                 planCandidateSetMetaData.PlanDurationMinutes.Ptr(),
+		StartTime:                planCandidateSetMetaData.StartAt.Ptr(),
+		TravelMode:               models.TravelMode(planCandidateSetMetaData.TravelMode),
+		BudgetMax:                planCandidateSetMetaData.BudgetMax.Ptr(),
+		NumberOfPeople:           planCandidateSetMetaData.NumberOfPeople.Ptr(),
+		Weather:                  newWeatherForecastFromEntity(*planCandidateSetMetaData),
+		PromptIntent:             promptIntent,
 		CreateByCategoryMetaData: newPlanCandidateSetMetaDataCreateByCategoryFromEntry(planCandidateSetMetaDataCreateByCategory),
 	}, nil
 }
+
+// newLocationEndFromEntity 到着地点が指定されていない場合は nil を返す
+func newLocationEndFromEntity(planCandidateSetMetaData generated.PlanCandidateSetMetaDatum) *models.GeoLocation {
+	if !planCandidateSetMetaData.LatitudeEnd.Valid || !planCandidateSetMetaData.LongitudeEnd.Valid {
+		return nil
+	}
+
+	return &models.GeoLocation{
+		Latitude:  planCandidateSetMetaData.LatitudeEnd.Float64,
+		Longitude: planCandidateSetMetaData.LongitudeEnd.Float64,
+	}
+}
+
+// newWeatherForecastFromEntity プラン作成時に天気予報が取得されていない場合は nil を返す
+func newWeatherForecastFromEntity(planCandidateSetMetaData generated.PlanCandidateSetMetaDatum) *models.WeatherForecast {
+	if !planCandidateSetMetaData.WeatherPrecipitationProbability.Valid && !planCandidateSetMetaData.WeatherPrecipitationMM.Valid {
+		return nil
+	}
+
+	return &models.WeatherForecast{
+		PrecipitationProbability: planCandidateSetMetaData.WeatherPrecipitationProbability.Int,
+		PrecipitationInMm:        planCandidateSetMetaData.WeatherPrecipitationMM.Float64,
+	}
+}
+
+// newPromptIntentFromEntity 自然文からプランが作成されていない場合は nil を返す
+func newPromptIntentFromEntity(planCandidateSetMetaData generated.PlanCandidateSetMetaDatum) (*models.PlanPromptIntent, error) {
+	if !planCandidateSetMetaData.PromptIntent.Valid {
+		return nil, nil
+	}
+
+	var promptIntent models.PlanPromptIntent
+	if err := planCandidateSetMetaData.PromptIntent.Unmarshal(&promptIntent); err != nil {
+		return nil, fmt.Errorf("error while unmarshalling prompt intent: %v", err)
+	}
+
+	return &promptIntent, nil
+}
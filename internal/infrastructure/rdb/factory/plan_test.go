@@ -4,6 +4,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/volatiletech/null/v8"
 	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
 	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
 	"testing"
 )
@@ -30,6 +31,54 @@ func TestNewPlanEntityFromDomainModel(t *testing.T) {
 				Name:   "plan title",
 			},
 		},
+		{
+			name: "should return a valid entity with description and version",
+			plan: models.Plan{
+				Id:          "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name:        "plan title",
+				Description: utils.StrPointer("plan description"),
+				Version:     3,
+			},
+			expected: generated.Plan{
+				ID:          "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name:        "plan title",
+				Description: null.StringFrom("plan description"),
+				Version:     3,
+			},
+		},
+		{
+			name: "should return a valid entity with visibility",
+			plan: models.Plan{
+				Id:         "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name:       "plan title",
+				Visibility: models.PlanVisibilityPrivate,
+			},
+			expected: generated.Plan{
+				ID:         "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name:       "plan title",
+				Visibility: "PRIVATE",
+			},
+		},
+		{
+			name: "should return a valid entity with location of first place",
+			plan: models.Plan{
+				Id:   "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name: "plan title",
+				Places: []models.Place{
+					{Id: "first-place", Location: models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}},
+					{Id: "second-place", Location: models.GeoLocation{Latitude: 35.690921, Longitude: 139.700258}},
+				},
+			},
+			expected: generated.Plan{
+				ID:              "ec7c607d-454a-4644-929a-c3b1e078842d",
+				Name:            "plan title",
+				Latitude:        35.6812362,
+				Longitude:       139.7649361,
+				S2CellIDLevel10: null.Uint64From(6924437259198398464),
+				S2CellIDLevel13: null.Uint64From(6924438341530157056),
+				S2CellIDLevel16: null.Uint64From(6924438331061174272),
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,57 @@
+package factory
+
+import (
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func NewTripEntityFromDomainModel(trip models.Trip) generated.Trip {
+	if trip.Id == "" {
+		trip.Id = uuid.New().String()
+	}
+
+	var userId *string
+	if trip.Author != nil {
+		userId = &trip.Author.Id
+	}
+
+	travelMode := trip.TravelMode
+	if !travelMode.IsValid() {
+		travelMode = models.TravelModeWalking
+	}
+
+	return generated.Trip{
+		ID:         trip.Id,
+		UserID:     null.StringFromPtr(userId),
+		Name:       trip.Name,
+		StartAt:    null.TimeFromPtr(trip.StartTime),
+		TravelMode: string(travelMode),
+	}
+}
+
+func NewTripPlanSliceFromDomainModel(trip models.Trip) generated.TripPlanSlice {
+	tripPlanSlice := make(generated.TripPlanSlice, 0, len(trip.Plans))
+	for i, plan := range trip.Plans {
+		tripPlanSlice = append(tripPlanSlice, &generated.TripPlan{
+			ID:       uuid.New().String(),
+			TripID:   trip.Id,
+			PlanID:   plan.Id,
+			DayIndex: i,
+		})
+	}
+	return tripPlanSlice
+}
+
+// NewTripFromEntity plans は日付順に並んでいるものとする
+func NewTripFromEntity(tripEntity generated.Trip, plans []models.Plan, author *models.User) *models.Trip {
+	return &models.Trip{
+		Id:         tripEntity.ID,
+		Name:       tripEntity.Name,
+		Plans:      plans,
+		Author:     author,
+		StartTime:  tripEntity.StartAt.Ptr(),
+		TravelMode: models.TravelMode(tripEntity.TravelMode),
+	}
+}
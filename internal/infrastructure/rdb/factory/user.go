@@ -14,6 +14,8 @@ func NewUserEntityFromUser(user models.User) generated.User {
 		Name:        null.StringFrom(user.Name),
 		PhotoURL:    null.StringFrom(utils.StrEmptyIfNil(user.PhotoUrl)),
 		Email:       null.StringFrom(utils.StrEmptyIfNil(user.Email)),
+
+		PersonalizationDisabled: user.PersonalizationDisabled,
 	}
 }
 
@@ -24,5 +26,7 @@ func NewUserFromUserEntity(userEntity generated.User) *models.User {
 		Name:        userEntity.Name.String,
 		PhotoUrl:    utils.StrOmitEmpty(userEntity.PhotoURL.String),
 		Email:       utils.StrOmitEmpty(userEntity.Email.String),
+
+		PersonalizationDisabled: userEntity.PersonalizationDisabled,
 	}
 }
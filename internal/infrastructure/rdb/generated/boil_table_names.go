@@ -13,13 +13,19 @@ var TableNames = struct {
 	GooglePlaces                             string
 	PlacePhotoReferences                     string
 	PlacePhotos                              string
+	PlaceRankingScores                       string
 	PlaceRecommendations                     string
 	Places                                   string
+	PlanCandidateEditHistories               string
 	PlanCandidatePlaces                      string
+	PlanCandidateSetGroups                   string
 	PlanCandidateSetLikePlaces               string
 	PlanCandidateSetMetaData                 string
 	PlanCandidateSetMetaDataCategories       string
 	PlanCandidateSetMetaDataCreateByCategory string
+	PlanCandidateSetParticipants             string
+	PlanCandidateSetPlaceVotes               string
+	PlanCandidateSetPlanVotes                string
 	PlanCandidateSets                        string
 	PlanCandidates                           string
 	PlanCollagePhotos                        string
@@ -27,6 +33,8 @@ var TableNames = struct {
 	PlanParentChildren                       string
 	PlanPlaces                               string
 	Plans                                    string
+	TripPlans                                string
+	Trips                                    string
 	UserLikePlaces                           string
 	Users                                    string
 }{
@@ -39,13 +47,19 @@ var TableNames = struct {
 	GooglePlaces:                             "google_places",
 	PlacePhotoReferences:                     "place_photo_references",
 	PlacePhotos:                              "place_photos",
+	PlaceRankingScores:                       "place_ranking_scores",
 	PlaceRecommendations:                     "place_recommendations",
 	Places:                                   "places",
+	PlanCandidateEditHistories:               "plan_candidate_edit_histories",
 	PlanCandidatePlaces:                      "plan_candidate_places",
+	PlanCandidateSetGroups:                   "plan_candidate_set_groups",
 	PlanCandidateSetLikePlaces:               "plan_candidate_set_like_places",
 	PlanCandidateSetMetaData:                 "plan_candidate_set_meta_data",
 	PlanCandidateSetMetaDataCategories:       "plan_candidate_set_meta_data_categories",
 	PlanCandidateSetMetaDataCreateByCategory: "plan_candidate_set_meta_data_create_by_category",
+	PlanCandidateSetParticipants:             "plan_candidate_set_participants",
+	PlanCandidateSetPlaceVotes:               "plan_candidate_set_place_votes",
+	PlanCandidateSetPlanVotes:                "plan_candidate_set_plan_votes",
 	PlanCandidateSets:                        "plan_candidate_sets",
 	PlanCandidates:                           "plan_candidates",
 	PlanCollagePhotos:                        "plan_collage_photos",
@@ -53,6 +67,8 @@ var TableNames = struct {
 	PlanParentChildren:                       "plan_parent_children",
 	PlanPlaces:                               "plan_places",
 	Plans:                                    "plans",
+	TripPlans:                                "trip_plans",
+	Trips:                                    "trips",
 	UserLikePlaces:                           "user_like_places",
 	Users:                                    "users",
 }
@@ -24,77 +24,107 @@ import (
 
 // GooglePlace is an object representing the database table.
 type GooglePlace struct {
-	GooglePlaceID    string       `boil:"google_place_id" json:"google_place_id" toml:"google_place_id" yaml:"google_place_id"`
-	PlaceID          string       `boil:"place_id" json:"place_id" toml:"place_id" yaml:"place_id"`
-	Name             string       `boil:"name" json:"name" toml:"name" yaml:"name"`
-	FormattedAddress null.String  `boil:"formatted_address" json:"formatted_address,omitempty" toml:"formatted_address" yaml:"formatted_address,omitempty"`
-	Vicinity         null.String  `boil:"vicinity" json:"vicinity,omitempty" toml:"vicinity" yaml:"vicinity,omitempty"`
-	PriceLevel       null.Int     `boil:"price_level" json:"price_level,omitempty" toml:"price_level" yaml:"price_level,omitempty"`
-	Rating           null.Float32 `boil:"rating" json:"rating,omitempty" toml:"rating" yaml:"rating,omitempty"`
-	UserRatingsTotal null.Int     `boil:"user_ratings_total" json:"user_ratings_total,omitempty" toml:"user_ratings_total" yaml:"user_ratings_total,omitempty"`
-	Latitude         float64      `boil:"latitude" json:"latitude" toml:"latitude" yaml:"latitude"`
-	Longitude        float64      `boil:"longitude" json:"longitude" toml:"longitude" yaml:"longitude"`
-	CreatedAt        null.Time    `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
-	UpdatedAt        null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	GooglePlaceID           string       `boil:"google_place_id" json:"google_place_id" toml:"google_place_id" yaml:"google_place_id"`
+	PlaceID                 string       `boil:"place_id" json:"place_id" toml:"place_id" yaml:"place_id"`
+	Name                    string       `boil:"name" json:"name" toml:"name" yaml:"name"`
+	FormattedAddress        null.String  `boil:"formatted_address" json:"formatted_address,omitempty" toml:"formatted_address" yaml:"formatted_address,omitempty"`
+	Vicinity                null.String  `boil:"vicinity" json:"vicinity,omitempty" toml:"vicinity" yaml:"vicinity,omitempty"`
+	PriceLevel              null.Int     `boil:"price_level" json:"price_level,omitempty" toml:"price_level" yaml:"price_level,omitempty"`
+	Rating                  null.Float32 `boil:"rating" json:"rating,omitempty" toml:"rating" yaml:"rating,omitempty"`
+	UserRatingsTotal        null.Int     `boil:"user_ratings_total" json:"user_ratings_total,omitempty" toml:"user_ratings_total" yaml:"user_ratings_total,omitempty"`
+	Latitude                float64      `boil:"latitude" json:"latitude" toml:"latitude" yaml:"latitude"`
+	Longitude               float64      `boil:"longitude" json:"longitude" toml:"longitude" yaml:"longitude"`
+	CreatedAt               null.Time    `boil:"created_at" json:"created_at,omitempty" toml:"created_at" yaml:"created_at,omitempty"`
+	UpdatedAt               null.Time    `boil:"updated_at" json:"updated_at,omitempty" toml:"updated_at" yaml:"updated_at,omitempty"`
+	S2CellIDLevel10         null.Uint64  `boil:"s2_cell_id_level10" json:"s2_cell_id_level10,omitempty" toml:"s2_cell_id_level10" yaml:"s2_cell_id_level10,omitempty"`
+	S2CellIDLevel13         null.Uint64  `boil:"s2_cell_id_level13" json:"s2_cell_id_level13,omitempty" toml:"s2_cell_id_level13" yaml:"s2_cell_id_level13,omitempty"`
+	S2CellIDLevel16         null.Uint64  `boil:"s2_cell_id_level16" json:"s2_cell_id_level16,omitempty" toml:"s2_cell_id_level16" yaml:"s2_cell_id_level16,omitempty"`
+	FetchedAt               time.Time    `boil:"fetched_at" json:"fetched_at" toml:"fetched_at" yaml:"fetched_at"`
+	OpeningPeriodsFetchedAt null.Time    `boil:"opening_periods_fetched_at" json:"opening_periods_fetched_at,omitempty" toml:"opening_periods_fetched_at" yaml:"opening_periods_fetched_at,omitempty"`
+	ReviewsFetchedAt        null.Time    `boil:"reviews_fetched_at" json:"reviews_fetched_at,omitempty" toml:"reviews_fetched_at" yaml:"reviews_fetched_at,omitempty"`
 
 	R *googlePlaceR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L googlePlaceL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var GooglePlaceColumns = struct {
-	GooglePlaceID    string
-	PlaceID          string
-	Name             string
-	FormattedAddress string
-	Vicinity         string
-	PriceLevel       string
-	Rating           string
-	UserRatingsTotal string
-	Latitude         string
-	Longitude        string
-	CreatedAt        string
-	UpdatedAt        string
+	GooglePlaceID           string
+	PlaceID                 string
+	Name                    string
+	FormattedAddress        string
+	Vicinity                string
+	PriceLevel              string
+	Rating                  string
+	UserRatingsTotal        string
+	Latitude                string
+	Longitude               string
+	CreatedAt               string
+	UpdatedAt               string
+	S2CellIDLevel10         string
+	S2CellIDLevel13         string
+	S2CellIDLevel16         string
+	FetchedAt               string
+	OpeningPeriodsFetchedAt string
+	ReviewsFetchedAt        string
 }{
-	GooglePlaceID:    "google_place_id",
-	PlaceID:          "place_id",
-	Name:             "name",
-	FormattedAddress: "formatted_address",
-	Vicinity:         "vicinity",
-	PriceLevel:       "price_level",
-	Rating:           "rating",
-	UserRatingsTotal: "user_ratings_total",
-	Latitude:         "latitude",
-	Longitude:        "longitude",
-	CreatedAt:        "created_at",
-	UpdatedAt:        "updated_at",
+	GooglePlaceID:           "google_place_id",
+	PlaceID:                 "place_id",
+	Name:                    "name",
+	FormattedAddress:        "formatted_address",
+	Vicinity:                "vicinity",
+	PriceLevel:              "price_level",
+	Rating:                  "rating",
+	UserRatingsTotal:        "user_ratings_total",
+	Latitude:                "latitude",
+	Longitude:               "longitude",
+	CreatedAt:               "created_at",
+	UpdatedAt:               "updated_at",
+	S2CellIDLevel10:         "s2_cell_id_level10",
+	S2CellIDLevel13:         "s2_cell_id_level13",
+	S2CellIDLevel16:         "s2_cell_id_level16",
+	FetchedAt:               "fetched_at",
+	OpeningPeriodsFetchedAt: "opening_periods_fetched_at",
+	ReviewsFetchedAt:        "reviews_fetched_at",
 }
 
 var GooglePlaceTableColumns = struct {
-	GooglePlaceID    string
-	PlaceID          string
-	Name             string
-	FormattedAddress string
-	Vicinity         string
-	PriceLevel       string
-	Rating           string
-	UserRatingsTotal string
-	Latitude         string
-	Longitude        string
-	CreatedAt        string
-	UpdatedAt        string
+	GooglePlaceID           string
+	PlaceID                 string
+	Name                    string
+	FormattedAddress        string
+	Vicinity                string
+	PriceLevel              string
+	Rating                  string
+	UserRatingsTotal        string
+	Latitude                string
+	Longitude               string
+	CreatedAt               string
+	UpdatedAt               string
+	S2CellIDLevel10         string
+	S2CellIDLevel13         string
+	S2CellIDLevel16         string
+	FetchedAt               string
+	OpeningPeriodsFetchedAt string
+	ReviewsFetchedAt        string
 }{
-	GooglePlaceID:    "google_places.google_place_id",
-	PlaceID:          "google_places.place_id",
-	Name:             "google_places.name",
-	FormattedAddress: "google_places.formatted_address",
-	Vicinity:         "google_places.vicinity",
-	PriceLevel:       "google_places.price_level",
-	Rating:           "google_places.rating",
-	UserRatingsTotal: "google_places.user_ratings_total",
-	Latitude:         "google_places.latitude",
-	Longitude:        "google_places.longitude",
-	CreatedAt:        "google_places.created_at",
-	UpdatedAt:        "google_places.updated_at",
+	GooglePlaceID:           "google_places.google_place_id",
+	PlaceID:                 "google_places.place_id",
+	Name:                    "google_places.name",
+	FormattedAddress:        "google_places.formatted_address",
+	Vicinity:                "google_places.vicinity",
+	PriceLevel:              "google_places.price_level",
+	Rating:                  "google_places.rating",
+	UserRatingsTotal:        "google_places.user_ratings_total",
+	Latitude:                "google_places.latitude",
+	Longitude:               "google_places.longitude",
+	CreatedAt:               "google_places.created_at",
+	UpdatedAt:               "google_places.updated_at",
+	S2CellIDLevel10:         "google_places.s2_cell_id_level10",
+	S2CellIDLevel13:         "google_places.s2_cell_id_level13",
+	S2CellIDLevel16:         "google_places.s2_cell_id_level16",
+	FetchedAt:               "google_places.fetched_at",
+	OpeningPeriodsFetchedAt: "google_places.opening_periods_fetched_at",
+	ReviewsFetchedAt:        "google_places.reviews_fetched_at",
 }
 
 // Generated where
@@ -166,32 +196,103 @@ func (w whereHelperfloat64) NIN(slice []float64) qm.QueryMod {
 	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
 }
 
+type whereHelpernull_Uint64 struct{ field string }
+
+func (w whereHelpernull_Uint64) EQ(x null.Uint64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Uint64) NEQ(x null.Uint64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Uint64) LT(x null.Uint64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Uint64) LTE(x null.Uint64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Uint64) GT(x null.Uint64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Uint64) GTE(x null.Uint64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Uint64) IN(slice []uint64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Uint64) NIN(slice []uint64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Uint64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Uint64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpertime_Time struct{ field string }
+
+func (w whereHelpertime_Time) EQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertime_Time) NEQ(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertime_Time) LT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertime_Time) LTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertime_Time) GT(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertime_Time) GTE(x time.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var GooglePlaceWhere = struct {
-	GooglePlaceID    whereHelperstring
-	PlaceID          whereHelperstring
-	Name             whereHelperstring
-	FormattedAddress whereHelpernull_String
-	Vicinity         whereHelpernull_String
-	PriceLevel       whereHelpernull_Int
-	Rating           whereHelpernull_Float32
-	UserRatingsTotal whereHelpernull_Int
-	Latitude         whereHelperfloat64
-	Longitude        whereHelperfloat64
-	CreatedAt        whereHelpernull_Time
-	UpdatedAt        whereHelpernull_Time
+	GooglePlaceID           whereHelperstring
+	PlaceID                 whereHelperstring
+	Name                    whereHelperstring
+	FormattedAddress        whereHelpernull_String
+	Vicinity                whereHelpernull_String
+	PriceLevel              whereHelpernull_Int
+	Rating                  whereHelpernull_Float32
+	UserRatingsTotal        whereHelpernull_Int
+	Latitude                whereHelperfloat64
+	Longitude               whereHelperfloat64
+	CreatedAt               whereHelpernull_Time
+	UpdatedAt               whereHelpernull_Time
+	S2CellIDLevel10         whereHelpernull_Uint64
+	S2CellIDLevel13         whereHelpernull_Uint64
+	S2CellIDLevel16         whereHelpernull_Uint64
+	FetchedAt               whereHelpertime_Time
+	OpeningPeriodsFetchedAt whereHelpernull_Time
+	ReviewsFetchedAt        whereHelpernull_Time
 }{
-	GooglePlaceID:    whereHelperstring{field: "`google_places`.`google_place_id`"},
-	PlaceID:          whereHelperstring{field: "`google_places`.`place_id`"},
-	Name:             whereHelperstring{field: "`google_places`.`name`"},
-	FormattedAddress: whereHelpernull_String{field: "`google_places`.`formatted_address`"},
-	Vicinity:         whereHelpernull_String{field: "`google_places`.`vicinity`"},
-	PriceLevel:       whereHelpernull_Int{field: "`google_places`.`price_level`"},
-	Rating:           whereHelpernull_Float32{field: "`google_places`.`rating`"},
-	UserRatingsTotal: whereHelpernull_Int{field: "`google_places`.`user_ratings_total`"},
-	Latitude:         whereHelperfloat64{field: "`google_places`.`latitude`"},
-	Longitude:        whereHelperfloat64{field: "`google_places`.`longitude`"},
-	CreatedAt:        whereHelpernull_Time{field: "`google_places`.`created_at`"},
-	UpdatedAt:        whereHelpernull_Time{field: "`google_places`.`updated_at`"},
+	GooglePlaceID:           whereHelperstring{field: "`google_places`.`google_place_id`"},
+	PlaceID:                 whereHelperstring{field: "`google_places`.`place_id`"},
+	Name:                    whereHelperstring{field: "`google_places`.`name`"},
+	FormattedAddress:        whereHelpernull_String{field: "`google_places`.`formatted_address`"},
+	Vicinity:                whereHelpernull_String{field: "`google_places`.`vicinity`"},
+	PriceLevel:              whereHelpernull_Int{field: "`google_places`.`price_level`"},
+	Rating:                  whereHelpernull_Float32{field: "`google_places`.`rating`"},
+	UserRatingsTotal:        whereHelpernull_Int{field: "`google_places`.`user_ratings_total`"},
+	Latitude:                whereHelperfloat64{field: "`google_places`.`latitude`"},
+	Longitude:               whereHelperfloat64{field: "`google_places`.`longitude`"},
+	CreatedAt:               whereHelpernull_Time{field: "`google_places`.`created_at`"},
+	UpdatedAt:               whereHelpernull_Time{field: "`google_places`.`updated_at`"},
+	S2CellIDLevel10:         whereHelpernull_Uint64{field: "`google_places`.`s2_cell_id_level10`"},
+	S2CellIDLevel13:         whereHelpernull_Uint64{field: "`google_places`.`s2_cell_id_level13`"},
+	S2CellIDLevel16:         whereHelpernull_Uint64{field: "`google_places`.`s2_cell_id_level16`"},
+	FetchedAt:               whereHelpertime_Time{field: "`google_places`.`fetched_at`"},
+	OpeningPeriodsFetchedAt: whereHelpernull_Time{field: "`google_places`.`opening_periods_fetched_at`"},
+	ReviewsFetchedAt:        whereHelpernull_Time{field: "`google_places`.`reviews_fetched_at`"},
 }
 
 // GooglePlaceRels is where relationship names are stored.
@@ -282,9 +383,9 @@ func (r *googlePlaceR) GetGooglePlaceTypes() GooglePlaceTypeSlice {
 type googlePlaceL struct{}
 
 var (
-	googlePlaceAllColumns            = []string{"google_place_id", "place_id", "name", "formatted_address", "vicinity", "price_level", "rating", "user_ratings_total", "latitude", "longitude", "created_at", "updated_at"}
-	googlePlaceColumnsWithoutDefault = []string{"google_place_id", "place_id", "name", "formatted_address", "vicinity", "price_level", "rating", "user_ratings_total", "latitude", "longitude"}
-	googlePlaceColumnsWithDefault    = []string{"created_at", "updated_at"}
+	googlePlaceAllColumns            = []string{"google_place_id", "place_id", "name", "formatted_address", "vicinity", "price_level", "rating", "user_ratings_total", "latitude", "longitude", "created_at", "updated_at", "s2_cell_id_level10", "s2_cell_id_level13", "s2_cell_id_level16", "fetched_at", "opening_periods_fetched_at", "reviews_fetched_at"}
+	googlePlaceColumnsWithoutDefault = []string{"google_place_id", "place_id", "name", "formatted_address", "vicinity", "price_level", "rating", "user_ratings_total", "latitude", "longitude", "s2_cell_id_level10", "s2_cell_id_level13", "s2_cell_id_level16", "opening_periods_fetched_at", "reviews_fetched_at"}
+	googlePlaceColumnsWithDefault    = []string{"created_at", "updated_at", "fetched_at"}
 	googlePlacePrimaryKeyColumns     = []string{"google_place_id"}
 	googlePlaceGeneratedColumns      = []string{}
 )
@@ -73,22 +73,26 @@ var PlaceWhere = struct {
 
 // PlaceRels is where relationship names are stored.
 var PlaceRels = struct {
+	PlaceRankingScore          string
 	PlaceRecommendation        string
 	GooglePlaces               string
 	PlacePhotoReferences       string
 	PlacePhotos                string
 	PlanCandidatePlaces        string
 	PlanCandidateSetLikePlaces string
+	PlanCandidateSetPlaceVotes string
 	PlanCollagePhotos          string
 	PlanPlaces                 string
 	UserLikePlaces             string
 }{
+	PlaceRankingScore:          "PlaceRankingScore",
 	PlaceRecommendation:        "PlaceRecommendation",
 	GooglePlaces:               "GooglePlaces",
 	PlacePhotoReferences:       "PlacePhotoReferences",
 	PlacePhotos:                "PlacePhotos",
 	PlanCandidatePlaces:        "PlanCandidatePlaces",
 	PlanCandidateSetLikePlaces: "PlanCandidateSetLikePlaces",
+	PlanCandidateSetPlaceVotes: "PlanCandidateSetPlaceVotes",
 	PlanCollagePhotos:          "PlanCollagePhotos",
 	PlanPlaces:                 "PlanPlaces",
 	UserLikePlaces:             "UserLikePlaces",
@@ -96,12 +100,14 @@ var PlaceRels = struct {
 
 // placeR is where relationships are stored.
 type placeR struct {
+	PlaceRankingScore          *PlaceRankingScore             `boil:"PlaceRankingScore" json:"PlaceRankingScore" toml:"PlaceRankingScore" yaml:"PlaceRankingScore"`
 	PlaceRecommendation        *PlaceRecommendation           `boil:"PlaceRecommendation" json:"PlaceRecommendation" toml:"PlaceRecommendation" yaml:"PlaceRecommendation"`
 	GooglePlaces               GooglePlaceSlice               `boil:"GooglePlaces" json:"GooglePlaces" toml:"GooglePlaces" yaml:"GooglePlaces"`
 	PlacePhotoReferences       PlacePhotoReferenceSlice       `boil:"PlacePhotoReferences" json:"PlacePhotoReferences" toml:"PlacePhotoReferences" yaml:"PlacePhotoReferences"`
 	PlacePhotos                PlacePhotoSlice                `boil:"PlacePhotos" json:"PlacePhotos" toml:"PlacePhotos" yaml:"PlacePhotos"`
 	PlanCandidatePlaces        PlanCandidatePlaceSlice        `boil:"PlanCandidatePlaces" json:"PlanCandidatePlaces" toml:"PlanCandidatePlaces" yaml:"PlanCandidatePlaces"`
 	PlanCandidateSetLikePlaces PlanCandidateSetLikePlaceSlice `boil:"PlanCandidateSetLikePlaces" json:"PlanCandidateSetLikePlaces" toml:"PlanCandidateSetLikePlaces" yaml:"PlanCandidateSetLikePlaces"`
+	PlanCandidateSetPlaceVotes PlanCandidateSetPlaceVoteSlice `boil:"PlanCandidateSetPlaceVotes" json:"PlanCandidateSetPlaceVotes" toml:"PlanCandidateSetPlaceVotes" yaml:"PlanCandidateSetPlaceVotes"`
 	PlanCollagePhotos          PlanCollagePhotoSlice          `boil:"PlanCollagePhotos" json:"PlanCollagePhotos" toml:"PlanCollagePhotos" yaml:"PlanCollagePhotos"`
 	PlanPlaces                 PlanPlaceSlice                 `boil:"PlanPlaces" json:"PlanPlaces" toml:"PlanPlaces" yaml:"PlanPlaces"`
 	UserLikePlaces             UserLikePlaceSlice             `boil:"UserLikePlaces" json:"UserLikePlaces" toml:"UserLikePlaces" yaml:"UserLikePlaces"`
@@ -112,6 +118,13 @@ func (*placeR) NewStruct() *placeR {
 	return &placeR{}
 }
 
+func (r *placeR) GetPlaceRankingScore() *PlaceRankingScore {
+	if r == nil {
+		return nil
+	}
+	return r.PlaceRankingScore
+}
+
 func (r *placeR) GetPlaceRecommendation() *PlaceRecommendation {
 	if r == nil {
 		return nil
@@ -154,6 +167,13 @@ func (r *placeR) GetPlanCandidateSetLikePlaces() PlanCandidateSetLikePlaceSlice
 	return r.PlanCandidateSetLikePlaces
 }
 
+func (r *placeR) GetPlanCandidateSetPlaceVotes() PlanCandidateSetPlaceVoteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateSetPlaceVotes
+}
+
 func (r *placeR) GetPlanCollagePhotos() PlanCollagePhotoSlice {
 	if r == nil {
 		return nil
@@ -491,6 +511,17 @@ func (q placeQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool
 	return count > 0, nil
 }
 
+// PlaceRankingScore pointed to by the foreign key.
+func (o *Place) PlaceRankingScore(mods ...qm.QueryMod) placeRankingScoreQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`place_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PlaceRankingScores(queryMods...)
+}
+
 // PlaceRecommendation pointed to by the foreign key.
 func (o *Place) PlaceRecommendation(mods ...qm.QueryMod) placeRecommendationQuery {
 	queryMods := []qm.QueryMod{
@@ -572,6 +603,20 @@ func (o *Place) PlanCandidateSetLikePlaces(mods ...qm.QueryMod) planCandidateSet
 	return PlanCandidateSetLikePlaces(queryMods...)
 }
 
+// PlanCandidateSetPlaceVotes retrieves all the plan_candidate_set_place_vote's PlanCandidateSetPlaceVotes with an executor.
+func (o *Place) PlanCandidateSetPlaceVotes(mods ...qm.QueryMod) planCandidateSetPlaceVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_set_place_votes`.`place_id`=?", o.ID),
+	)
+
+	return PlanCandidateSetPlaceVotes(queryMods...)
+}
+
 // PlanCollagePhotos retrieves all the plan_collage_photo's PlanCollagePhotos with an executor.
 func (o *Place) PlanCollagePhotos(mods ...qm.QueryMod) planCollagePhotoQuery {
 	var queryMods []qm.QueryMod
@@ -614,6 +659,123 @@ func (o *Place) UserLikePlaces(mods ...qm.QueryMod) userLikePlaceQuery {
 	return UserLikePlaces(queryMods...)
 }
 
+// LoadPlaceRankingScore allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (placeL) LoadPlaceRankingScore(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlace interface{}, mods queries.Applicator) error {
+	var slice []*Place
+	var object *Place
+
+	if singular {
+		var ok bool
+		object, ok = maybePlace.(*Place)
+		if !ok {
+			object = new(Place)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlace)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlace))
+			}
+		}
+	} else {
+		s, ok := maybePlace.(*[]*Place)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlace)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlace))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &placeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &placeR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`place_ranking_scores`),
+		qm.WhereIn(`place_ranking_scores.place_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PlaceRankingScore")
+	}
+
+	var resultSlice []*PlaceRankingScore
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PlaceRankingScore")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for place_ranking_scores")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for place_ranking_scores")
+	}
+
+	if len(placeRankingScoreAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PlaceRankingScore = foreign
+		if foreign.R == nil {
+			foreign.R = &placeRankingScoreR{}
+		}
+		foreign.R.Place = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PlaceID {
+				local.R.PlaceRankingScore = foreign
+				if foreign.R == nil {
+					foreign.R = &placeRankingScoreR{}
+				}
+				foreign.R.Place = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlaceRecommendation allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (placeL) LoadPlaceRecommendation(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlace interface{}, mods queries.Applicator) error {
@@ -1296,6 +1458,119 @@ func (placeL) LoadPlanCandidateSetLikePlaces(ctx context.Context, e boil.Context
 	return nil
 }
 
+// LoadPlanCandidateSetPlaceVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (placeL) LoadPlanCandidateSetPlaceVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlace interface{}, mods queries.Applicator) error {
+	var slice []*Place
+	var object *Place
+
+	if singular {
+		var ok bool
+		object, ok = maybePlace.(*Place)
+		if !ok {
+			object = new(Place)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlace)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlace))
+			}
+		}
+	} else {
+		s, ok := maybePlace.(*[]*Place)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlace)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlace))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &placeR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &placeR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_set_place_votes`),
+		qm.WhereIn(`plan_candidate_set_place_votes.place_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_set_place_votes")
+	}
+
+	var resultSlice []*PlanCandidateSetPlaceVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_set_place_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_set_place_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_set_place_votes")
+	}
+
+	if len(planCandidateSetPlaceVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateSetPlaceVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateSetPlaceVoteR{}
+			}
+			foreign.R.Place = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PlaceID {
+				local.R.PlanCandidateSetPlaceVotes = append(local.R.PlanCandidateSetPlaceVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateSetPlaceVoteR{}
+				}
+				foreign.R.Place = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlanCollagePhotos allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (placeL) LoadPlanCollagePhotos(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlace interface{}, mods queries.Applicator) error {
@@ -1635,6 +1910,56 @@ func (placeL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, si
 	return nil
 }
 
+// SetPlaceRankingScore of the place to the related item.
+// Sets o.R.PlaceRankingScore to related.
+// Adds o to related.R.Place.
+func (o *Place) SetPlaceRankingScore(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PlaceRankingScore) error {
+	var err error
+
+	if insert {
+		related.PlaceID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `place_ranking_scores` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"place_id"}),
+			strmangle.WhereClause("`", "`", 0, placeRankingScorePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.PlaceID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PlaceID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &placeR{
+			PlaceRankingScore: related,
+		}
+	} else {
+		o.R.PlaceRankingScore = related
+	}
+
+	if related.R == nil {
+		related.R = &placeRankingScoreR{
+			Place: o,
+		}
+	} else {
+		related.R.Place = o
+	}
+	return nil
+}
+
 // SetPlaceRecommendation of the place to the related item.
 // Sets o.R.PlaceRecommendation to related.
 // Adds o to related.R.Place.
@@ -1950,6 +2275,59 @@ func (o *Place) AddPlanCandidateSetLikePlaces(ctx context.Context, exec boil.Con
 	return nil
 }
 
+// AddPlanCandidateSetPlaceVotes adds the given related objects to the existing relationships
+// of the place, optionally inserting them as new records.
+// Appends related to o.R.PlanCandidateSetPlaceVotes.
+// Sets related.R.Place appropriately.
+func (o *Place) AddPlanCandidateSetPlaceVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSetPlaceVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PlaceID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `plan_candidate_set_place_votes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"place_id"}),
+				strmangle.WhereClause("`", "`", 0, planCandidateSetPlaceVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PlaceID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &placeR{
+			PlanCandidateSetPlaceVotes: related,
+		}
+	} else {
+		o.R.PlanCandidateSetPlaceVotes = append(o.R.PlanCandidateSetPlaceVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &planCandidateSetPlaceVoteR{
+				Place: o,
+			}
+		} else {
+			rel.R.Place = o
+		}
+	}
+	return nil
+}
+
 // AddPlanCollagePhotos adds the given related objects to the existing relationships
 // of the place, optionally inserting them as new records.
 // Appends related to o.R.PlanCollagePhotos.
@@ -3263,6 +3641,33 @@ func (s PlaceSlice) GetLoadedPlanCandidateSetLikePlaces() PlanCandidateSetLikePl
 	return result
 }
 
+// LoadPlanCandidateSetPlaceVotesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s PlaceSlice) LoadPlanCandidateSetPlaceVotesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateSetPlaceVotesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlaceSlice) LoadPlanCandidateSetPlaceVotesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Place](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateSetPlaceVotes(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlaceSlice) GetLoadedPlanCandidateSetPlaceVotes() PlanCandidateSetPlaceVoteSlice {
+	result := make(PlanCandidateSetPlaceVoteSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateSetPlaceVotes == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateSetPlaceVotes...)
+	}
+	return result
+}
+
 // LoadPlanCollagePhotosByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
 func (s PlaceSlice) LoadPlanCollagePhotosByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadPlanCollagePhotosByPageEx(ctx, e, DefaultPageSize, mods...)
@@ -3344,6 +3749,33 @@ func (s PlaceSlice) GetLoadedUserLikePlaces() UserLikePlaceSlice {
 	return result
 }
 
+// LoadPlaceRankingScoreByPage performs eager loading of values by page. This is for a 1-1 relationship.
+func (s PlaceSlice) LoadPlaceRankingScoreByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlaceRankingScoreByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlaceSlice) LoadPlaceRankingScoreByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Place](s, pageSize) {
+		if err := chunk[0].L.LoadPlaceRankingScore(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlaceSlice) GetLoadedPlaceRankingScore() PlaceRankingScoreSlice {
+	result := make(PlaceRankingScoreSlice, 0, len(s))
+	for _, item := range s {
+		if item.R == nil || item.R.PlaceRankingScore == nil {
+			continue
+		}
+		result = append(result, item.R.PlaceRankingScore)
+	}
+	return result
+}
+
 // LoadPlaceRecommendationByPage performs eager loading of values by page. This is for a 1-1 relationship.
 func (s PlaceSlice) LoadPlaceRecommendationByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadPlaceRecommendationByPageEx(ctx, e, DefaultPageSize, mods...)
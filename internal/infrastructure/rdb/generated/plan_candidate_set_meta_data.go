@@ -24,88 +24,204 @@ import (
 
 // PlanCandidateSetMetaDatum is an object representing the database table.
 type PlanCandidateSetMetaDatum struct {
-	ID                           string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	PlanCandidateSetID           string    `boil:"plan_candidate_set_id" json:"plan_candidate_set_id" toml:"plan_candidate_set_id" yaml:"plan_candidate_set_id"`
-	LatitudeStart                float64   `boil:"latitude_start" json:"latitude_start" toml:"latitude_start" yaml:"latitude_start"`
-	LongitudeStart               float64   `boil:"longitude_start" json:"longitude_start" toml:"longitude_start" yaml:"longitude_start"`
-	IsCreatedFromCurrentLocation bool      `boil:"is_created_from_current_location" json:"is_created_from_current_location" toml:"is_created_from_current_location" yaml:"is_created_from_current_location"`
-	PlanDurationMinutes          null.Int  `boil:"plan_duration_minutes" json:"plan_duration_minutes,omitempty" toml:"plan_duration_minutes" yaml:"plan_duration_minutes,omitempty"`
-	CreatedAt                    time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt                    time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                              string       `boil:"id" json:"id" toml:"id" yaml:"id"`
+	PlanCandidateSetID              string       `boil:"plan_candidate_set_id" json:"plan_candidate_set_id" toml:"plan_candidate_set_id" yaml:"plan_candidate_set_id"`
+	LatitudeStart                   float64      `boil:"latitude_start" json:"latitude_start" toml:"latitude_start" yaml:"latitude_start"`
+	LongitudeStart                  float64      `boil:"longitude_start" json:"longitude_start" toml:"longitude_start" yaml:"longitude_start"`
+	IsCreatedFromCurrentLocation    bool         `boil:"is_created_from_current_location" json:"is_created_from_current_location" toml:"is_created_from_current_location" yaml:"is_created_from_current_location"`
+	PlanDurationMinutes             null.Int     `boil:"plan_duration_minutes" json:"plan_duration_minutes,omitempty" toml:"plan_duration_minutes" yaml:"plan_duration_minutes,omitempty"`
+	CreatedAt                       time.Time    `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt                       time.Time    `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	StartAt                         null.Time    `boil:"start_at" json:"start_at,omitempty" toml:"start_at" yaml:"start_at,omitempty"`
+	TravelMode                      string       `boil:"travel_mode" json:"travel_mode" toml:"travel_mode" yaml:"travel_mode"`
+	BudgetMax                       null.Int     `boil:"budget_max" json:"budget_max,omitempty" toml:"budget_max" yaml:"budget_max,omitempty"`
+	NumberOfPeople                  null.Int     `boil:"number_of_people" json:"number_of_people,omitempty" toml:"number_of_people" yaml:"number_of_people,omitempty"`
+	WeatherPrecipitationProbability null.Int     `boil:"weather_precipitation_probability" json:"weather_precipitation_probability,omitempty" toml:"weather_precipitation_probability" yaml:"weather_precipitation_probability,omitempty"`
+	WeatherPrecipitationMM          null.Float64 `boil:"weather_precipitation_mm" json:"weather_precipitation_mm,omitempty" toml:"weather_precipitation_mm" yaml:"weather_precipitation_mm,omitempty"`
+	LatitudeEnd                     null.Float64 `boil:"latitude_end" json:"latitude_end,omitempty" toml:"latitude_end" yaml:"latitude_end,omitempty"`
+	LongitudeEnd                    null.Float64 `boil:"longitude_end" json:"longitude_end,omitempty" toml:"longitude_end" yaml:"longitude_end,omitempty"`
+	PromptIntent                    null.JSON    `boil:"prompt_intent" json:"prompt_intent,omitempty" toml:"prompt_intent" yaml:"prompt_intent,omitempty"`
 
 	R *planCandidateSetMetaDatumR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L planCandidateSetMetaDatumL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PlanCandidateSetMetaDatumColumns = struct {
-	ID                           string
-	PlanCandidateSetID           string
-	LatitudeStart                string
-	LongitudeStart               string
-	IsCreatedFromCurrentLocation string
-	PlanDurationMinutes          string
-	CreatedAt                    string
-	UpdatedAt                    string
+	ID                              string
+	PlanCandidateSetID              string
+	LatitudeStart                   string
+	LongitudeStart                  string
+	IsCreatedFromCurrentLocation    string
+	PlanDurationMinutes             string
+	CreatedAt                       string
+	UpdatedAt                       string
+	StartAt                         string
+	TravelMode                      string
+	BudgetMax                       string
+	NumberOfPeople                  string
+	WeatherPrecipitationProbability string
+	WeatherPrecipitationMM          string
+	LatitudeEnd                     string
+	LongitudeEnd                    string
+	PromptIntent                    string
 }{
-	ID:                           "id",
-	PlanCandidateSetID:           "plan_candidate_set_id",
-	LatitudeStart:                "latitude_start",
-	LongitudeStart:               "longitude_start",
-	IsCreatedFromCurrentLocation: "is_created_from_current_location",
-	PlanDurationMinutes:          "plan_duration_minutes",
-	CreatedAt:                    "created_at",
-	UpdatedAt:                    "updated_at",
+	ID:                              "id",
+	PlanCandidateSetID:              "plan_candidate_set_id",
+	LatitudeStart:                   "latitude_start",
+	LongitudeStart:                  "longitude_start",
+	IsCreatedFromCurrentLocation:    "is_created_from_current_location",
+	PlanDurationMinutes:             "plan_duration_minutes",
+	CreatedAt:                       "created_at",
+	UpdatedAt:                       "updated_at",
+	StartAt:                         "start_at",
+	TravelMode:                      "travel_mode",
+	BudgetMax:                       "budget_max",
+	NumberOfPeople:                  "number_of_people",
+	WeatherPrecipitationProbability: "weather_precipitation_probability",
+	WeatherPrecipitationMM:          "weather_precipitation_mm",
+	LatitudeEnd:                     "latitude_end",
+	LongitudeEnd:                    "longitude_end",
+	PromptIntent:                    "prompt_intent",
 }
 
 var PlanCandidateSetMetaDatumTableColumns = struct {
-	ID                           string
-	PlanCandidateSetID           string
-	LatitudeStart                string
-	LongitudeStart               string
-	IsCreatedFromCurrentLocation string
-	PlanDurationMinutes          string
-	CreatedAt                    string
-	UpdatedAt                    string
+	ID                              string
+	PlanCandidateSetID              string
+	LatitudeStart                   string
+	LongitudeStart                  string
+	IsCreatedFromCurrentLocation    string
+	PlanDurationMinutes             string
+	CreatedAt                       string
+	UpdatedAt                       string
+	StartAt                         string
+	TravelMode                      string
+	BudgetMax                       string
+	NumberOfPeople                  string
+	WeatherPrecipitationProbability string
+	WeatherPrecipitationMM          string
+	LatitudeEnd                     string
+	LongitudeEnd                    string
+	PromptIntent                    string
 }{
-	ID:                           "plan_candidate_set_meta_data.id",
-	PlanCandidateSetID:           "plan_candidate_set_meta_data.plan_candidate_set_id",
-	LatitudeStart:                "plan_candidate_set_meta_data.latitude_start",
-	LongitudeStart:               "plan_candidate_set_meta_data.longitude_start",
-	IsCreatedFromCurrentLocation: "plan_candidate_set_meta_data.is_created_from_current_location",
-	PlanDurationMinutes:          "plan_candidate_set_meta_data.plan_duration_minutes",
-	CreatedAt:                    "plan_candidate_set_meta_data.created_at",
-	UpdatedAt:                    "plan_candidate_set_meta_data.updated_at",
+	ID:                              "plan_candidate_set_meta_data.id",
+	PlanCandidateSetID:              "plan_candidate_set_meta_data.plan_candidate_set_id",
+	LatitudeStart:                   "plan_candidate_set_meta_data.latitude_start",
+	LongitudeStart:                  "plan_candidate_set_meta_data.longitude_start",
+	IsCreatedFromCurrentLocation:    "plan_candidate_set_meta_data.is_created_from_current_location",
+	PlanDurationMinutes:             "plan_candidate_set_meta_data.plan_duration_minutes",
+	CreatedAt:                       "plan_candidate_set_meta_data.created_at",
+	UpdatedAt:                       "plan_candidate_set_meta_data.updated_at",
+	StartAt:                         "plan_candidate_set_meta_data.start_at",
+	TravelMode:                      "plan_candidate_set_meta_data.travel_mode",
+	BudgetMax:                       "plan_candidate_set_meta_data.budget_max",
+	NumberOfPeople:                  "plan_candidate_set_meta_data.number_of_people",
+	WeatherPrecipitationProbability: "plan_candidate_set_meta_data.weather_precipitation_probability",
+	WeatherPrecipitationMM:          "plan_candidate_set_meta_data.weather_precipitation_mm",
+	LatitudeEnd:                     "plan_candidate_set_meta_data.latitude_end",
+	LongitudeEnd:                    "plan_candidate_set_meta_data.longitude_end",
+	PromptIntent:                    "plan_candidate_set_meta_data.prompt_intent",
 }
 
 // Generated where
 
-type whereHelperbool struct{ field string }
+type whereHelpernull_Float64 struct{ field string }
 
-func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
-func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
-func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
-func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
-func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
-func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelpernull_Float64) EQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Float64) NEQ(x null.Float64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Float64) LT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Float64) LTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Float64) GT(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Float64) GTE(x null.Float64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+func (w whereHelpernull_Float64) IN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelpernull_Float64) NIN(slice []float64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereNotIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+func (w whereHelpernull_Float64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Float64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_JSON struct{ field string }
+
+func (w whereHelpernull_JSON) EQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_JSON) NEQ(x null.JSON) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_JSON) LT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_JSON) LTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_JSON) GT(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_JSON) GTE(x null.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_JSON) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_JSON) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
 
 var PlanCandidateSetMetaDatumWhere = struct {
-	ID                           whereHelperstring
-	PlanCandidateSetID           whereHelperstring
-	LatitudeStart                whereHelperfloat64
-	LongitudeStart               whereHelperfloat64
-	IsCreatedFromCurrentLocation whereHelperbool
-	PlanDurationMinutes          whereHelpernull_Int
-	CreatedAt                    whereHelpertime_Time
-	UpdatedAt                    whereHelpertime_Time
+	ID                              whereHelperstring
+	PlanCandidateSetID              whereHelperstring
+	LatitudeStart                   whereHelperfloat64
+	LongitudeStart                  whereHelperfloat64
+	IsCreatedFromCurrentLocation    whereHelperbool
+	PlanDurationMinutes             whereHelpernull_Int
+	CreatedAt                       whereHelpertime_Time
+	UpdatedAt                       whereHelpertime_Time
+	StartAt                         whereHelpernull_Time
+	TravelMode                      whereHelperstring
+	BudgetMax                       whereHelpernull_Int
+	NumberOfPeople                  whereHelpernull_Int
+	WeatherPrecipitationProbability whereHelpernull_Int
+	WeatherPrecipitationMM          whereHelpernull_Float64
+	LatitudeEnd                     whereHelpernull_Float64
+	LongitudeEnd                    whereHelpernull_Float64
+	PromptIntent                    whereHelpernull_JSON
 }{
-	ID:                           whereHelperstring{field: "`plan_candidate_set_meta_data`.`id`"},
-	PlanCandidateSetID:           whereHelperstring{field: "`plan_candidate_set_meta_data`.`plan_candidate_set_id`"},
-	LatitudeStart:                whereHelperfloat64{field: "`plan_candidate_set_meta_data`.`latitude_start`"},
-	LongitudeStart:               whereHelperfloat64{field: "`plan_candidate_set_meta_data`.`longitude_start`"},
-	IsCreatedFromCurrentLocation: whereHelperbool{field: "`plan_candidate_set_meta_data`.`is_created_from_current_location`"},
-	PlanDurationMinutes:          whereHelpernull_Int{field: "`plan_candidate_set_meta_data`.`plan_duration_minutes`"},
-	CreatedAt:                    whereHelpertime_Time{field: "`plan_candidate_set_meta_data`.`created_at`"},
-	UpdatedAt:                    whereHelpertime_Time{field: "`plan_candidate_set_meta_data`.`updated_at`"},
+	ID:                              whereHelperstring{field: "`plan_candidate_set_meta_data`.`id`"},
+	PlanCandidateSetID:              whereHelperstring{field: "`plan_candidate_set_meta_data`.`plan_candidate_set_id`"},
+	LatitudeStart:                   whereHelperfloat64{field: "`plan_candidate_set_meta_data`.`latitude_start`"},
+	LongitudeStart:                  whereHelperfloat64{field: "`plan_candidate_set_meta_data`.`longitude_start`"},
+	IsCreatedFromCurrentLocation:    whereHelperbool{field: "`plan_candidate_set_meta_data`.`is_created_from_current_location`"},
+	PlanDurationMinutes:             whereHelpernull_Int{field: "`plan_candidate_set_meta_data`.`plan_duration_minutes`"},
+	CreatedAt:                       whereHelpertime_Time{field: "`plan_candidate_set_meta_data`.`created_at`"},
+	UpdatedAt:                       whereHelpertime_Time{field: "`plan_candidate_set_meta_data`.`updated_at`"},
+	StartAt:                         whereHelpernull_Time{field: "`plan_candidate_set_meta_data`.`start_at`"},
+	TravelMode:                      whereHelperstring{field: "`plan_candidate_set_meta_data`.`travel_mode`"},
+	BudgetMax:                       whereHelpernull_Int{field: "`plan_candidate_set_meta_data`.`budget_max`"},
+	NumberOfPeople:                  whereHelpernull_Int{field: "`plan_candidate_set_meta_data`.`number_of_people`"},
+	WeatherPrecipitationProbability: whereHelpernull_Int{field: "`plan_candidate_set_meta_data`.`weather_precipitation_probability`"},
+	WeatherPrecipitationMM:          whereHelpernull_Float64{field: "`plan_candidate_set_meta_data`.`weather_precipitation_mm`"},
+	LatitudeEnd:                     whereHelpernull_Float64{field: "`plan_candidate_set_meta_data`.`latitude_end`"},
+	LongitudeEnd:                    whereHelpernull_Float64{field: "`plan_candidate_set_meta_data`.`longitude_end`"},
+	PromptIntent:                    whereHelpernull_JSON{field: "`plan_candidate_set_meta_data`.`prompt_intent`"},
 }
 
 // PlanCandidateSetMetaDatumRels is where relationship names are stored.
@@ -136,9 +252,9 @@ func (r *planCandidateSetMetaDatumR) GetPlanCandidateSet() *PlanCandidateSet {
 type planCandidateSetMetaDatumL struct{}
 
 var (
-	planCandidateSetMetaDatumAllColumns            = []string{"id", "plan_candidate_set_id", "latitude_start", "longitude_start", "is_created_from_current_location", "plan_duration_minutes", "created_at", "updated_at"}
-	planCandidateSetMetaDatumColumnsWithoutDefault = []string{"id", "plan_candidate_set_id", "latitude_start", "longitude_start", "is_created_from_current_location", "plan_duration_minutes"}
-	planCandidateSetMetaDatumColumnsWithDefault    = []string{"created_at", "updated_at"}
+	planCandidateSetMetaDatumAllColumns            = []string{"id", "plan_candidate_set_id", "latitude_start", "longitude_start", "is_created_from_current_location", "plan_duration_minutes", "created_at", "updated_at", "start_at", "travel_mode", "budget_max", "number_of_people", "weather_precipitation_probability", "weather_precipitation_mm", "latitude_end", "longitude_end", "prompt_intent"}
+	planCandidateSetMetaDatumColumnsWithoutDefault = []string{"id", "plan_candidate_set_id", "latitude_start", "longitude_start", "is_created_from_current_location", "plan_duration_minutes", "start_at", "budget_max", "number_of_people", "weather_precipitation_probability", "weather_precipitation_mm", "latitude_end", "longitude_end", "prompt_intent"}
+	planCandidateSetMetaDatumColumnsWithDefault    = []string{"created_at", "updated_at", "travel_mode"}
 	planCandidateSetMetaDatumPrimaryKeyColumns     = []string{"id"}
 	planCandidateSetMetaDatumGeneratedColumns      = []string{}
 )
@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,11 +24,12 @@ import (
 
 // PlanCandidateSet is an object representing the database table.
 type PlanCandidateSet struct {
-	ID              string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	ExpiresAt       time.Time `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
-	CreatedAt       time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt       time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	IsPlaceSearched bool      `boil:"is_place_searched" json:"is_place_searched" toml:"is_place_searched" yaml:"is_place_searched"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	ExpiresAt       time.Time   `boil:"expires_at" json:"expires_at" toml:"expires_at" yaml:"expires_at"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	IsPlaceSearched bool        `boil:"is_place_searched" json:"is_place_searched" toml:"is_place_searched" yaml:"is_place_searched"`
+	UserID          null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
 
 	R *planCandidateSetR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L planCandidateSetL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -39,12 +41,14 @@ var PlanCandidateSetColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	IsPlaceSearched string
+	UserID          string
 }{
 	ID:              "id",
 	ExpiresAt:       "expires_at",
 	CreatedAt:       "created_at",
 	UpdatedAt:       "updated_at",
 	IsPlaceSearched: "is_place_searched",
+	UserID:          "user_id",
 }
 
 var PlanCandidateSetTableColumns = struct {
@@ -53,12 +57,14 @@ var PlanCandidateSetTableColumns = struct {
 	CreatedAt       string
 	UpdatedAt       string
 	IsPlaceSearched string
+	UserID          string
 }{
 	ID:              "plan_candidate_sets.id",
 	ExpiresAt:       "plan_candidate_sets.expires_at",
 	CreatedAt:       "plan_candidate_sets.created_at",
 	UpdatedAt:       "plan_candidate_sets.updated_at",
 	IsPlaceSearched: "plan_candidate_sets.is_place_searched",
+	UserID:          "plan_candidate_sets.user_id",
 }
 
 // Generated where
@@ -69,16 +75,21 @@ var PlanCandidateSetWhere = struct {
 	CreatedAt       whereHelpertime_Time
 	UpdatedAt       whereHelpertime_Time
 	IsPlaceSearched whereHelperbool
+	UserID          whereHelpernull_String
 }{
 	ID:              whereHelperstring{field: "`plan_candidate_sets`.`id`"},
 	ExpiresAt:       whereHelpertime_Time{field: "`plan_candidate_sets`.`expires_at`"},
 	CreatedAt:       whereHelpertime_Time{field: "`plan_candidate_sets`.`created_at`"},
 	UpdatedAt:       whereHelpertime_Time{field: "`plan_candidate_sets`.`updated_at`"},
 	IsPlaceSearched: whereHelperbool{field: "`plan_candidate_sets`.`is_place_searched`"},
+	UserID:          whereHelpernull_String{field: "`plan_candidate_sets`.`user_id`"},
 }
 
 // PlanCandidateSetRels is where relationship names are stored.
 var PlanCandidateSetRels = struct {
+	User                                       string
+	PlanCandidateSetGroup                      string
+	PlanCandidateEditHistories                 string
 	PlanCandidatePlaces                        string
 	PlanCandidateSetLikePlaces                 string
 	PlanCandidateSetMetaData                   string
@@ -86,6 +97,9 @@ var PlanCandidateSetRels = struct {
 	PlanCandidateSetMetaDataCreateByCategories string
 	PlanCandidates                             string
 }{
+	User:                                       "User",
+	PlanCandidateSetGroup:                      "PlanCandidateSetGroup",
+	PlanCandidateEditHistories:                 "PlanCandidateEditHistories",
 	PlanCandidatePlaces:                        "PlanCandidatePlaces",
 	PlanCandidateSetLikePlaces:                 "PlanCandidateSetLikePlaces",
 	PlanCandidateSetMetaData:                   "PlanCandidateSetMetaData",
@@ -96,6 +110,9 @@ var PlanCandidateSetRels = struct {
 
 // planCandidateSetR is where relationships are stored.
 type planCandidateSetR struct {
+	User                                       *User                                         `boil:"User" json:"User" toml:"User" yaml:"User"`
+	PlanCandidateSetGroup                      *PlanCandidateSetGroup                        `boil:"PlanCandidateSetGroup" json:"PlanCandidateSetGroup" toml:"PlanCandidateSetGroup" yaml:"PlanCandidateSetGroup"`
+	PlanCandidateEditHistories                 PlanCandidateEditHistorySlice                 `boil:"PlanCandidateEditHistories" json:"PlanCandidateEditHistories" toml:"PlanCandidateEditHistories" yaml:"PlanCandidateEditHistories"`
 	PlanCandidatePlaces                        PlanCandidatePlaceSlice                       `boil:"PlanCandidatePlaces" json:"PlanCandidatePlaces" toml:"PlanCandidatePlaces" yaml:"PlanCandidatePlaces"`
 	PlanCandidateSetLikePlaces                 PlanCandidateSetLikePlaceSlice                `boil:"PlanCandidateSetLikePlaces" json:"PlanCandidateSetLikePlaces" toml:"PlanCandidateSetLikePlaces" yaml:"PlanCandidateSetLikePlaces"`
 	PlanCandidateSetMetaData                   PlanCandidateSetMetaDatumSlice                `boil:"PlanCandidateSetMetaData" json:"PlanCandidateSetMetaData" toml:"PlanCandidateSetMetaData" yaml:"PlanCandidateSetMetaData"`
@@ -109,6 +126,27 @@ func (*planCandidateSetR) NewStruct() *planCandidateSetR {
 	return &planCandidateSetR{}
 }
 
+func (r *planCandidateSetR) GetUser() *User {
+	if r == nil {
+		return nil
+	}
+	return r.User
+}
+
+func (r *planCandidateSetR) GetPlanCandidateSetGroup() *PlanCandidateSetGroup {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateSetGroup
+}
+
+func (r *planCandidateSetR) GetPlanCandidateEditHistories() PlanCandidateEditHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateEditHistories
+}
+
 func (r *planCandidateSetR) GetPlanCandidatePlaces() PlanCandidatePlaceSlice {
 	if r == nil {
 		return nil
@@ -155,8 +193,8 @@ func (r *planCandidateSetR) GetPlanCandidates() PlanCandidateSlice {
 type planCandidateSetL struct{}
 
 var (
-	planCandidateSetAllColumns            = []string{"id", "expires_at", "created_at", "updated_at", "is_place_searched"}
-	planCandidateSetColumnsWithoutDefault = []string{"id", "expires_at"}
+	planCandidateSetAllColumns            = []string{"id", "expires_at", "created_at", "updated_at", "is_place_searched", "user_id"}
+	planCandidateSetColumnsWithoutDefault = []string{"id", "expires_at", "user_id"}
 	planCandidateSetColumnsWithDefault    = []string{"created_at", "updated_at", "is_place_searched"}
 	planCandidateSetPrimaryKeyColumns     = []string{"id"}
 	planCandidateSetGeneratedColumns      = []string{}
@@ -467,6 +505,42 @@ func (q planCandidateSetQuery) Exists(ctx context.Context, exec boil.ContextExec
 	return count > 0, nil
 }
 
+// User pointed to by the foreign key.
+func (o *PlanCandidateSet) User(mods ...qm.QueryMod) userQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`id` = ?", o.UserID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return Users(queryMods...)
+}
+
+// PlanCandidateSetGroup pointed to by the foreign key.
+func (o *PlanCandidateSet) PlanCandidateSetGroup(mods ...qm.QueryMod) planCandidateSetGroupQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`plan_candidate_set_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return PlanCandidateSetGroups(queryMods...)
+}
+
+// PlanCandidateEditHistories retrieves all the plan_candidate_edit_history's PlanCandidateEditHistories with an executor.
+func (o *PlanCandidateSet) PlanCandidateEditHistories(mods ...qm.QueryMod) planCandidateEditHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_edit_histories`.`plan_candidate_set_id`=?", o.ID),
+	)
+
+	return PlanCandidateEditHistories(queryMods...)
+}
+
 // PlanCandidatePlaces retrieves all the plan_candidate_place's PlanCandidatePlaces with an executor.
 func (o *PlanCandidateSet) PlanCandidatePlaces(mods ...qm.QueryMod) planCandidatePlaceQuery {
 	var queryMods []qm.QueryMod
@@ -551,6 +625,360 @@ func (o *PlanCandidateSet) PlanCandidates(mods ...qm.QueryMod) planCandidateQuer
 	return PlanCandidates(queryMods...)
 }
 
+// LoadUser allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for an N-1 relationship.
+func (planCandidateSetL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidateSet interface{}, mods queries.Applicator) error {
+	var slice []*PlanCandidateSet
+	var object *PlanCandidateSet
+
+	if singular {
+		var ok bool
+		object, ok = maybePlanCandidateSet.(*PlanCandidateSet)
+		if !ok {
+			object = new(PlanCandidateSet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlanCandidateSet))
+			}
+		}
+	} else {
+		s, ok := maybePlanCandidateSet.(*[]*PlanCandidateSet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlanCandidateSet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planCandidateSetR{}
+		}
+		if !queries.IsNil(object.UserID) {
+			args[object.UserID] = struct{}{}
+		}
+
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planCandidateSetR{}
+			}
+
+			if !queries.IsNil(obj.UserID) {
+				args[obj.UserID] = struct{}{}
+			}
+
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`users`),
+		qm.WhereIn(`users.id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load User")
+	}
+
+	var resultSlice []*User
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice User")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for users")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for users")
+	}
+
+	if len(userAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.User = foreign
+		if foreign.R == nil {
+			foreign.R = &userR{}
+		}
+		foreign.R.PlanCandidateSets = append(foreign.R.PlanCandidateSets, object)
+		return nil
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if queries.Equal(local.UserID, foreign.ID) {
+				local.R.User = foreign
+				if foreign.R == nil {
+					foreign.R = &userR{}
+				}
+				foreign.R.PlanCandidateSets = append(foreign.R.PlanCandidateSets, local)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlanCandidateSetGroup allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (planCandidateSetL) LoadPlanCandidateSetGroup(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidateSet interface{}, mods queries.Applicator) error {
+	var slice []*PlanCandidateSet
+	var object *PlanCandidateSet
+
+	if singular {
+		var ok bool
+		object, ok = maybePlanCandidateSet.(*PlanCandidateSet)
+		if !ok {
+			object = new(PlanCandidateSet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlanCandidateSet))
+			}
+		}
+	} else {
+		s, ok := maybePlanCandidateSet.(*[]*PlanCandidateSet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlanCandidateSet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planCandidateSetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planCandidateSetR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_set_groups`),
+		qm.WhereIn(`plan_candidate_set_groups.plan_candidate_set_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load PlanCandidateSetGroup")
+	}
+
+	var resultSlice []*PlanCandidateSetGroup
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice PlanCandidateSetGroup")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for plan_candidate_set_groups")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_set_groups")
+	}
+
+	if len(planCandidateSetGroupAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.PlanCandidateSetGroup = foreign
+		if foreign.R == nil {
+			foreign.R = &planCandidateSetGroupR{}
+		}
+		foreign.R.PlanCandidateSet = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PlanCandidateSetID {
+				local.R.PlanCandidateSetGroup = foreign
+				if foreign.R == nil {
+					foreign.R = &planCandidateSetGroupR{}
+				}
+				foreign.R.PlanCandidateSet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlanCandidateEditHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (planCandidateSetL) LoadPlanCandidateEditHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidateSet interface{}, mods queries.Applicator) error {
+	var slice []*PlanCandidateSet
+	var object *PlanCandidateSet
+
+	if singular {
+		var ok bool
+		object, ok = maybePlanCandidateSet.(*PlanCandidateSet)
+		if !ok {
+			object = new(PlanCandidateSet)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlanCandidateSet))
+			}
+		}
+	} else {
+		s, ok := maybePlanCandidateSet.(*[]*PlanCandidateSet)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlanCandidateSet)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlanCandidateSet))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planCandidateSetR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planCandidateSetR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_edit_histories`),
+		qm.WhereIn(`plan_candidate_edit_histories.plan_candidate_set_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_edit_histories")
+	}
+
+	var resultSlice []*PlanCandidateEditHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_edit_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_edit_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_edit_histories")
+	}
+
+	if len(planCandidateEditHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateEditHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateEditHistoryR{}
+			}
+			foreign.R.PlanCandidateSet = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PlanCandidateSetID {
+				local.R.PlanCandidateEditHistories = append(local.R.PlanCandidateEditHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateEditHistoryR{}
+				}
+				foreign.R.PlanCandidateSet = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlanCandidatePlaces allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (planCandidateSetL) LoadPlanCandidatePlaces(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidateSet interface{}, mods queries.Applicator) error {
@@ -1229,6 +1657,189 @@ func (planCandidateSetL) LoadPlanCandidates(ctx context.Context, e boil.ContextE
 	return nil
 }
 
+// SetUser of the planCandidateSet to the related item.
+// Sets o.R.User to related.
+// Adds o to related.R.PlanCandidateSets.
+func (o *PlanCandidateSet) SetUser(ctx context.Context, exec boil.ContextExecutor, insert bool, related *User) error {
+	var err error
+	if insert {
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	}
+
+	updateQuery := fmt.Sprintf(
+		"UPDATE `plan_candidate_sets` SET %s WHERE %s",
+		strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+		strmangle.WhereClause("`", "`", 0, planCandidateSetPrimaryKeyColumns),
+	)
+	values := []interface{}{related.ID, o.ID}
+
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, updateQuery)
+		fmt.Fprintln(writer, values)
+	}
+	if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	queries.Assign(&o.UserID, related.ID)
+	if o.R == nil {
+		o.R = &planCandidateSetR{
+			User: related,
+		}
+	} else {
+		o.R.User = related
+	}
+
+	if related.R == nil {
+		related.R = &userR{
+			PlanCandidateSets: PlanCandidateSetSlice{o},
+		}
+	} else {
+		related.R.PlanCandidateSets = append(related.R.PlanCandidateSets, o)
+	}
+
+	return nil
+}
+
+// RemoveUser relationship.
+// Sets o.R.User to nil.
+// Removes o from all passed in related items' relationships struct.
+func (o *PlanCandidateSet) RemoveUser(ctx context.Context, exec boil.ContextExecutor, related *User) error {
+	var err error
+
+	queries.SetScanner(&o.UserID, nil)
+	if _, err = o.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+		return errors.Wrap(err, "failed to update local table")
+	}
+
+	if o.R != nil {
+		o.R.User = nil
+	}
+	if related == nil || related.R == nil {
+		return nil
+	}
+
+	for i, ri := range related.R.PlanCandidateSets {
+		if queries.Equal(o.UserID, ri.UserID) {
+			continue
+		}
+
+		ln := len(related.R.PlanCandidateSets)
+		if ln > 1 && i < ln-1 {
+			related.R.PlanCandidateSets[i] = related.R.PlanCandidateSets[ln-1]
+		}
+		related.R.PlanCandidateSets = related.R.PlanCandidateSets[:ln-1]
+		break
+	}
+	return nil
+}
+
+// SetPlanCandidateSetGroup of the planCandidateSet to the related item.
+// Sets o.R.PlanCandidateSetGroup to related.
+// Adds o to related.R.PlanCandidateSet.
+func (o *PlanCandidateSet) SetPlanCandidateSetGroup(ctx context.Context, exec boil.ContextExecutor, insert bool, related *PlanCandidateSetGroup) error {
+	var err error
+
+	if insert {
+		related.PlanCandidateSetID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `plan_candidate_set_groups` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"plan_candidate_set_id"}),
+			strmangle.WhereClause("`", "`", 0, planCandidateSetGroupPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.PlanCandidateSetID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PlanCandidateSetID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &planCandidateSetR{
+			PlanCandidateSetGroup: related,
+		}
+	} else {
+		o.R.PlanCandidateSetGroup = related
+	}
+
+	if related.R == nil {
+		related.R = &planCandidateSetGroupR{
+			PlanCandidateSet: o,
+		}
+	} else {
+		related.R.PlanCandidateSet = o
+	}
+	return nil
+}
+
+// AddPlanCandidateEditHistories adds the given related objects to the existing relationships
+// of the plan_candidate_set, optionally inserting them as new records.
+// Appends related to o.R.PlanCandidateEditHistories.
+// Sets related.R.PlanCandidateSet appropriately.
+func (o *PlanCandidateSet) AddPlanCandidateEditHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateEditHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PlanCandidateSetID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `plan_candidate_edit_histories` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"plan_candidate_set_id"}),
+				strmangle.WhereClause("`", "`", 0, planCandidateEditHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PlanCandidateSetID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &planCandidateSetR{
+			PlanCandidateEditHistories: related,
+		}
+	} else {
+		o.R.PlanCandidateEditHistories = append(o.R.PlanCandidateEditHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &planCandidateEditHistoryR{
+				PlanCandidateSet: o,
+			}
+		} else {
+			rel.R.PlanCandidateSet = o
+		}
+	}
+	return nil
+}
+
 // AddPlanCandidatePlaces adds the given related objects to the existing relationships
 // of the plan_candidate_set, optionally inserting them as new records.
 // Appends related to o.R.PlanCandidatePlaces.
@@ -2566,6 +3177,33 @@ func (s PlanCandidateSetSlice) UpsertAllByPage(ctx context.Context, exec boil.Co
 	return rowsAffected, nil
 }
 
+// LoadPlanCandidateEditHistoriesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s PlanCandidateSetSlice) LoadPlanCandidateEditHistoriesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateEditHistoriesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanCandidateSetSlice) LoadPlanCandidateEditHistoriesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*PlanCandidateSet](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateEditHistories(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanCandidateSetSlice) GetLoadedPlanCandidateEditHistories() PlanCandidateEditHistorySlice {
+	result := make(PlanCandidateEditHistorySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateEditHistories == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateEditHistories...)
+	}
+	return result
+}
+
 // LoadPlanCandidatePlacesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
 func (s PlanCandidateSetSlice) LoadPlanCandidatePlacesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadPlanCandidatePlacesByPageEx(ctx, e, DefaultPageSize, mods...)
@@ -2728,4 +3366,63 @@ func (s PlanCandidateSetSlice) GetLoadedPlanCandidates() PlanCandidateSlice {
 	return result
 }
 
+// LoadUsersByPage performs eager loading of values by page. This is for a N-1 relationship.
+func (s PlanCandidateSetSlice) LoadUsersByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadUsersByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanCandidateSetSlice) LoadUsersByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*PlanCandidateSet](s, pageSize) {
+		if err := chunk[0].L.LoadUser(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanCandidateSetSlice) GetLoadedUsers() UserSlice {
+	result := make(UserSlice, 0, len(s))
+	mapCheckDup := make(map[*User]struct{})
+	for _, item := range s {
+		if item.R == nil || item.R.User == nil {
+			continue
+		}
+		if _, ok := mapCheckDup[item.R.User]; ok {
+			continue
+		}
+		result = append(result, item.R.User)
+		mapCheckDup[item.R.User] = struct{}{}
+	}
+	return result
+}
+
+// LoadPlanCandidateSetGroupByPage performs eager loading of values by page. This is for a 1-1 relationship.
+func (s PlanCandidateSetSlice) LoadPlanCandidateSetGroupByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateSetGroupByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanCandidateSetSlice) LoadPlanCandidateSetGroupByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*PlanCandidateSet](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateSetGroup(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanCandidateSetSlice) GetLoadedPlanCandidateSetGroup() PlanCandidateSetGroupSlice {
+	result := make(PlanCandidateSetGroupSlice, 0, len(s))
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateSetGroup == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateSetGroup)
+	}
+	return result
+}
+
 ///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -94,20 +94,26 @@ var PlanCandidateWhere = struct {
 
 // PlanCandidateRels is where relationship names are stored.
 var PlanCandidateRels = struct {
-	ParentPlan          string
-	PlanCandidateSet    string
-	PlanCandidatePlaces string
+	ParentPlan                 string
+	PlanCandidateSet           string
+	PlanCandidateEditHistories string
+	PlanCandidatePlaces        string
+	PlanCandidateSetPlanVotes  string
 }{
-	ParentPlan:          "ParentPlan",
-	PlanCandidateSet:    "PlanCandidateSet",
-	PlanCandidatePlaces: "PlanCandidatePlaces",
+	ParentPlan:                 "ParentPlan",
+	PlanCandidateSet:           "PlanCandidateSet",
+	PlanCandidateEditHistories: "PlanCandidateEditHistories",
+	PlanCandidatePlaces:        "PlanCandidatePlaces",
+	PlanCandidateSetPlanVotes:  "PlanCandidateSetPlanVotes",
 }
 
 // planCandidateR is where relationships are stored.
 type planCandidateR struct {
-	ParentPlan          *Plan                   `boil:"ParentPlan" json:"ParentPlan" toml:"ParentPlan" yaml:"ParentPlan"`
-	PlanCandidateSet    *PlanCandidateSet       `boil:"PlanCandidateSet" json:"PlanCandidateSet" toml:"PlanCandidateSet" yaml:"PlanCandidateSet"`
-	PlanCandidatePlaces PlanCandidatePlaceSlice `boil:"PlanCandidatePlaces" json:"PlanCandidatePlaces" toml:"PlanCandidatePlaces" yaml:"PlanCandidatePlaces"`
+	ParentPlan                 *Plan                         `boil:"ParentPlan" json:"ParentPlan" toml:"ParentPlan" yaml:"ParentPlan"`
+	PlanCandidateSet           *PlanCandidateSet             `boil:"PlanCandidateSet" json:"PlanCandidateSet" toml:"PlanCandidateSet" yaml:"PlanCandidateSet"`
+	PlanCandidateEditHistories PlanCandidateEditHistorySlice `boil:"PlanCandidateEditHistories" json:"PlanCandidateEditHistories" toml:"PlanCandidateEditHistories" yaml:"PlanCandidateEditHistories"`
+	PlanCandidatePlaces        PlanCandidatePlaceSlice       `boil:"PlanCandidatePlaces" json:"PlanCandidatePlaces" toml:"PlanCandidatePlaces" yaml:"PlanCandidatePlaces"`
+	PlanCandidateSetPlanVotes  PlanCandidateSetPlanVoteSlice `boil:"PlanCandidateSetPlanVotes" json:"PlanCandidateSetPlanVotes" toml:"PlanCandidateSetPlanVotes" yaml:"PlanCandidateSetPlanVotes"`
 }
 
 // NewStruct creates a new relationship struct
@@ -129,6 +135,13 @@ func (r *planCandidateR) GetPlanCandidateSet() *PlanCandidateSet {
 	return r.PlanCandidateSet
 }
 
+func (r *planCandidateR) GetPlanCandidateEditHistories() PlanCandidateEditHistorySlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateEditHistories
+}
+
 func (r *planCandidateR) GetPlanCandidatePlaces() PlanCandidatePlaceSlice {
 	if r == nil {
 		return nil
@@ -136,6 +149,13 @@ func (r *planCandidateR) GetPlanCandidatePlaces() PlanCandidatePlaceSlice {
 	return r.PlanCandidatePlaces
 }
 
+func (r *planCandidateR) GetPlanCandidateSetPlanVotes() PlanCandidateSetPlanVoteSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateSetPlanVotes
+}
+
 // planCandidateL is where Load methods for each relationship are stored.
 type planCandidateL struct{}
 
@@ -474,6 +494,20 @@ func (o *PlanCandidate) PlanCandidateSet(mods ...qm.QueryMod) planCandidateSetQu
 	return PlanCandidateSets(queryMods...)
 }
 
+// PlanCandidateEditHistories retrieves all the plan_candidate_edit_history's PlanCandidateEditHistories with an executor.
+func (o *PlanCandidate) PlanCandidateEditHistories(mods ...qm.QueryMod) planCandidateEditHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_edit_histories`.`plan_candidate_id`=?", o.ID),
+	)
+
+	return PlanCandidateEditHistories(queryMods...)
+}
+
 // PlanCandidatePlaces retrieves all the plan_candidate_place's PlanCandidatePlaces with an executor.
 func (o *PlanCandidate) PlanCandidatePlaces(mods ...qm.QueryMod) planCandidatePlaceQuery {
 	var queryMods []qm.QueryMod
@@ -488,6 +522,20 @@ func (o *PlanCandidate) PlanCandidatePlaces(mods ...qm.QueryMod) planCandidatePl
 	return PlanCandidatePlaces(queryMods...)
 }
 
+// PlanCandidateSetPlanVotes retrieves all the plan_candidate_set_plan_vote's PlanCandidateSetPlanVotes with an executor.
+func (o *PlanCandidate) PlanCandidateSetPlanVotes(mods ...qm.QueryMod) planCandidateSetPlanVoteQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_set_plan_votes`.`plan_candidate_id`=?", o.ID),
+	)
+
+	return PlanCandidateSetPlanVotes(queryMods...)
+}
+
 // LoadParentPlan allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (planCandidateL) LoadParentPlan(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidate interface{}, mods queries.Applicator) error {
@@ -732,6 +780,119 @@ func (planCandidateL) LoadPlanCandidateSet(ctx context.Context, e boil.ContextEx
 	return nil
 }
 
+// LoadPlanCandidateEditHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (planCandidateL) LoadPlanCandidateEditHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidate interface{}, mods queries.Applicator) error {
+	var slice []*PlanCandidate
+	var object *PlanCandidate
+
+	if singular {
+		var ok bool
+		object, ok = maybePlanCandidate.(*PlanCandidate)
+		if !ok {
+			object = new(PlanCandidate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlanCandidate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlanCandidate))
+			}
+		}
+	} else {
+		s, ok := maybePlanCandidate.(*[]*PlanCandidate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlanCandidate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlanCandidate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planCandidateR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planCandidateR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_edit_histories`),
+		qm.WhereIn(`plan_candidate_edit_histories.plan_candidate_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_edit_histories")
+	}
+
+	var resultSlice []*PlanCandidateEditHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_edit_histories")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_edit_histories")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_edit_histories")
+	}
+
+	if len(planCandidateEditHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateEditHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateEditHistoryR{}
+			}
+			foreign.R.PlanCandidate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PlanCandidateID {
+				local.R.PlanCandidateEditHistories = append(local.R.PlanCandidateEditHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateEditHistoryR{}
+				}
+				foreign.R.PlanCandidate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlanCandidatePlaces allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (planCandidateL) LoadPlanCandidatePlaces(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidate interface{}, mods queries.Applicator) error {
@@ -845,6 +1006,119 @@ func (planCandidateL) LoadPlanCandidatePlaces(ctx context.Context, e boil.Contex
 	return nil
 }
 
+// LoadPlanCandidateSetPlanVotes allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (planCandidateL) LoadPlanCandidateSetPlanVotes(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlanCandidate interface{}, mods queries.Applicator) error {
+	var slice []*PlanCandidate
+	var object *PlanCandidate
+
+	if singular {
+		var ok bool
+		object, ok = maybePlanCandidate.(*PlanCandidate)
+		if !ok {
+			object = new(PlanCandidate)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlanCandidate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlanCandidate))
+			}
+		}
+	} else {
+		s, ok := maybePlanCandidate.(*[]*PlanCandidate)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlanCandidate)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlanCandidate))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planCandidateR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planCandidateR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_set_plan_votes`),
+		qm.WhereIn(`plan_candidate_set_plan_votes.plan_candidate_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_set_plan_votes")
+	}
+
+	var resultSlice []*PlanCandidateSetPlanVote
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_set_plan_votes")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_set_plan_votes")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_set_plan_votes")
+	}
+
+	if len(planCandidateSetPlanVoteAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateSetPlanVotes = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateSetPlanVoteR{}
+			}
+			foreign.R.PlanCandidate = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.PlanCandidateID {
+				local.R.PlanCandidateSetPlanVotes = append(local.R.PlanCandidateSetPlanVotes, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateSetPlanVoteR{}
+				}
+				foreign.R.PlanCandidate = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetParentPlan of the planCandidate to the related item.
 // Sets o.R.ParentPlan to related.
 // Adds o to related.R.ParentPlanPlanCandidates.
@@ -972,6 +1246,59 @@ func (o *PlanCandidate) SetPlanCandidateSet(ctx context.Context, exec boil.Conte
 	return nil
 }
 
+// AddPlanCandidateEditHistories adds the given related objects to the existing relationships
+// of the plan_candidate, optionally inserting them as new records.
+// Appends related to o.R.PlanCandidateEditHistories.
+// Sets related.R.PlanCandidate appropriately.
+func (o *PlanCandidate) AddPlanCandidateEditHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateEditHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PlanCandidateID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `plan_candidate_edit_histories` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"plan_candidate_id"}),
+				strmangle.WhereClause("`", "`", 0, planCandidateEditHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PlanCandidateID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &planCandidateR{
+			PlanCandidateEditHistories: related,
+		}
+	} else {
+		o.R.PlanCandidateEditHistories = append(o.R.PlanCandidateEditHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &planCandidateEditHistoryR{
+				PlanCandidate: o,
+			}
+		} else {
+			rel.R.PlanCandidate = o
+		}
+	}
+	return nil
+}
+
 // AddPlanCandidatePlaces adds the given related objects to the existing relationships
 // of the plan_candidate, optionally inserting them as new records.
 // Appends related to o.R.PlanCandidatePlaces.
@@ -1025,6 +1352,59 @@ func (o *PlanCandidate) AddPlanCandidatePlaces(ctx context.Context, exec boil.Co
 	return nil
 }
 
+// AddPlanCandidateSetPlanVotes adds the given related objects to the existing relationships
+// of the plan_candidate, optionally inserting them as new records.
+// Appends related to o.R.PlanCandidateSetPlanVotes.
+// Sets related.R.PlanCandidate appropriately.
+func (o *PlanCandidate) AddPlanCandidateSetPlanVotes(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSetPlanVote) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.PlanCandidateID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `plan_candidate_set_plan_votes` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"plan_candidate_id"}),
+				strmangle.WhereClause("`", "`", 0, planCandidateSetPlanVotePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.PlanCandidateID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &planCandidateR{
+			PlanCandidateSetPlanVotes: related,
+		}
+	} else {
+		o.R.PlanCandidateSetPlanVotes = append(o.R.PlanCandidateSetPlanVotes, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &planCandidateSetPlanVoteR{
+				PlanCandidate: o,
+			}
+		} else {
+			rel.R.PlanCandidate = o
+		}
+	}
+	return nil
+}
+
 // PlanCandidates retrieves all the records using an executor.
 func PlanCandidates(mods ...qm.QueryMod) planCandidateQuery {
 	mods = append(mods, qm.From("`plan_candidates`"))
@@ -2044,6 +2424,33 @@ func (s PlanCandidateSlice) UpsertAllByPage(ctx context.Context, exec boil.Conte
 	return rowsAffected, nil
 }
 
+// LoadPlanCandidateEditHistoriesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s PlanCandidateSlice) LoadPlanCandidateEditHistoriesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateEditHistoriesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanCandidateSlice) LoadPlanCandidateEditHistoriesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*PlanCandidate](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateEditHistories(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanCandidateSlice) GetLoadedPlanCandidateEditHistories() PlanCandidateEditHistorySlice {
+	result := make(PlanCandidateEditHistorySlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateEditHistories == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateEditHistories...)
+	}
+	return result
+}
+
 // LoadPlanCandidatePlacesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
 func (s PlanCandidateSlice) LoadPlanCandidatePlacesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadPlanCandidatePlacesByPageEx(ctx, e, DefaultPageSize, mods...)
@@ -2071,6 +2478,33 @@ func (s PlanCandidateSlice) GetLoadedPlanCandidatePlaces() PlanCandidatePlaceSli
 	return result
 }
 
+// LoadPlanCandidateSetPlanVotesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s PlanCandidateSlice) LoadPlanCandidateSetPlanVotesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateSetPlanVotesByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanCandidateSlice) LoadPlanCandidateSetPlanVotesByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*PlanCandidate](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateSetPlanVotes(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanCandidateSlice) GetLoadedPlanCandidateSetPlanVotes() PlanCandidateSetPlanVoteSlice {
+	result := make(PlanCandidateSetPlanVoteSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateSetPlanVotes == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateSetPlanVotes...)
+	}
+	return result
+}
+
 // LoadParentPlansByPage performs eager loading of values by page. This is for a N-1 relationship.
 func (s PlanCandidateSlice) LoadParentPlansByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadParentPlansByPageEx(ctx, e, DefaultPageSize, mods...)
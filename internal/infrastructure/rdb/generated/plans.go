@@ -24,77 +24,120 @@ import (
 
 // Plan is an object representing the database table.
 type Plan struct {
-	ID        string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	UserID    null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
-	Name      string      `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Latitude  float64     `boil:"latitude" json:"latitude" toml:"latitude" yaml:"latitude"`
-	Longitude float64     `boil:"longitude" json:"longitude" toml:"longitude" yaml:"longitude"`
+	ID              string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	UserID          null.String `boil:"user_id" json:"user_id,omitempty" toml:"user_id" yaml:"user_id,omitempty"`
+	Name            string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt       time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt       time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Latitude        float64     `boil:"latitude" json:"latitude" toml:"latitude" yaml:"latitude"`
+	Longitude       float64     `boil:"longitude" json:"longitude" toml:"longitude" yaml:"longitude"`
+	Description     null.String `boil:"description" json:"description,omitempty" toml:"description" yaml:"description,omitempty"`
+	Version         int         `boil:"version" json:"version" toml:"version" yaml:"version"`
+	Visibility      string      `boil:"visibility" json:"visibility" toml:"visibility" yaml:"visibility"`
+	S2CellIDLevel10 null.Uint64 `boil:"s2_cell_id_level10" json:"s2_cell_id_level10,omitempty" toml:"s2_cell_id_level10" yaml:"s2_cell_id_level10,omitempty"`
+	S2CellIDLevel13 null.Uint64 `boil:"s2_cell_id_level13" json:"s2_cell_id_level13,omitempty" toml:"s2_cell_id_level13" yaml:"s2_cell_id_level13,omitempty"`
+	S2CellIDLevel16 null.Uint64 `boil:"s2_cell_id_level16" json:"s2_cell_id_level16,omitempty" toml:"s2_cell_id_level16" yaml:"s2_cell_id_level16,omitempty"`
 
 	R *planR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L planL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var PlanColumns = struct {
-	ID        string
-	UserID    string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Latitude  string
-	Longitude string
+	ID              string
+	UserID          string
+	Name            string
+	CreatedAt       string
+	UpdatedAt       string
+	Latitude        string
+	Longitude       string
+	Description     string
+	Version         string
+	Visibility      string
+	S2CellIDLevel10 string
+	S2CellIDLevel13 string
+	S2CellIDLevel16 string
 }{
-	ID:        "id",
-	UserID:    "user_id",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	Latitude:  "latitude",
-	Longitude: "longitude",
+	ID:              "id",
+	UserID:          "user_id",
+	Name:            "name",
+	CreatedAt:       "created_at",
+	UpdatedAt:       "updated_at",
+	Latitude:        "latitude",
+	Longitude:       "longitude",
+	Description:     "description",
+	Version:         "version",
+	Visibility:      "visibility",
+	S2CellIDLevel10: "s2_cell_id_level10",
+	S2CellIDLevel13: "s2_cell_id_level13",
+	S2CellIDLevel16: "s2_cell_id_level16",
 }
 
 var PlanTableColumns = struct {
-	ID        string
-	UserID    string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Latitude  string
-	Longitude string
+	ID              string
+	UserID          string
+	Name            string
+	CreatedAt       string
+	UpdatedAt       string
+	Latitude        string
+	Longitude       string
+	Description     string
+	Version         string
+	Visibility      string
+	S2CellIDLevel10 string
+	S2CellIDLevel13 string
+	S2CellIDLevel16 string
 }{
-	ID:        "plans.id",
-	UserID:    "plans.user_id",
-	Name:      "plans.name",
-	CreatedAt: "plans.created_at",
-	UpdatedAt: "plans.updated_at",
-	Latitude:  "plans.latitude",
-	Longitude: "plans.longitude",
+	ID:              "plans.id",
+	UserID:          "plans.user_id",
+	Name:            "plans.name",
+	CreatedAt:       "plans.created_at",
+	UpdatedAt:       "plans.updated_at",
+	Latitude:        "plans.latitude",
+	Longitude:       "plans.longitude",
+	Description:     "plans.description",
+	Version:         "plans.version",
+	Visibility:      "plans.visibility",
+	S2CellIDLevel10: "plans.s2_cell_id_level10",
+	S2CellIDLevel13: "plans.s2_cell_id_level13",
+	S2CellIDLevel16: "plans.s2_cell_id_level16",
 }
 
 // Generated where
 
 var PlanWhere = struct {
-	ID        whereHelperstring
-	UserID    whereHelpernull_String
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	Latitude  whereHelperfloat64
-	Longitude whereHelperfloat64
+	ID              whereHelperstring
+	UserID          whereHelpernull_String
+	Name            whereHelperstring
+	CreatedAt       whereHelpertime_Time
+	UpdatedAt       whereHelpertime_Time
+	Latitude        whereHelperfloat64
+	Longitude       whereHelperfloat64
+	Description     whereHelpernull_String
+	Version         whereHelperint
+	Visibility      whereHelperstring
+	S2CellIDLevel10 whereHelpernull_Uint64
+	S2CellIDLevel13 whereHelpernull_Uint64
+	S2CellIDLevel16 whereHelpernull_Uint64
 }{
-	ID:        whereHelperstring{field: "`plans`.`id`"},
-	UserID:    whereHelpernull_String{field: "`plans`.`user_id`"},
-	Name:      whereHelperstring{field: "`plans`.`name`"},
-	CreatedAt: whereHelpertime_Time{field: "`plans`.`created_at`"},
-	UpdatedAt: whereHelpertime_Time{field: "`plans`.`updated_at`"},
-	Latitude:  whereHelperfloat64{field: "`plans`.`latitude`"},
-	Longitude: whereHelperfloat64{field: "`plans`.`longitude`"},
+	ID:              whereHelperstring{field: "`plans`.`id`"},
+	UserID:          whereHelpernull_String{field: "`plans`.`user_id`"},
+	Name:            whereHelperstring{field: "`plans`.`name`"},
+	CreatedAt:       whereHelpertime_Time{field: "`plans`.`created_at`"},
+	UpdatedAt:       whereHelpertime_Time{field: "`plans`.`updated_at`"},
+	Latitude:        whereHelperfloat64{field: "`plans`.`latitude`"},
+	Longitude:       whereHelperfloat64{field: "`plans`.`longitude`"},
+	Description:     whereHelpernull_String{field: "`plans`.`description`"},
+	Version:         whereHelperint{field: "`plans`.`version`"},
+	Visibility:      whereHelperstring{field: "`plans`.`visibility`"},
+	S2CellIDLevel10: whereHelpernull_Uint64{field: "`plans`.`s2_cell_id_level10`"},
+	S2CellIDLevel13: whereHelpernull_Uint64{field: "`plans`.`s2_cell_id_level13`"},
+	S2CellIDLevel16: whereHelpernull_Uint64{field: "`plans`.`s2_cell_id_level16`"},
 }
 
 // PlanRels is where relationship names are stored.
 var PlanRels = struct {
 	User                         string
+	TripPlan                     string
 	ParentPlanPlanCandidates     string
 	PlanCollages                 string
 	ParentPlanPlanParentChildren string
@@ -102,6 +145,7 @@ var PlanRels = struct {
 	PlanPlaces                   string
 }{
 	User:                         "User",
+	TripPlan:                     "TripPlan",
 	ParentPlanPlanCandidates:     "ParentPlanPlanCandidates",
 	PlanCollages:                 "PlanCollages",
 	ParentPlanPlanParentChildren: "ParentPlanPlanParentChildren",
@@ -112,6 +156,7 @@ var PlanRels = struct {
 // planR is where relationships are stored.
 type planR struct {
 	User                         *User                `boil:"User" json:"User" toml:"User" yaml:"User"`
+	TripPlan                     *TripPlan            `boil:"TripPlan" json:"TripPlan" toml:"TripPlan" yaml:"TripPlan"`
 	ParentPlanPlanCandidates     PlanCandidateSlice   `boil:"ParentPlanPlanCandidates" json:"ParentPlanPlanCandidates" toml:"ParentPlanPlanCandidates" yaml:"ParentPlanPlanCandidates"`
 	PlanCollages                 PlanCollageSlice     `boil:"PlanCollages" json:"PlanCollages" toml:"PlanCollages" yaml:"PlanCollages"`
 	ParentPlanPlanParentChildren PlanParentChildSlice `boil:"ParentPlanPlanParentChildren" json:"ParentPlanPlanParentChildren" toml:"ParentPlanPlanParentChildren" yaml:"ParentPlanPlanParentChildren"`
@@ -131,6 +176,13 @@ func (r *planR) GetUser() *User {
 	return r.User
 }
 
+func (r *planR) GetTripPlan() *TripPlan {
+	if r == nil {
+		return nil
+	}
+	return r.TripPlan
+}
+
 func (r *planR) GetParentPlanPlanCandidates() PlanCandidateSlice {
 	if r == nil {
 		return nil
@@ -170,9 +222,9 @@ func (r *planR) GetPlanPlaces() PlanPlaceSlice {
 type planL struct{}
 
 var (
-	planAllColumns            = []string{"id", "user_id", "name", "created_at", "updated_at", "latitude", "longitude"}
-	planColumnsWithoutDefault = []string{"id", "user_id", "name"}
-	planColumnsWithDefault    = []string{"created_at", "updated_at", "latitude", "longitude"}
+	planAllColumns            = []string{"id", "user_id", "name", "created_at", "updated_at", "latitude", "longitude", "description", "version", "visibility", "s2_cell_id_level10", "s2_cell_id_level13", "s2_cell_id_level16"}
+	planColumnsWithoutDefault = []string{"id", "user_id", "name", "description", "s2_cell_id_level10", "s2_cell_id_level13", "s2_cell_id_level16"}
+	planColumnsWithDefault    = []string{"created_at", "updated_at", "latitude", "longitude", "version", "visibility"}
 	planPrimaryKeyColumns     = []string{"id"}
 	planGeneratedColumns      = []string{}
 )
@@ -493,6 +545,17 @@ func (o *Plan) User(mods ...qm.QueryMod) userQuery {
 	return Users(queryMods...)
 }
 
+// TripPlan pointed to by the foreign key.
+func (o *Plan) TripPlan(mods ...qm.QueryMod) tripPlanQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("`plan_id` = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	return TripPlans(queryMods...)
+}
+
 // ParentPlanPlanCandidates retrieves all the plan_candidate's PlanCandidates with an executor via parent_plan_id column.
 func (o *Plan) ParentPlanPlanCandidates(mods ...qm.QueryMod) planCandidateQuery {
 	var queryMods []qm.QueryMod
@@ -687,6 +750,123 @@ func (planL) LoadUser(ctx context.Context, e boil.ContextExecutor, singular bool
 	return nil
 }
 
+// LoadTripPlan allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (planL) LoadTripPlan(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlan interface{}, mods queries.Applicator) error {
+	var slice []*Plan
+	var object *Plan
+
+	if singular {
+		var ok bool
+		object, ok = maybePlan.(*Plan)
+		if !ok {
+			object = new(Plan)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybePlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybePlan))
+			}
+		}
+	} else {
+		s, ok := maybePlan.(*[]*Plan)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybePlan)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybePlan))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &planR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &planR{}
+			}
+
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`trip_plans`),
+		qm.WhereIn(`trip_plans.plan_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load TripPlan")
+	}
+
+	var resultSlice []*TripPlan
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice TripPlan")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for trip_plans")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trip_plans")
+	}
+
+	if len(tripPlanAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.TripPlan = foreign
+		if foreign.R == nil {
+			foreign.R = &tripPlanR{}
+		}
+		foreign.R.Plan = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.PlanID {
+				local.R.TripPlan = foreign
+				if foreign.R == nil {
+					foreign.R = &tripPlanR{}
+				}
+				foreign.R.Plan = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadParentPlanPlanCandidates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (planL) LoadParentPlanPlanCandidates(ctx context.Context, e boil.ContextExecutor, singular bool, maybePlan interface{}, mods queries.Applicator) error {
@@ -1332,6 +1512,56 @@ func (o *Plan) RemoveUser(ctx context.Context, exec boil.ContextExecutor, relate
 	return nil
 }
 
+// SetTripPlan of the plan to the related item.
+// Sets o.R.TripPlan to related.
+// Adds o to related.R.Plan.
+func (o *Plan) SetTripPlan(ctx context.Context, exec boil.ContextExecutor, insert bool, related *TripPlan) error {
+	var err error
+
+	if insert {
+		related.PlanID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE `trip_plans` SET %s WHERE %s",
+			strmangle.SetParamNames("`", "`", 0, []string{"plan_id"}),
+			strmangle.WhereClause("`", "`", 0, tripPlanPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.IsDebug(ctx) {
+			writer := boil.DebugWriterFrom(ctx)
+			fmt.Fprintln(writer, updateQuery)
+			fmt.Fprintln(writer, values)
+		}
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.PlanID = o.ID
+	}
+
+	if o.R == nil {
+		o.R = &planR{
+			TripPlan: related,
+		}
+	} else {
+		o.R.TripPlan = related
+	}
+
+	if related.R == nil {
+		related.R = &tripPlanR{
+			Plan: o,
+		}
+	} else {
+		related.R.Plan = o
+	}
+	return nil
+}
+
 // AddParentPlanPlanCandidates adds the given related objects to the existing relationships
 // of the plan, optionally inserting them as new records.
 // Appends related to o.R.ParentPlanPlanCandidates.
@@ -2857,4 +3087,31 @@ func (s PlanSlice) GetLoadedUsers() UserSlice {
 	return result
 }
 
+// LoadTripPlanByPage performs eager loading of values by page. This is for a 1-1 relationship.
+func (s PlanSlice) LoadTripPlanByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTripPlanByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s PlanSlice) LoadTripPlanByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*Plan](s, pageSize) {
+		if err := chunk[0].L.LoadTripPlan(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s PlanSlice) GetLoadedTripPlan() TripPlanSlice {
+	result := make(TripPlanSlice, 0, len(s))
+	for _, item := range s {
+		if item.R == nil || item.R.TripPlan == nil {
+			continue
+		}
+		result = append(result, item.R.TripPlan)
+	}
+	return result
+}
+
 ///////////////////////////////// END EXTENSIONS /////////////////////////////////
@@ -24,93 +24,109 @@ import (
 
 // User is an object representing the database table.
 type User struct {
-	ID          string      `boil:"id" json:"id" toml:"id" yaml:"id"`
-	FirebaseUID string      `boil:"firebase_uid" json:"firebase_uid" toml:"firebase_uid" yaml:"firebase_uid"`
-	Name        null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
-	Email       null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
-	PhotoURL    null.String `boil:"photo_url" json:"photo_url,omitempty" toml:"photo_url" yaml:"photo_url,omitempty"`
-	CreatedAt   time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt   time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	ID                      string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	FirebaseUID             string      `boil:"firebase_uid" json:"firebase_uid" toml:"firebase_uid" yaml:"firebase_uid"`
+	Name                    null.String `boil:"name" json:"name,omitempty" toml:"name" yaml:"name,omitempty"`
+	Email                   null.String `boil:"email" json:"email,omitempty" toml:"email" yaml:"email,omitempty"`
+	PhotoURL                null.String `boil:"photo_url" json:"photo_url,omitempty" toml:"photo_url" yaml:"photo_url,omitempty"`
+	CreatedAt               time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt               time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	PersonalizationDisabled bool        `boil:"personalization_disabled" json:"personalization_disabled" toml:"personalization_disabled" yaml:"personalization_disabled"`
 
 	R *userR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L userL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var UserColumns = struct {
-	ID          string
-	FirebaseUID string
-	Name        string
-	Email       string
-	PhotoURL    string
-	CreatedAt   string
-	UpdatedAt   string
+	ID                      string
+	FirebaseUID             string
+	Name                    string
+	Email                   string
+	PhotoURL                string
+	CreatedAt               string
+	UpdatedAt               string
+	PersonalizationDisabled string
 }{
-	ID:          "id",
-	FirebaseUID: "firebase_uid",
-	Name:        "name",
-	Email:       "email",
-	PhotoURL:    "photo_url",
-	CreatedAt:   "created_at",
-	UpdatedAt:   "updated_at",
+	ID:                      "id",
+	FirebaseUID:             "firebase_uid",
+	Name:                    "name",
+	Email:                   "email",
+	PhotoURL:                "photo_url",
+	CreatedAt:               "created_at",
+	UpdatedAt:               "updated_at",
+	PersonalizationDisabled: "personalization_disabled",
 }
 
 var UserTableColumns = struct {
-	ID          string
-	FirebaseUID string
-	Name        string
-	Email       string
-	PhotoURL    string
-	CreatedAt   string
-	UpdatedAt   string
+	ID                      string
+	FirebaseUID             string
+	Name                    string
+	Email                   string
+	PhotoURL                string
+	CreatedAt               string
+	UpdatedAt               string
+	PersonalizationDisabled string
 }{
-	ID:          "users.id",
-	FirebaseUID: "users.firebase_uid",
-	Name:        "users.name",
-	Email:       "users.email",
-	PhotoURL:    "users.photo_url",
-	CreatedAt:   "users.created_at",
-	UpdatedAt:   "users.updated_at",
+	ID:                      "users.id",
+	FirebaseUID:             "users.firebase_uid",
+	Name:                    "users.name",
+	Email:                   "users.email",
+	PhotoURL:                "users.photo_url",
+	CreatedAt:               "users.created_at",
+	UpdatedAt:               "users.updated_at",
+	PersonalizationDisabled: "users.personalization_disabled",
 }
 
 // Generated where
 
 var UserWhere = struct {
-	ID          whereHelperstring
-	FirebaseUID whereHelperstring
-	Name        whereHelpernull_String
-	Email       whereHelpernull_String
-	PhotoURL    whereHelpernull_String
-	CreatedAt   whereHelpertime_Time
-	UpdatedAt   whereHelpertime_Time
+	ID                      whereHelperstring
+	FirebaseUID             whereHelperstring
+	Name                    whereHelpernull_String
+	Email                   whereHelpernull_String
+	PhotoURL                whereHelpernull_String
+	CreatedAt               whereHelpertime_Time
+	UpdatedAt               whereHelpertime_Time
+	PersonalizationDisabled whereHelperbool
 }{
-	ID:          whereHelperstring{field: "`users`.`id`"},
-	FirebaseUID: whereHelperstring{field: "`users`.`firebase_uid`"},
-	Name:        whereHelpernull_String{field: "`users`.`name`"},
-	Email:       whereHelpernull_String{field: "`users`.`email`"},
-	PhotoURL:    whereHelpernull_String{field: "`users`.`photo_url`"},
-	CreatedAt:   whereHelpertime_Time{field: "`users`.`created_at`"},
-	UpdatedAt:   whereHelpertime_Time{field: "`users`.`updated_at`"},
+	ID:                      whereHelperstring{field: "`users`.`id`"},
+	FirebaseUID:             whereHelperstring{field: "`users`.`firebase_uid`"},
+	Name:                    whereHelpernull_String{field: "`users`.`name`"},
+	Email:                   whereHelpernull_String{field: "`users`.`email`"},
+	PhotoURL:                whereHelpernull_String{field: "`users`.`photo_url`"},
+	CreatedAt:               whereHelpertime_Time{field: "`users`.`created_at`"},
+	UpdatedAt:               whereHelpertime_Time{field: "`users`.`updated_at`"},
+	PersonalizationDisabled: whereHelperbool{field: "`users`.`personalization_disabled`"},
 }
 
 // UserRels is where relationship names are stored.
 var UserRels = struct {
-	PlacePhotoReferences string
-	PlacePhotos          string
-	Plans                string
-	UserLikePlaces       string
+	PlacePhotoReferences         string
+	PlacePhotos                  string
+	PlanCandidateSetParticipants string
+	PlanCandidateSets            string
+	Plans                        string
+	Trips                        string
+	UserLikePlaces               string
 }{
-	PlacePhotoReferences: "PlacePhotoReferences",
-	PlacePhotos:          "PlacePhotos",
-	Plans:                "Plans",
-	UserLikePlaces:       "UserLikePlaces",
+	PlacePhotoReferences:         "PlacePhotoReferences",
+	PlacePhotos:                  "PlacePhotos",
+	PlanCandidateSetParticipants: "PlanCandidateSetParticipants",
+	PlanCandidateSets:            "PlanCandidateSets",
+	Plans:                        "Plans",
+	Trips:                        "Trips",
+	UserLikePlaces:               "UserLikePlaces",
 }
 
 // userR is where relationships are stored.
 type userR struct {
-	PlacePhotoReferences PlacePhotoReferenceSlice `boil:"PlacePhotoReferences" json:"PlacePhotoReferences" toml:"PlacePhotoReferences" yaml:"PlacePhotoReferences"`
-	PlacePhotos          PlacePhotoSlice          `boil:"PlacePhotos" json:"PlacePhotos" toml:"PlacePhotos" yaml:"PlacePhotos"`
-	Plans                PlanSlice                `boil:"Plans" json:"Plans" toml:"Plans" yaml:"Plans"`
-	UserLikePlaces       UserLikePlaceSlice       `boil:"UserLikePlaces" json:"UserLikePlaces" toml:"UserLikePlaces" yaml:"UserLikePlaces"`
+	PlacePhotoReferences         PlacePhotoReferenceSlice         `boil:"PlacePhotoReferences" json:"PlacePhotoReferences" toml:"PlacePhotoReferences" yaml:"PlacePhotoReferences"`
+	PlacePhotos                  PlacePhotoSlice                  `boil:"PlacePhotos" json:"PlacePhotos" toml:"PlacePhotos" yaml:"PlacePhotos"`
+	PlanCandidateSetParticipants PlanCandidateSetParticipantSlice `boil:"PlanCandidateSetParticipants" json:"PlanCandidateSetParticipants" toml:"PlanCandidateSetParticipants" yaml:"PlanCandidateSetParticipants"`
+	PlanCandidateSets            PlanCandidateSetSlice            `boil:"PlanCandidateSets" json:"PlanCandidateSets" toml:"PlanCandidateSets" yaml:"PlanCandidateSets"`
+	Plans                        PlanSlice                        `boil:"Plans" json:"Plans" toml:"Plans" yaml:"Plans"`
+	Trips                        TripSlice                        `boil:"Trips" json:"Trips" toml:"Trips" yaml:"Trips"`
+	UserLikePlaces               UserLikePlaceSlice               `boil:"UserLikePlaces" json:"UserLikePlaces" toml:"UserLikePlaces" yaml:"UserLikePlaces"`
 }
 
 // NewStruct creates a new relationship struct
@@ -132,6 +148,20 @@ func (r *userR) GetPlacePhotos() PlacePhotoSlice {
 	return r.PlacePhotos
 }
 
+func (r *userR) GetPlanCandidateSetParticipants() PlanCandidateSetParticipantSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateSetParticipants
+}
+
+func (r *userR) GetPlanCandidateSets() PlanCandidateSetSlice {
+	if r == nil {
+		return nil
+	}
+	return r.PlanCandidateSets
+}
+
 func (r *userR) GetPlans() PlanSlice {
 	if r == nil {
 		return nil
@@ -139,6 +169,13 @@ func (r *userR) GetPlans() PlanSlice {
 	return r.Plans
 }
 
+func (r *userR) GetTrips() TripSlice {
+	if r == nil {
+		return nil
+	}
+	return r.Trips
+}
+
 func (r *userR) GetUserLikePlaces() UserLikePlaceSlice {
 	if r == nil {
 		return nil
@@ -150,9 +187,9 @@ func (r *userR) GetUserLikePlaces() UserLikePlaceSlice {
 type userL struct{}
 
 var (
-	userAllColumns            = []string{"id", "firebase_uid", "name", "email", "photo_url", "created_at", "updated_at"}
+	userAllColumns            = []string{"id", "firebase_uid", "name", "email", "photo_url", "created_at", "updated_at", "personalization_disabled"}
 	userColumnsWithoutDefault = []string{"id", "firebase_uid", "name", "email", "photo_url"}
-	userColumnsWithDefault    = []string{"created_at", "updated_at"}
+	userColumnsWithDefault    = []string{"created_at", "updated_at", "personalization_disabled"}
 	userPrimaryKeyColumns     = []string{"id"}
 	userGeneratedColumns      = []string{}
 )
@@ -490,6 +527,34 @@ func (o *User) PlacePhotos(mods ...qm.QueryMod) placePhotoQuery {
 	return PlacePhotos(queryMods...)
 }
 
+// PlanCandidateSetParticipants retrieves all the plan_candidate_set_participant's PlanCandidateSetParticipants with an executor.
+func (o *User) PlanCandidateSetParticipants(mods ...qm.QueryMod) planCandidateSetParticipantQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_set_participants`.`user_id`=?", o.ID),
+	)
+
+	return PlanCandidateSetParticipants(queryMods...)
+}
+
+// PlanCandidateSets retrieves all the plan_candidate_set's PlanCandidateSets with an executor.
+func (o *User) PlanCandidateSets(mods ...qm.QueryMod) planCandidateSetQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`plan_candidate_sets`.`user_id`=?", o.ID),
+	)
+
+	return PlanCandidateSets(queryMods...)
+}
+
 // Plans retrieves all the plan's Plans with an executor.
 func (o *User) Plans(mods ...qm.QueryMod) planQuery {
 	var queryMods []qm.QueryMod
@@ -504,6 +569,20 @@ func (o *User) Plans(mods ...qm.QueryMod) planQuery {
 	return Plans(queryMods...)
 }
 
+// Trips retrieves all the trip's Trips with an executor.
+func (o *User) Trips(mods ...qm.QueryMod) tripQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("`trips`.`user_id`=?", o.ID),
+	)
+
+	return Trips(queryMods...)
+}
+
 // UserLikePlaces retrieves all the user_like_place's UserLikePlaces with an executor.
 func (o *User) UserLikePlaces(mods ...qm.QueryMod) userLikePlaceQuery {
 	var queryMods []qm.QueryMod
@@ -744,6 +823,232 @@ func (userL) LoadPlacePhotos(ctx context.Context, e boil.ContextExecutor, singul
 	return nil
 }
 
+// LoadPlanCandidateSetParticipants allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPlanCandidateSetParticipants(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_set_participants`),
+		qm.WhereIn(`plan_candidate_set_participants.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_set_participants")
+	}
+
+	var resultSlice []*PlanCandidateSetParticipant
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_set_participants")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_set_participants")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_set_participants")
+	}
+
+	if len(planCandidateSetParticipantAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateSetParticipants = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateSetParticipantR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.PlanCandidateSetParticipants = append(local.R.PlanCandidateSetParticipants, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateSetParticipantR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadPlanCandidateSets allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadPlanCandidateSets(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`plan_candidate_sets`),
+		qm.WhereIn(`plan_candidate_sets.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load plan_candidate_sets")
+	}
+
+	var resultSlice []*PlanCandidateSet
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice plan_candidate_sets")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on plan_candidate_sets")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for plan_candidate_sets")
+	}
+
+	if len(planCandidateSetAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.PlanCandidateSets = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &planCandidateSetR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.PlanCandidateSets = append(local.R.PlanCandidateSets, foreign)
+				if foreign.R == nil {
+					foreign.R = &planCandidateSetR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadPlans allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (userL) LoadPlans(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
@@ -857,9 +1162,9 @@ func (userL) LoadPlans(ctx context.Context, e boil.ContextExecutor, singular boo
 	return nil
 }
 
-// LoadUserLikePlaces allows an eager lookup of values, cached into the
+// LoadTrips allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (userL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+func (userL) LoadTrips(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
 	var slice []*User
 	var object *User
 
@@ -912,8 +1217,8 @@ func (userL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, sin
 	}
 
 	query := NewQuery(
-		qm.From(`user_like_places`),
-		qm.WhereIn(`user_like_places.user_id in ?`, argsSlice...),
+		qm.From(`trips`),
+		qm.WhereIn(`trips.user_id in ?`, argsSlice...),
 	)
 	if mods != nil {
 		mods.Apply(query)
@@ -921,22 +1226,22 @@ func (userL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, sin
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load user_like_places")
+		return errors.Wrap(err, "failed to eager load trips")
 	}
 
-	var resultSlice []*UserLikePlace
+	var resultSlice []*Trip
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice user_like_places")
+		return errors.Wrap(err, "failed to bind eager loaded slice trips")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on user_like_places")
+		return errors.Wrap(err, "failed to close results in eager load on trips")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_like_places")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trips")
 	}
 
-	if len(userLikePlaceAfterSelectHooks) != 0 {
+	if len(tripAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -944,49 +1249,395 @@ func (userL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, sin
 		}
 	}
 	if singular {
-		object.R.UserLikePlaces = resultSlice
+		object.R.Trips = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &userLikePlaceR{}
+				foreign.R = &tripR{}
 			}
 			foreign.R.User = object
 		}
 		return nil
 	}
 
-	for _, foreign := range resultSlice {
-		for _, local := range slice {
-			if local.ID == foreign.UserID {
-				local.R.UserLikePlaces = append(local.R.UserLikePlaces, foreign)
-				if foreign.R == nil {
-					foreign.R = &userLikePlaceR{}
-				}
-				foreign.R.User = local
-				break
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.UserID) {
+				local.R.Trips = append(local.R.Trips, foreign)
+				if foreign.R == nil {
+					foreign.R = &tripR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadUserLikePlaces allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (userL) LoadUserLikePlaces(ctx context.Context, e boil.ContextExecutor, singular bool, maybeUser interface{}, mods queries.Applicator) error {
+	var slice []*User
+	var object *User
+
+	if singular {
+		var ok bool
+		object, ok = maybeUser.(*User)
+		if !ok {
+			object = new(User)
+			ok = queries.SetFromEmbeddedStruct(&object, &maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", object, maybeUser))
+			}
+		}
+	} else {
+		s, ok := maybeUser.(*[]*User)
+		if ok {
+			slice = *s
+		} else {
+			ok = queries.SetFromEmbeddedStruct(&slice, maybeUser)
+			if !ok {
+				return errors.New(fmt.Sprintf("failed to set %T from embedded struct %T", slice, maybeUser))
+			}
+		}
+	}
+
+	args := make(map[interface{}]struct{})
+	if singular {
+		if object.R == nil {
+			object.R = &userR{}
+		}
+		args[object.ID] = struct{}{}
+	} else {
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &userR{}
+			}
+			args[obj.ID] = struct{}{}
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	argsSlice := make([]interface{}, len(args))
+	i := 0
+	for arg := range args {
+		argsSlice[i] = arg
+		i++
+	}
+
+	query := NewQuery(
+		qm.From(`user_like_places`),
+		qm.WhereIn(`user_like_places.user_id in ?`, argsSlice...),
+	)
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load user_like_places")
+	}
+
+	var resultSlice []*UserLikePlace
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice user_like_places")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on user_like_places")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for user_like_places")
+	}
+
+	if len(userLikePlaceAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.UserLikePlaces = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &userLikePlaceR{}
+			}
+			foreign.R.User = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.UserID {
+				local.R.UserLikePlaces = append(local.R.UserLikePlaces, foreign)
+				if foreign.R == nil {
+					foreign.R = &userLikePlaceR{}
+				}
+				foreign.R.User = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddPlacePhotoReferences adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PlacePhotoReferences.
+// Sets related.R.User appropriately.
+func (o *User) AddPlacePhotoReferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlacePhotoReference) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `place_photo_references` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, placePhotoReferencePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PlacePhotoReferences: related,
+		}
+	} else {
+		o.R.PlacePhotoReferences = append(o.R.PlacePhotoReferences, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &placePhotoReferenceR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPlacePhotos adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PlacePhotos.
+// Sets related.R.User appropriately.
+func (o *User) AddPlacePhotos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlacePhoto) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.UserID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `place_photos` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, placePhotoPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.UserID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PlacePhotos: related,
+		}
+	} else {
+		o.R.PlacePhotos = append(o.R.PlacePhotos, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &placePhotoR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// AddPlanCandidateSetParticipants adds the given related objects to the existing relationships
+// of the user, optionally inserting them as new records.
+// Appends related to o.R.PlanCandidateSetParticipants.
+// Sets related.R.User appropriately.
+func (o *User) AddPlanCandidateSetParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSetParticipant) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.UserID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE `plan_candidate_set_participants` SET %s WHERE %s",
+				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
+				strmangle.WhereClause("`", "`", 0, planCandidateSetParticipantPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.IsDebug(ctx) {
+				writer := boil.DebugWriterFrom(ctx)
+				fmt.Fprintln(writer, updateQuery)
+				fmt.Fprintln(writer, values)
+			}
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.UserID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &userR{
+			PlanCandidateSetParticipants: related,
+		}
+	} else {
+		o.R.PlanCandidateSetParticipants = append(o.R.PlanCandidateSetParticipants, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &planCandidateSetParticipantR{
+				User: o,
+			}
+		} else {
+			rel.R.User = o
+		}
+	}
+	return nil
+}
+
+// SetPlanCandidateSetParticipants removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PlanCandidateSetParticipants accordingly.
+// Replaces o.R.PlanCandidateSetParticipants with related.
+// Sets related.R.User's PlanCandidateSetParticipants accordingly.
+func (o *User) SetPlanCandidateSetParticipants(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSetParticipant) error {
+	query := "update `plan_candidate_set_participants` set `user_id` = null where `user_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PlanCandidateSetParticipants {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.PlanCandidateSetParticipants = nil
+	}
+
+	return o.AddPlanCandidateSetParticipants(ctx, exec, insert, related...)
+}
+
+// RemovePlanCandidateSetParticipants relationships from objects passed in.
+// Removes related items from R.PlanCandidateSetParticipants (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemovePlanCandidateSetParticipants(ctx context.Context, exec boil.ContextExecutor, related ...*PlanCandidateSetParticipant) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PlanCandidateSetParticipants {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PlanCandidateSetParticipants)
+			if ln > 1 && i < ln-1 {
+				o.R.PlanCandidateSetParticipants[i] = o.R.PlanCandidateSetParticipants[ln-1]
 			}
+			o.R.PlanCandidateSetParticipants = o.R.PlanCandidateSetParticipants[:ln-1]
+			break
 		}
 	}
 
 	return nil
 }
 
-// AddPlacePhotoReferences adds the given related objects to the existing relationships
+// AddPlanCandidateSets adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PlacePhotoReferences.
+// Appends related to o.R.PlanCandidateSets.
 // Sets related.R.User appropriately.
-func (o *User) AddPlacePhotoReferences(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlacePhotoReference) error {
+func (o *User) AddPlanCandidateSets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSet) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `place_photo_references` SET %s WHERE %s",
+				"UPDATE `plan_candidate_sets` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, placePhotoReferencePrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, planCandidateSetPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -999,21 +1650,21 @@ func (o *User) AddPlacePhotoReferences(ctx context.Context, exec boil.ContextExe
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			PlacePhotoReferences: related,
+			PlanCandidateSets: related,
 		}
 	} else {
-		o.R.PlacePhotoReferences = append(o.R.PlacePhotoReferences, related...)
+		o.R.PlanCandidateSets = append(o.R.PlanCandidateSets, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &placePhotoReferenceR{
+			rel.R = &planCandidateSetR{
 				User: o,
 			}
 		} else {
@@ -1023,23 +1674,97 @@ func (o *User) AddPlacePhotoReferences(ctx context.Context, exec boil.ContextExe
 	return nil
 }
 
-// AddPlacePhotos adds the given related objects to the existing relationships
+// SetPlanCandidateSets removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's PlanCandidateSets accordingly.
+// Replaces o.R.PlanCandidateSets with related.
+// Sets related.R.User's PlanCandidateSets accordingly.
+func (o *User) SetPlanCandidateSets(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlanCandidateSet) error {
+	query := "update `plan_candidate_sets` set `user_id` = null where `user_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.PlanCandidateSets {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.PlanCandidateSets = nil
+	}
+
+	return o.AddPlanCandidateSets(ctx, exec, insert, related...)
+}
+
+// RemovePlanCandidateSets relationships from objects passed in.
+// Removes related items from R.PlanCandidateSets (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemovePlanCandidateSets(ctx context.Context, exec boil.ContextExecutor, related ...*PlanCandidateSet) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.PlanCandidateSets {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.PlanCandidateSets)
+			if ln > 1 && i < ln-1 {
+				o.R.PlanCandidateSets[i] = o.R.PlanCandidateSets[ln-1]
+			}
+			o.R.PlanCandidateSets = o.R.PlanCandidateSets[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddPlans adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.PlacePhotos.
+// Appends related to o.R.Plans.
 // Sets related.R.User appropriately.
-func (o *User) AddPlacePhotos(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*PlacePhoto) error {
+func (o *User) AddPlans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Plan) error {
 	var err error
 	for _, rel := range related {
 		if insert {
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
 				return errors.Wrap(err, "failed to insert into foreign table")
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `place_photos` SET %s WHERE %s",
+				"UPDATE `plans` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, placePhotoPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, planPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1052,21 +1777,21 @@ func (o *User) AddPlacePhotos(ctx context.Context, exec boil.ContextExecutor, in
 				return errors.Wrap(err, "failed to update foreign table")
 			}
 
-			rel.UserID = o.ID
+			queries.Assign(&rel.UserID, o.ID)
 		}
 	}
 
 	if o.R == nil {
 		o.R = &userR{
-			PlacePhotos: related,
+			Plans: related,
 		}
 	} else {
-		o.R.PlacePhotos = append(o.R.PlacePhotos, related...)
+		o.R.Plans = append(o.R.Plans, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &placePhotoR{
+			rel.R = &planR{
 				User: o,
 			}
 		} else {
@@ -1076,11 +1801,85 @@ func (o *User) AddPlacePhotos(ctx context.Context, exec boil.ContextExecutor, in
 	return nil
 }
 
-// AddPlans adds the given related objects to the existing relationships
+// SetPlans removes all previously related items of the
+// user replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.User's Plans accordingly.
+// Replaces o.R.Plans with related.
+// Sets related.R.User's Plans accordingly.
+func (o *User) SetPlans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Plan) error {
+	query := "update `plans` set `user_id` = null where `user_id` = ?"
+	values := []interface{}{o.ID}
+	if boil.IsDebug(ctx) {
+		writer := boil.DebugWriterFrom(ctx)
+		fmt.Fprintln(writer, query)
+		fmt.Fprintln(writer, values)
+	}
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.Plans {
+			queries.SetScanner(&rel.UserID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.User = nil
+		}
+		o.R.Plans = nil
+	}
+
+	return o.AddPlans(ctx, exec, insert, related...)
+}
+
+// RemovePlans relationships from objects passed in.
+// Removes related items from R.Plans (uses pointer comparison, removal does not keep order)
+// Sets related.R.User.
+func (o *User) RemovePlans(ctx context.Context, exec boil.ContextExecutor, related ...*Plan) error {
+	if len(related) == 0 {
+		return nil
+	}
+
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.UserID, nil)
+		if rel.R != nil {
+			rel.R.User = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("user_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.Plans {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.Plans)
+			if ln > 1 && i < ln-1 {
+				o.R.Plans[i] = o.R.Plans[ln-1]
+			}
+			o.R.Plans = o.R.Plans[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
+// AddTrips adds the given related objects to the existing relationships
 // of the user, optionally inserting them as new records.
-// Appends related to o.R.Plans.
+// Appends related to o.R.Trips.
 // Sets related.R.User appropriately.
-func (o *User) AddPlans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Plan) error {
+func (o *User) AddTrips(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Trip) error {
 	var err error
 	for _, rel := range related {
 		if insert {
@@ -1090,9 +1889,9 @@ func (o *User) AddPlans(ctx context.Context, exec boil.ContextExecutor, insert b
 			}
 		} else {
 			updateQuery := fmt.Sprintf(
-				"UPDATE `plans` SET %s WHERE %s",
+				"UPDATE `trips` SET %s WHERE %s",
 				strmangle.SetParamNames("`", "`", 0, []string{"user_id"}),
-				strmangle.WhereClause("`", "`", 0, planPrimaryKeyColumns),
+				strmangle.WhereClause("`", "`", 0, tripPrimaryKeyColumns),
 			)
 			values := []interface{}{o.ID, rel.ID}
 
@@ -1111,15 +1910,15 @@ func (o *User) AddPlans(ctx context.Context, exec boil.ContextExecutor, insert b
 
 	if o.R == nil {
 		o.R = &userR{
-			Plans: related,
+			Trips: related,
 		}
 	} else {
-		o.R.Plans = append(o.R.Plans, related...)
+		o.R.Trips = append(o.R.Trips, related...)
 	}
 
 	for _, rel := range related {
 		if rel.R == nil {
-			rel.R = &planR{
+			rel.R = &tripR{
 				User: o,
 			}
 		} else {
@@ -1129,14 +1928,14 @@ func (o *User) AddPlans(ctx context.Context, exec boil.ContextExecutor, insert b
 	return nil
 }
 
-// SetPlans removes all previously related items of the
+// SetTrips removes all previously related items of the
 // user replacing them completely with the passed
 // in related items, optionally inserting them as new records.
-// Sets o.R.User's Plans accordingly.
-// Replaces o.R.Plans with related.
-// Sets related.R.User's Plans accordingly.
-func (o *User) SetPlans(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Plan) error {
-	query := "update `plans` set `user_id` = null where `user_id` = ?"
+// Sets o.R.User's Trips accordingly.
+// Replaces o.R.Trips with related.
+// Sets related.R.User's Trips accordingly.
+func (o *User) SetTrips(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Trip) error {
+	query := "update `trips` set `user_id` = null where `user_id` = ?"
 	values := []interface{}{o.ID}
 	if boil.IsDebug(ctx) {
 		writer := boil.DebugWriterFrom(ctx)
@@ -1149,7 +1948,7 @@ func (o *User) SetPlans(ctx context.Context, exec boil.ContextExecutor, insert b
 	}
 
 	if o.R != nil {
-		for _, rel := range o.R.Plans {
+		for _, rel := range o.R.Trips {
 			queries.SetScanner(&rel.UserID, nil)
 			if rel.R == nil {
 				continue
@@ -1157,16 +1956,16 @@ func (o *User) SetPlans(ctx context.Context, exec boil.ContextExecutor, insert b
 
 			rel.R.User = nil
 		}
-		o.R.Plans = nil
+		o.R.Trips = nil
 	}
 
-	return o.AddPlans(ctx, exec, insert, related...)
+	return o.AddTrips(ctx, exec, insert, related...)
 }
 
-// RemovePlans relationships from objects passed in.
-// Removes related items from R.Plans (uses pointer comparison, removal does not keep order)
+// RemoveTrips relationships from objects passed in.
+// Removes related items from R.Trips (uses pointer comparison, removal does not keep order)
 // Sets related.R.User.
-func (o *User) RemovePlans(ctx context.Context, exec boil.ContextExecutor, related ...*Plan) error {
+func (o *User) RemoveTrips(ctx context.Context, exec boil.ContextExecutor, related ...*Trip) error {
 	if len(related) == 0 {
 		return nil
 	}
@@ -1186,16 +1985,16 @@ func (o *User) RemovePlans(ctx context.Context, exec boil.ContextExecutor, relat
 	}
 
 	for _, rel := range related {
-		for i, ri := range o.R.Plans {
+		for i, ri := range o.R.Trips {
 			if rel != ri {
 				continue
 			}
 
-			ln := len(o.R.Plans)
+			ln := len(o.R.Trips)
 			if ln > 1 && i < ln-1 {
-				o.R.Plans[i] = o.R.Plans[ln-1]
+				o.R.Trips[i] = o.R.Trips[ln-1]
 			}
-			o.R.Plans = o.R.Plans[:ln-1]
+			o.R.Trips = o.R.Trips[:ln-1]
 			break
 		}
 	}
@@ -2330,6 +3129,60 @@ func (s UserSlice) GetLoadedPlacePhotos() PlacePhotoSlice {
 	return result
 }
 
+// LoadPlanCandidateSetParticipantsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadPlanCandidateSetParticipantsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateSetParticipantsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadPlanCandidateSetParticipantsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateSetParticipants(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s UserSlice) GetLoadedPlanCandidateSetParticipants() PlanCandidateSetParticipantSlice {
+	result := make(PlanCandidateSetParticipantSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateSetParticipants == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateSetParticipants...)
+	}
+	return result
+}
+
+// LoadPlanCandidateSetsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadPlanCandidateSetsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadPlanCandidateSetsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadPlanCandidateSetsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadPlanCandidateSets(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s UserSlice) GetLoadedPlanCandidateSets() PlanCandidateSetSlice {
+	result := make(PlanCandidateSetSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.PlanCandidateSets == nil {
+			continue
+		}
+		result = append(result, item.R.PlanCandidateSets...)
+	}
+	return result
+}
+
 // LoadPlansByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
 func (s UserSlice) LoadPlansByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadPlansByPageEx(ctx, e, DefaultPageSize, mods...)
@@ -2357,6 +3210,33 @@ func (s UserSlice) GetLoadedPlans() PlanSlice {
 	return result
 }
 
+// LoadTripsByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
+func (s UserSlice) LoadTripsByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
+	return s.LoadTripsByPageEx(ctx, e, DefaultPageSize, mods...)
+}
+func (s UserSlice) LoadTripsByPageEx(ctx context.Context, e boil.ContextExecutor, pageSize int, mods ...qm.QueryMod) error {
+	if len(s) == 0 {
+		return nil
+	}
+	for _, chunk := range chunkSlice[*User](s, pageSize) {
+		if err := chunk[0].L.LoadTrips(ctx, e, false, &chunk, queryMods(mods)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s UserSlice) GetLoadedTrips() TripSlice {
+	result := make(TripSlice, 0, len(s)*2)
+	for _, item := range s {
+		if item.R == nil || item.R.Trips == nil {
+			continue
+		}
+		result = append(result, item.R.Trips...)
+	}
+	return result
+}
+
 // LoadUserLikePlacesByPage performs eager loading of values by page. This is for a 1-M or N-M relationship.
 func (s UserSlice) LoadUserLikePlacesByPage(ctx context.Context, e boil.ContextExecutor, mods ...qm.QueryMod) error {
 	return s.LoadUserLikePlacesByPageEx(ctx, e, DefaultPageSize, mods...)
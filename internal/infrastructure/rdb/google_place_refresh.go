@@ -0,0 +1,255 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+// FindStaleGooglePlaces は policy のもとで古い情報を持つ場所を、取得し直す優先度の高い順に最大 limit 件取得する
+// 優先度は models.GooglePlaceFreshness.RefreshPriority と同じ式で計算し、並び替えてから件数を絞る
+func (p PlaceRepository) FindStaleGooglePlaces(ctx context.Context, policy models.GooglePlaceRefreshPolicy, now time.Time, limit int) ([]models.GooglePlaceFreshness, error) {
+	fetchedBefore := policy.FetchedBefore(now)
+	detailFetchedBefore := policy.DetailFetchedBefore(now)
+
+	// 古さは最大の保存期間に対する経過時間の割合で、基本情報・営業時間・レビューのうち最も古いものを用いる
+	// 人気はレビュー数の対数とする
+	refreshPriority := fmt.Sprintf(
+		`GREATEST(
+	TIMESTAMPDIFF(SECOND, %s, ?) / ?,
+	COALESCE(TIMESTAMPDIFF(SECOND, %s, ?) / ?, 0),
+	COALESCE(TIMESTAMPDIFF(SECOND, %s, ?) / ?, 0)
+) * LOG10(10 + COALESCE(%s, 0)) DESC, %s`,
+		generated.GooglePlaceColumns.FetchedAt,
+		generated.GooglePlaceColumns.OpeningPeriodsFetchedAt,
+		generated.GooglePlaceColumns.ReviewsFetchedAt,
+		generated.GooglePlaceColumns.UserRatingsTotal,
+		generated.GooglePlaceColumns.FetchedAt,
+	)
+	maxAgeInSeconds := policy.MaxAge.Seconds()
+	detailMaxAgeInSeconds := policy.DetailMaxAge.Seconds()
+
+	googlePlaceEntities, err := generated.GooglePlaces(
+		qm.Select(
+			generated.GooglePlaceColumns.GooglePlaceID,
+			generated.GooglePlaceColumns.UserRatingsTotal,
+			generated.GooglePlaceColumns.FetchedAt,
+			generated.GooglePlaceColumns.OpeningPeriodsFetchedAt,
+			generated.GooglePlaceColumns.ReviewsFetchedAt,
+		),
+		qm.Expr(
+			generated.GooglePlaceWhere.FetchedAt.LT(fetchedBefore),
+			qm.Or2(generated.GooglePlaceWhere.OpeningPeriodsFetchedAt.LT(null.TimeFrom(detailFetchedBefore))),
+			qm.Or2(generated.GooglePlaceWhere.ReviewsFetchedAt.LT(null.TimeFrom(detailFetchedBefore))),
+		),
+		qm.OrderBy(
+			refreshPriority,
+			now, maxAgeInSeconds,
+			now, detailMaxAgeInSeconds,
+			now, detailMaxAgeInSeconds,
+		),
+		qm.Limit(limit),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stale google places: %w", err)
+	}
+
+	return array.Map(googlePlaceEntities, func(googlePlaceEntity *generated.GooglePlace) models.GooglePlaceFreshness {
+		return factory.NewGooglePlaceFreshnessFromEntity(*googlePlaceEntity)
+	}), nil
+}
+
+// RefreshGooglePlace は Google Places API から取得し直した情報で、保存されている場所の情報を更新する
+// 種類・営業時間・レビューは保存されているものとの差分のみを更新する
+// 位置が変わった場合は、S2 セルも計算し直す
+// googlePlace.PlaceDetail が nil の場合は、営業時間とレビューは更新しない
+func (p PlaceRepository) RefreshGooglePlace(ctx context.Context, googlePlace models.GooglePlace, fetchedAt time.Time) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		googlePlaceEntity, err := generated.GooglePlaces(
+			generated.GooglePlaceWhere.GooglePlaceID.EQ(googlePlace.PlaceId),
+			qm.Load(generated.GooglePlaceRels.GooglePlaceTypes),
+			qm.Load(generated.GooglePlaceRels.GooglePlaceOpeningPeriods),
+			qm.Load(generated.GooglePlaceRels.GooglePlaceReviews),
+			qm.For("UPDATE"),
+		).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to find google place: %w", err)
+		}
+
+		googlePlaceEntity.Name = googlePlace.Name
+		googlePlaceEntity.PriceLevel = null.IntFrom(googlePlace.PriceLevel)
+		googlePlaceEntity.Rating = null.Float32From(googlePlace.Rating)
+		googlePlaceEntity.UserRatingsTotal = null.IntFrom(googlePlace.UserRatingsTotal)
+		googlePlaceEntity.FetchedAt = fetchedAt
+
+		if googlePlaceEntity.Latitude != googlePlace.Location.Latitude || googlePlaceEntity.Longitude != googlePlace.Location.Longitude {
+			s2CellIds := googlePlace.Location.S2CellIds()
+			googlePlaceEntity.Latitude = googlePlace.Location.Latitude
+			googlePlaceEntity.Longitude = googlePlace.Location.Longitude
+			googlePlaceEntity.S2CellIDLevel10 = null.Uint64From(s2CellIds.Level10)
+			googlePlaceEntity.S2CellIDLevel13 = null.Uint64From(s2CellIds.Level13)
+			googlePlaceEntity.S2CellIDLevel16 = null.Uint64From(s2CellIds.Level16)
+		}
+
+		// Place Detail API では住所を取得していないため、取得できた場合のみ更新する
+		if googlePlace.FormattedAddress != nil {
+			googlePlaceEntity.FormattedAddress = null.StringFromPtr(googlePlace.FormattedAddress)
+		}
+		if googlePlace.Vicinity != nil {
+			googlePlaceEntity.Vicinity = null.StringFromPtr(googlePlace.Vicinity)
+		}
+
+		if err := refreshGooglePlaceTypes(ctx, tx, googlePlaceEntity, googlePlace); err != nil {
+			return err
+		}
+
+		if googlePlace.PlaceDetail != nil {
+			if err := refreshGooglePlaceOpeningPeriods(ctx, tx, googlePlaceEntity, *googlePlace.PlaceDetail); err != nil {
+				return err
+			}
+
+			if err := refreshGooglePlaceReviews(ctx, tx, googlePlaceEntity, *googlePlace.PlaceDetail); err != nil {
+				return err
+			}
+
+			googlePlaceEntity.OpeningPeriodsFetchedAt = null.TimeFrom(fetchedAt)
+			googlePlaceEntity.ReviewsFetchedAt = null.TimeFrom(fetchedAt)
+		}
+
+		if _, err := googlePlaceEntity.Update(ctx, tx, boil.Whitelist(
+			generated.GooglePlaceColumns.Name,
+			generated.GooglePlaceColumns.PriceLevel,
+			generated.GooglePlaceColumns.Rating,
+			generated.GooglePlaceColumns.UserRatingsTotal,
+			generated.GooglePlaceColumns.Latitude,
+			generated.GooglePlaceColumns.Longitude,
+			generated.GooglePlaceColumns.S2CellIDLevel10,
+			generated.GooglePlaceColumns.S2CellIDLevel13,
+			generated.GooglePlaceColumns.S2CellIDLevel16,
+			generated.GooglePlaceColumns.FormattedAddress,
+			generated.GooglePlaceColumns.Vicinity,
+			generated.GooglePlaceColumns.FetchedAt,
+			generated.GooglePlaceColumns.OpeningPeriodsFetchedAt,
+			generated.GooglePlaceColumns.ReviewsFetchedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update google place: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+// refreshGooglePlaceTypes は種類の並び順が変わった場合のみ、保存されている種類を置き換える
+// (種類の並び順は場所のカテゴリを決めるときに用いられるため、順番も比較する)
+func refreshGooglePlaceTypes(ctx context.Context, tx *sql.Tx, googlePlaceEntity *generated.GooglePlace, googlePlace models.GooglePlace) error {
+	savedTypes := factory.NewGooglePlaceTypesFromEntity(googlePlaceEntity.R.GooglePlaceTypes)
+	if slices.Equal(savedTypes, googlePlace.Types) {
+		return nil
+	}
+
+	if _, err := googlePlaceEntity.R.GooglePlaceTypes.DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete google place types: %w", err)
+	}
+
+	googlePlaceTypeSlice := factory.NewGooglePlaceTypeSliceFromGooglePlace(googlePlace)
+	if _, err := googlePlaceTypeSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert google place types: %w", err)
+	}
+
+	return nil
+}
+
+// refreshGooglePlaceOpeningPeriods は取得し直した営業時間に含まれなくなったものを削除し、新しく含まれるようになったものを追加する
+func refreshGooglePlaceOpeningPeriods(ctx context.Context, tx *sql.Tx, googlePlaceEntity *generated.GooglePlace, googlePlaceDetail models.GooglePlaceDetail) error {
+	openingPeriodKey := func(openingPeriodEntity *generated.GooglePlaceOpeningPeriod) string {
+		return fmt.Sprintf("%d-%s-%d-%s", openingPeriodEntity.OpenDay, openingPeriodEntity.OpenTime, openingPeriodEntity.CloseDay, openingPeriodEntity.CloseTime)
+	}
+
+	savedOpeningPeriodSlice := googlePlaceEntity.R.GooglePlaceOpeningPeriods
+	fetchedOpeningPeriodSlice := factory.NewGooglePlaceOpeningPeriodSliceFromGooglePlaceDetail(googlePlaceDetail, googlePlaceEntity.GooglePlaceID)
+
+	savedKeys := array.Map(savedOpeningPeriodSlice, openingPeriodKey)
+	fetchedKeys := array.Map(fetchedOpeningPeriodSlice, openingPeriodKey)
+
+	var openingPeriodSliceToDelete generated.GooglePlaceOpeningPeriodSlice = array.Filter(savedOpeningPeriodSlice, func(openingPeriodEntity *generated.GooglePlaceOpeningPeriod) bool {
+		return !array.IsContain(fetchedKeys, openingPeriodKey(openingPeriodEntity))
+	})
+	if _, err := openingPeriodSliceToDelete.DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete google place opening periods: %w", err)
+	}
+
+	var openingPeriodSliceToInsert generated.GooglePlaceOpeningPeriodSlice = array.Filter(fetchedOpeningPeriodSlice, func(openingPeriodEntity *generated.GooglePlaceOpeningPeriod) bool {
+		return !array.IsContain(savedKeys, openingPeriodKey(openingPeriodEntity))
+	})
+	if _, err := openingPeriodSliceToInsert.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert google place opening periods: %w", err)
+	}
+
+	return nil
+}
+
+// refreshGooglePlaceReviews は投稿者と投稿日時が同じレビューを同じものとみなし、内容を更新する
+// 取得し直したレビューに含まれなくなったものは削除し、新しく含まれるようになったものは追加する
+func refreshGooglePlaceReviews(ctx context.Context, tx *sql.Tx, googlePlaceEntity *generated.GooglePlace, googlePlaceDetail models.GooglePlaceDetail) error {
+	reviewKey := func(reviewEntity *generated.GooglePlaceReview) string {
+		return fmt.Sprintf("%s-%d", reviewEntity.AuthorName.String, reviewEntity.Time.Int)
+	}
+
+	savedReviewSlice := googlePlaceEntity.R.GooglePlaceReviews
+	fetchedReviewSlice := factory.NewGooglePlaceReviewSliceFromGooglePlaceDetail(googlePlaceDetail, googlePlaceEntity.GooglePlaceID)
+
+	fetchedKeys := array.Map(fetchedReviewSlice, reviewKey)
+
+	var reviewSliceToDelete generated.GooglePlaceReviewSlice = array.Filter(savedReviewSlice, func(reviewEntity *generated.GooglePlaceReview) bool {
+		return !array.IsContain(fetchedKeys, reviewKey(reviewEntity))
+	})
+	if _, err := reviewSliceToDelete.DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete google place reviews: %w", err)
+	}
+
+	var reviewSliceToInsert generated.GooglePlaceReviewSlice
+	for _, fetchedReviewEntity := range fetchedReviewSlice {
+		savedReviewEntity, found := array.Find(savedReviewSlice, func(reviewEntity *generated.GooglePlaceReview) bool {
+			return reviewKey(reviewEntity) == reviewKey(fetchedReviewEntity)
+		})
+		if !found {
+			reviewSliceToInsert = append(reviewSliceToInsert, fetchedReviewEntity)
+			continue
+		}
+
+		savedReviewEntity.Rating = fetchedReviewEntity.Rating
+		savedReviewEntity.Text = fetchedReviewEntity.Text
+		savedReviewEntity.AuthorURL = fetchedReviewEntity.AuthorURL
+		savedReviewEntity.AuthorProfilePhotoURL = fetchedReviewEntity.AuthorProfilePhotoURL
+		savedReviewEntity.Language = fetchedReviewEntity.Language
+		if _, err := savedReviewEntity.Update(ctx, tx, boil.Whitelist(
+			generated.GooglePlaceReviewColumns.Rating,
+			generated.GooglePlaceReviewColumns.Text,
+			generated.GooglePlaceReviewColumns.AuthorURL,
+			generated.GooglePlaceReviewColumns.AuthorProfilePhotoURL,
+			generated.GooglePlaceReviewColumns.Language,
+		)); err != nil {
+			return fmt.Errorf("failed to update google place review: %w", err)
+		}
+	}
+
+	if _, err := reviewSliceToInsert.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert google place reviews: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package rdb
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func TestPlaceRepository_FindStaleGooglePlaces(t *testing.T) {
+	now := time.Date(2024, 7, 25, 0, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		name                string
+		googlePlaceId       string
+		fetchedAt           time.Time
+		detailFetchedAt     *time.Time
+		expectedToBeFetched bool
+	}{
+		{
+			name:                "recently fetched",
+			googlePlaceId:       "google-place-fresh",
+			fetchedAt:           now.AddDate(0, 0, -1),
+			detailFetchedAt:     utils.ToPointer(now.AddDate(0, 0, -1)),
+			expectedToBeFetched: false,
+		},
+		{
+			name:                "basic information is stale",
+			googlePlaceId:       "google-place-stale",
+			fetchedAt:           now.AddDate(0, 0, -100),
+			expectedToBeFetched: true,
+		},
+		{
+			name:                "detail is stale",
+			googlePlaceId:       "google-place-detail-stale",
+			fetchedAt:           now.AddDate(0, 0, -40),
+			detailFetchedAt:     utils.ToPointer(now.AddDate(0, 0, -40)),
+			expectedToBeFetched: true,
+		},
+		{
+			name:                "detail is not fetched",
+			googlePlaceId:       "google-place-without-detail",
+			fetchedAt:           now.AddDate(0, 0, -40),
+			expectedToBeFetched: false,
+		},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	for _, c := range cases {
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if err := savePlaces(testContext, testDB, []models.Place{{Id: "place", Google: models.GooglePlace{PlaceId: c.googlePlaceId}}}); err != nil {
+				t.Fatalf("error saving places: %v", err)
+			}
+
+			columns := generated.M{generated.GooglePlaceColumns.FetchedAt: c.fetchedAt}
+			if c.detailFetchedAt != nil {
+				columns[generated.GooglePlaceColumns.OpeningPeriodsFetchedAt] = *c.detailFetchedAt
+				columns[generated.GooglePlaceColumns.ReviewsFetchedAt] = *c.detailFetchedAt
+			}
+			if _, err := generated.GooglePlaces(generated.GooglePlaceWhere.GooglePlaceID.EQ(c.googlePlaceId)).UpdateAll(testContext, testDB, columns); err != nil {
+				t.Fatalf("error updating fetched at: %v", err)
+			}
+
+			stalePlaces, err := placeRepository.FindStaleGooglePlaces(testContext, models.DefaultGooglePlaceRefreshPolicy(), now, 10)
+			if err != nil {
+				t.Fatalf("error finding stale google places: %v", err)
+			}
+
+			_, found := array.Find(stalePlaces, func(stalePlace models.GooglePlaceFreshness) bool {
+				return stalePlace.GooglePlaceId == c.googlePlaceId
+			})
+			if diff := cmp.Diff(c.expectedToBeFetched, found); diff != "" {
+				t.Errorf("stale google place mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlaceRepository_RefreshGooglePlace(t *testing.T) {
+	savedPlace := models.Place{
+		Id: "place",
+		Google: models.GooglePlace{
+			PlaceId:          "google-place",
+			Name:             "old name",
+			Types:            []string{"cafe", "food"},
+			Rating:           3.5,
+			UserRatingsTotal: 10,
+			PlaceDetail: &models.GooglePlaceDetail{
+				OpeningHours: &models.GooglePlaceOpeningHours{
+					Periods: []models.GooglePlaceOpeningPeriod{
+						{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "0900", ClosingTime: "1800"},
+						{DayOfWeekOpen: "Tuesday", DayOfWeekClose: "Tuesday", OpeningTime: "0900", ClosingTime: "1800"},
+					},
+				},
+				Reviews: []models.GooglePlaceReview{
+					{AuthorName: "kept", Time: 1, Rating: 3, Text: utils.StrPointer("old text")},
+					{AuthorName: "removed", Time: 2, Rating: 5},
+				},
+			},
+		},
+	}
+
+	fetchedGooglePlace := models.GooglePlace{
+		PlaceId:          "google-place",
+		Name:             "new name",
+		Types:            []string{"bakery", "cafe", "food"},
+		Rating:           4.0,
+		UserRatingsTotal: 20,
+		PlaceDetail: &models.GooglePlaceDetail{
+			OpeningHours: &models.GooglePlaceOpeningHours{
+				Periods: []models.GooglePlaceOpeningPeriod{
+					{DayOfWeekOpen: "Monday", DayOfWeekClose: "Monday", OpeningTime: "0900", ClosingTime: "1800"},
+					{DayOfWeekOpen: "Wednesday", DayOfWeekClose: "Wednesday", OpeningTime: "1000", ClosingTime: "1900"},
+				},
+			},
+			Reviews: []models.GooglePlaceReview{
+				{AuthorName: "kept", Time: 1, Rating: 4, Text: utils.StrPointer("new text")},
+				{AuthorName: "added", Time: 3, Rating: 2},
+			},
+		},
+	}
+
+	fetchedAt := time.Date(2024, 7, 25, 0, 0, 0, 0, time.Local)
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, []models.Place{savedPlace}); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	if err := placeRepository.RefreshGooglePlace(testContext, fetchedGooglePlace, fetchedAt); err != nil {
+		t.Fatalf("error refreshing google place: %v", err)
+	}
+
+	googlePlaceEntity, err := generated.GooglePlaces(
+		generated.GooglePlaceWhere.GooglePlaceID.EQ("google-place"),
+		qm.Load(generated.GooglePlaceRels.GooglePlaceTypes),
+		qm.Load(generated.GooglePlaceRels.GooglePlaceOpeningPeriods),
+		qm.Load(generated.GooglePlaceRels.GooglePlaceReviews),
+	).One(testContext, testDB)
+	if err != nil {
+		t.Fatalf("error finding google place: %v", err)
+	}
+
+	if diff := cmp.Diff("new name", googlePlaceEntity.Name); diff != "" {
+		t.Errorf("name mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(20, googlePlaceEntity.UserRatingsTotal.Int); diff != "" {
+		t.Errorf("user ratings total mismatch (-want +got):\n%s", diff)
+	}
+
+	if !googlePlaceEntity.FetchedAt.Equal(fetchedAt) || !googlePlaceEntity.ReviewsFetchedAt.Time.Equal(fetchedAt) || !googlePlaceEntity.OpeningPeriodsFetchedAt.Time.Equal(fetchedAt) {
+		t.Errorf("fetched at is not updated: %v", googlePlaceEntity)
+	}
+
+	types := array.Map(googlePlaceEntity.R.GooglePlaceTypes, func(typeEntity *generated.GooglePlaceType) string { return typeEntity.Type })
+	if diff := cmp.Diff([]string{"bakery", "cafe", "food"}, sortedStrings(types)); diff != "" {
+		t.Errorf("types mismatch (-want +got):\n%s", diff)
+	}
+
+	openingPeriods := array.Map(googlePlaceEntity.R.GooglePlaceOpeningPeriods, func(openingPeriodEntity *generated.GooglePlaceOpeningPeriod) string {
+		return time.Weekday(openingPeriodEntity.OpenDay).String() + " " + openingPeriodEntity.OpenTime
+	})
+	if diff := cmp.Diff([]string{"Monday 0900", "Wednesday 1000"}, sortedStrings(openingPeriods)); diff != "" {
+		t.Errorf("opening periods mismatch (-want +got):\n%s", diff)
+	}
+
+	reviews := array.Map(googlePlaceEntity.R.GooglePlaceReviews, func(reviewEntity *generated.GooglePlaceReview) string {
+		return reviewEntity.AuthorName.String + " " + reviewEntity.Text.String
+	})
+	if diff := cmp.Diff([]string{"added ", "kept new text"}, sortedStrings(reviews)); diff != "" {
+		t.Errorf("reviews mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func sortedStrings(values []string) []string {
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
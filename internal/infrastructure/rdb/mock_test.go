@@ -3,6 +3,7 @@ package rdb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/volatiletech/null/v8"
@@ -103,6 +104,25 @@ func savePlanCandidateSet(ctx context.Context, db *sql.DB, planCandidateSet mode
 			LatitudeStart:                planCandidateSet.MetaData.LocationStart.Latitude,
 			LongitudeStart:               planCandidateSet.MetaData.LocationStart.Longitude,
 			PlanDurationMinutes:          null.IntFromPtr(planCandidateSet.MetaData.FreeTime),
+			StartAt:                      null.TimeFromPtr(planCandidateSet.MetaData.StartTime),
+			TravelMode:                   string(planCandidateSet.MetaData.GetTravelMode()),
+			BudgetMax:                    null.IntFromPtr(planCandidateSet.MetaData.BudgetMax),
+			NumberOfPeople:               null.IntFromPtr(planCandidateSet.MetaData.NumberOfPeople),
+		}
+		if planCandidateSet.MetaData.LocationEnd != nil {
+			planCandidateSetMetaDataEntity.LatitudeEnd = null.Float64From(planCandidateSet.MetaData.LocationEnd.Latitude)
+			planCandidateSetMetaDataEntity.LongitudeEnd = null.Float64From(planCandidateSet.MetaData.LocationEnd.Longitude)
+		}
+		if planCandidateSet.MetaData.Weather != nil {
+			planCandidateSetMetaDataEntity.WeatherPrecipitationProbability = null.IntFrom(planCandidateSet.MetaData.Weather.PrecipitationProbability)
+			planCandidateSetMetaDataEntity.WeatherPrecipitationMM = null.Float64From(planCandidateSet.MetaData.Weather.PrecipitationInMm)
+		}
+		if planCandidateSet.MetaData.PromptIntent != nil {
+			promptIntent, err := json.Marshal(planCandidateSet.MetaData.PromptIntent)
+			if err != nil {
+				return fmt.Errorf("failed to marshal prompt intent: %v", err)
+			}
+			planCandidateSetMetaDataEntity.PromptIntent = null.JSONFrom(promptIntent)
 		}
 		if err := planCandidateSetMetaDataEntity.Insert(ctx, db, boil.Infer()); err != nil {
 			return fmt.Errorf("failed to insert plan candidate set meta data: %v", err)
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/friendsofgo/errors"
 	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -71,11 +73,16 @@ func (p PlaceRepository) SavePlacesFromGooglePlaces(ctx context.Context, googleP
 		// Google Place から対応する Place を生成
 		var googlePlaceEntities generated.GooglePlaceSlice
 		var placeEntities generated.PlaceSlice
+		fetchedAt := time.Now()
 		for _, googlePlace := range googlePlacesNotSaved {
 			placeEntity := factory.NewPlaceEntityFromGooglePlaceEntity(googlePlace)
 			placeEntities = append(placeEntities, &placeEntity)
 
 			googlePlaceEntity := factory.NewGooglePlaceEntityFromGooglePlace(googlePlace, placeEntity.ID)
+			if googlePlace.PlaceDetail != nil {
+				googlePlaceEntity.OpeningPeriodsFetchedAt = null.TimeFrom(fetchedAt)
+				googlePlaceEntity.ReviewsFetchedAt = null.TimeFrom(fetchedAt)
+			}
 			googlePlaceEntities = append(googlePlaceEntities, &googlePlaceEntity)
 		}
 
@@ -227,13 +234,8 @@ func (p PlaceRepository) Find(ctx context.Context, placeId string) (*models.Plac
 }
 
 func (p PlaceRepository) FindByLocation(ctx context.Context, location models.GeoLocation, radius float64) ([]models.Place, error) {
-	minLocation, maxLocation := location.CalculateMBR(radius)
-
 	googlePlaceEntities, err := generated.GooglePlaces(
-		generated.GooglePlaceWhere.Latitude.GT(minLocation.Latitude),
-		generated.GooglePlaceWhere.Latitude.LT(maxLocation.Latitude),
-		generated.GooglePlaceWhere.Longitude.GT(minLocation.Longitude),
-		generated.GooglePlaceWhere.Longitude.LT(maxLocation.Longitude),
+		googlePlaceWithinRadiusQueryMod(location, radius),
 		qm.Load(generated.GooglePlaceRels.Place),
 		qm.Load(generated.GooglePlaceRels.Place+"."+generated.PlaceRels.PlacePhotos),
 		qm.Load(generated.GooglePlaceRels.GooglePlaceTypes),
@@ -290,7 +292,6 @@ func (p PlaceRepository) FindByLocation(ctx context.Context, location models.Geo
 }
 
 func (p PlaceRepository) FindByGooglePlaceType(ctx context.Context, googlePlaceType string, baseLocation models.GeoLocation, radius float64) (*[]models.Place, error) {
-	minLocation, maxLocation := baseLocation.CalculateMBR(radius)
 	googlePlaceEntities, err := generated.GooglePlaces(
 		qm.InnerJoin(fmt.Sprintf(
 			"%s on %s.%s = %s.%s",
@@ -301,10 +302,7 @@ func (p PlaceRepository) FindByGooglePlaceType(ctx context.Context, googlePlaceT
 			generated.GooglePlaceColumns.GooglePlaceID,
 		)),
 		qm.Where(fmt.Sprintf("%s.%s = ?", generated.TableNames.GooglePlaceTypes, generated.GooglePlaceTypeColumns.Type), googlePlaceType),
-		generated.GooglePlaceWhere.Latitude.GT(minLocation.Latitude),
-		generated.GooglePlaceWhere.Latitude.LT(maxLocation.Latitude),
-		generated.GooglePlaceWhere.Longitude.GT(minLocation.Longitude),
-		generated.GooglePlaceWhere.Longitude.LT(maxLocation.Longitude),
+		googlePlaceWithinRadiusQueryMod(baseLocation, radius),
 		qm.OrderBy(generated.GooglePlaceColumns.UserRatingsTotal+" desc"),
 		qm.Limit(100),
 		qm.Load(generated.GooglePlaceRels.Place),
@@ -592,6 +590,7 @@ func (p PlaceRepository) SaveGooglePlaceDetail(ctx context.Context, googlePlaceI
 			if err := googlePlaceEntity.AddGooglePlaceReviews(ctx, tx, true, googlePlaceOpeningPeriodEntities...); err != nil {
 				return fmt.Errorf("failed to insert google place opening period: %w", err)
 			}
+			googlePlaceEntity.ReviewsFetchedAt = null.TimeFrom(time.Now())
 		}
 
 		// GooglePlaceOpeningPeriodを保存
@@ -600,6 +599,15 @@ func (p PlaceRepository) SaveGooglePlaceDetail(ctx context.Context, googlePlaceI
 			if err := googlePlaceEntity.AddGooglePlaceOpeningPeriods(ctx, tx, true, googlePlaceOpeningPeriodEntities...); err != nil {
 				return fmt.Errorf("failed to insert google place opening period: %w", err)
 			}
+			googlePlaceEntity.OpeningPeriodsFetchedAt = null.TimeFrom(time.Now())
+		}
+
+		// 営業時間・レビューを取得した日時を保存
+		if _, err := googlePlaceEntity.Update(ctx, tx, boil.Whitelist(
+			generated.GooglePlaceColumns.OpeningPeriodsFetchedAt,
+			generated.GooglePlaceColumns.ReviewsFetchedAt,
+		)); err != nil {
+			return fmt.Errorf("failed to update fetched at of google place: %w", err)
 		}
 
 		// GooglePlacePhotoReferenceを保存
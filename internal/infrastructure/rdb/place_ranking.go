@@ -0,0 +1,223 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/entities"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+// placeRankingScoreUpsertBatchSize は一度の INSERT 文でまとめて保存するスコアの数
+const placeRankingScoreUpsertBatchSize = 500
+
+func (p PlaceRepository) FindRankingSignals(ctx context.Context) ([]models.PlaceRankingSignals, error) {
+	googlePlaceSlice, err := generated.GooglePlaces(
+		qm.Select(
+			generated.GooglePlaceColumns.PlaceID,
+			generated.GooglePlaceColumns.Rating,
+			generated.GooglePlaceColumns.UserRatingsTotal,
+		),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find google places: %w", err)
+	}
+
+	likeCounts, err := countAllPlaceLikeCounts(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	savedPlanCounts, err := countAllPlaceSavedPlanCounts(ctx, p.db)
+	if err != nil {
+		return nil, err
+	}
+
+	signals := make([]models.PlaceRankingSignals, 0, len(googlePlaceSlice))
+	for _, googlePlaceEntity := range googlePlaceSlice {
+		if googlePlaceEntity == nil {
+			continue
+		}
+
+		signals = append(signals, models.PlaceRankingSignals{
+			PlaceId:                googlePlaceEntity.PlaceID,
+			GoogleRating:           googlePlaceEntity.Rating.Float32,
+			GoogleUserRatingsTotal: googlePlaceEntity.UserRatingsTotal.Int,
+			LikeCount:              likeCounts[googlePlaceEntity.PlaceID],
+			SavedPlanCount:         savedPlanCounts[googlePlaceEntity.PlaceID],
+		})
+	}
+
+	return signals, nil
+}
+
+func (p PlaceRepository) SaveRankingScores(ctx context.Context, scores []models.PlaceRankingScore) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		for _, chunk := range array.Chunk(scores, placeRankingScoreUpsertBatchSize) {
+			if err := upsertPlaceRankingScores(ctx, tx, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p PlaceRepository) FindRankingScoresByPlaceIds(ctx context.Context, placeIds []string) (map[string]float64, error) {
+	scores := make(map[string]float64, len(placeIds))
+	if len(placeIds) == 0 {
+		return scores, nil
+	}
+
+	placeRankingScoreSlice, err := generated.PlaceRankingScores(
+		generated.PlaceRankingScoreWhere.PlaceID.IN(placeIds),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find place ranking scores: %w", err)
+	}
+
+	for _, placeRankingScoreEntity := range placeRankingScoreSlice {
+		if placeRankingScoreEntity == nil {
+			continue
+		}
+		scores[placeRankingScoreEntity.PlaceID] = placeRankingScoreEntity.Score
+	}
+
+	return scores, nil
+}
+
+// countAllPlaceLikeCounts はプラン候補とユーザーによるいいねの合計を、場所ごとに集計する
+func countAllPlaceLikeCounts(ctx context.Context, exec boil.ContextExecutor) (map[string]int, error) {
+	query := fmt.Sprintf(
+		`SELECT place_likes.%s, COUNT(*) AS %s
+FROM (
+	SELECT %s AS %s
+	FROM %s
+	UNION ALL
+	SELECT %s AS %s
+	FROM %s
+) AS place_likes
+GROUP BY place_likes.%s`,
+		entities.PlanCandidateSetPlaceLikeCountColumns.PlaceId,
+		entities.PlanCandidateSetPlaceLikeCountColumns.LikeCount,
+
+		generated.PlanCandidateSetLikePlaceColumns.PlaceID,
+		entities.PlanCandidateSetPlaceLikeCountColumns.PlaceId,
+		generated.TableNames.PlanCandidateSetLikePlaces,
+
+		generated.UserLikePlaceColumns.PlaceID,
+		entities.PlanCandidateSetPlaceLikeCountColumns.PlaceId,
+		generated.TableNames.UserLikePlaces,
+
+		entities.PlanCandidateSetPlaceLikeCountColumns.PlaceId,
+	)
+
+	var placeLikeCounts []entities.PlanCandidateSetPlaceLikeCount
+	if err := queries.Raw(query).Bind(ctx, exec, &placeLikeCounts); err != nil {
+		return nil, fmt.Errorf("failed to count place like counts: %w", err)
+	}
+
+	likeCounts := make(map[string]int, len(placeLikeCounts))
+	for _, placeLikeCount := range placeLikeCounts {
+		likeCounts[placeLikeCount.PlaceId] = placeLikeCount.LikeCount
+	}
+	return likeCounts, nil
+}
+
+func (p PlaceRepository) CountSavedPlansByPlaceIds(ctx context.Context, placeIds []string) (map[string]int, error) {
+	if len(placeIds) == 0 {
+		return make(map[string]int), nil
+	}
+
+	return countPlaceSavedPlanCounts(ctx, p.db, placeIds)
+}
+
+// upsertPlaceRankingScores はスコアを一つの INSERT ... ON DUPLICATE KEY UPDATE 文で保存する
+func upsertPlaceRankingScores(ctx context.Context, exec boil.ContextExecutor, scores []models.PlaceRankingScore) error {
+	if len(scores) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(scores))
+	args := make([]interface{}, 0, len(scores)*4)
+	for _, score := range scores {
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, score.PlaceId, score.Score, score.LikeCount, score.SavedPlanCount)
+	}
+
+	query := fmt.Sprintf(
+		`INSERT INTO %s (%s, %s, %s, %s)
+VALUES %s
+ON DUPLICATE KEY UPDATE %s = VALUES(%s), %s = VALUES(%s), %s = VALUES(%s)`,
+		generated.TableNames.PlaceRankingScores,
+		generated.PlaceRankingScoreColumns.PlaceID,
+		generated.PlaceRankingScoreColumns.Score,
+		generated.PlaceRankingScoreColumns.LikeCount,
+		generated.PlaceRankingScoreColumns.SavedPlanCount,
+		strings.Join(placeholders, ", "),
+		generated.PlaceRankingScoreColumns.Score, generated.PlaceRankingScoreColumns.Score,
+		generated.PlaceRankingScoreColumns.LikeCount, generated.PlaceRankingScoreColumns.LikeCount,
+		generated.PlaceRankingScoreColumns.SavedPlanCount, generated.PlaceRankingScoreColumns.SavedPlanCount,
+	)
+
+	if _, err := queries.Raw(query, args...).ExecContext(ctx, exec); err != nil {
+		return fmt.Errorf("failed to upsert place ranking scores: %w", err)
+	}
+
+	return nil
+}
+
+// countAllPlaceSavedPlanCounts は場所が保存されたプランに含まれている回数を、場所ごとに集計する
+func countAllPlaceSavedPlanCounts(ctx context.Context, exec boil.ContextExecutor) (map[string]int, error) {
+	return countPlaceSavedPlanCounts(ctx, exec, nil)
+}
+
+// countPlaceSavedPlanCounts は場所が保存されたプランに含まれている回数を、場所ごとに集計する
+// placeIds が指定されている場合は、その場所のみを集計する
+func countPlaceSavedPlanCounts(ctx context.Context, exec boil.ContextExecutor, placeIds []string) (map[string]int, error) {
+	var where string
+	args := make([]interface{}, 0, len(placeIds))
+	if len(placeIds) > 0 {
+		placeholders := make([]string, 0, len(placeIds))
+		for _, placeId := range placeIds {
+			placeholders = append(placeholders, "?")
+			args = append(args, placeId)
+		}
+		where = fmt.Sprintf("WHERE %s IN (%s)", generated.PlanPlaceColumns.PlaceID, strings.Join(placeholders, ", "))
+	}
+
+	query := fmt.Sprintf(
+		`SELECT %s AS %s, COUNT(DISTINCT %s) AS %s
+FROM %s
+%s
+GROUP BY %s`,
+		generated.PlanPlaceColumns.PlaceID,
+		entities.PlaceSavedPlanCountColumns.PlaceId,
+		generated.PlanPlaceColumns.PlanID,
+		entities.PlaceSavedPlanCountColumns.SavedPlanCount,
+		generated.TableNames.PlanPlaces,
+		where,
+		generated.PlanPlaceColumns.PlaceID,
+	)
+
+	var placeSavedPlanCounts []entities.PlaceSavedPlanCount
+	if err := queries.Raw(query, args...).Bind(ctx, exec, &placeSavedPlanCounts); err != nil {
+		return nil, fmt.Errorf("failed to count place saved plan counts: %w", err)
+	}
+
+	savedPlanCounts := make(map[string]int, len(placeSavedPlanCounts))
+	for _, placeSavedPlanCount := range placeSavedPlanCounts {
+		savedPlanCounts[placeSavedPlanCount.PlaceId] = placeSavedPlanCount.SavedPlanCount
+	}
+	return savedPlanCounts, nil
+}
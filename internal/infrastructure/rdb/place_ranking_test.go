@@ -0,0 +1,181 @@
+package rdb
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+	"testing"
+	"time"
+)
+
+func TestPlaceRepository_FindRankingSignals(t *testing.T) {
+	cases := []struct {
+		name                                   string
+		savedPlaces                            []models.Place
+		savedUsers                             generated.UserSlice
+		savedPlans                             []models.Plan
+		savedPlanCandidateSets                 generated.PlanCandidateSetSlice
+		savedPlanCandidateSetLikePlaceEntities generated.PlanCandidateSetLikePlaceSlice
+		savedUserLikePlaceEntities             generated.UserLikePlaceSlice
+		expected                               []models.PlaceRankingSignals
+	}{
+		{
+			name: "should count likes and saved plans of each place",
+			savedPlaces: []models.Place{
+				{Id: "test-place-1", Google: models.GooglePlace{PlaceId: "test-google-place-1", Rating: 4.5, UserRatingsTotal: 100}},
+				{Id: "test-place-2", Google: models.GooglePlace{PlaceId: "test-google-place-2", Rating: 3.0, UserRatingsTotal: 10}},
+			},
+			savedUsers: generated.UserSlice{
+				{ID: "test-user-1", FirebaseUID: uuid.New().String()},
+			},
+			savedPlans: []models.Plan{
+				{Id: "test-plan-1", Name: "plan 1", Places: []models.Place{{Id: "test-place-1"}, {Id: "test-place-2"}}},
+				{Id: "test-plan-2", Name: "plan 2", Places: []models.Place{{Id: "test-place-1"}}},
+			},
+			savedPlanCandidateSets: generated.PlanCandidateSetSlice{
+				{ID: "test-plan-candidate-set-1", ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local)},
+			},
+			savedPlanCandidateSetLikePlaceEntities: generated.PlanCandidateSetLikePlaceSlice{
+				{ID: uuid.New().String(), PlanCandidateSetID: "test-plan-candidate-set-1", PlaceID: "test-place-1"},
+			},
+			savedUserLikePlaceEntities: generated.UserLikePlaceSlice{
+				{ID: uuid.New().String(), UserID: "test-user-1", PlaceID: "test-place-1"},
+			},
+			expected: []models.PlaceRankingSignals{
+				{PlaceId: "test-place-1", GoogleRating: 4.5, GoogleUserRatingsTotal: 100, LikeCount: 2, SavedPlanCount: 2},
+				{PlaceId: "test-place-2", GoogleRating: 3.0, GoogleUserRatingsTotal: 10, LikeCount: 0, SavedPlanCount: 1},
+			},
+		},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Errorf("error initializing place repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, placeRepository.GetDB()); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if _, err := c.savedUsers.InsertAll(testContext, placeRepository.GetDB(), boil.Infer()); err != nil {
+				t.Errorf("error saving user: %v", err)
+			}
+
+			if err := savePlaces(testContext, placeRepository.GetDB(), c.savedPlaces); err != nil {
+				t.Errorf("error saving places: %v", err)
+			}
+
+			if err := savePlans(testContext, placeRepository.GetDB(), c.savedPlans); err != nil {
+				t.Errorf("error saving plans: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidateSets.InsertAll(testContext, placeRepository.GetDB(), boil.Infer()); err != nil {
+				t.Errorf("error saving plan candidate set: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidateSetLikePlaceEntities.InsertAll(testContext, placeRepository.GetDB(), boil.Infer()); err != nil {
+				t.Errorf("error saving plan candidate set like place: %v", err)
+			}
+
+			if _, err := c.savedUserLikePlaceEntities.InsertAll(testContext, placeRepository.GetDB(), boil.Infer()); err != nil {
+				t.Errorf("error saving user like place: %v", err)
+			}
+
+			actual, err := placeRepository.FindRankingSignals(testContext)
+			if err != nil {
+				t.Fatalf("error finding ranking signals: %v", err)
+			}
+
+			sortSignals := cmpopts.SortSlices(func(a, b models.PlaceRankingSignals) bool { return a.PlaceId < b.PlaceId })
+			if diff := cmp.Diff(c.expected, actual, sortSignals); diff != "" {
+				t.Errorf("ranking signals mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlaceRepository_SaveRankingScores(t *testing.T) {
+	cases := []struct {
+		name        string
+		savedPlaces []models.Place
+		savedScores []models.PlaceRankingScore
+		scores      []models.PlaceRankingScore
+		placeIds    []string
+		expected    map[string]float64
+	}{
+		{
+			name: "should save ranking scores",
+			savedPlaces: []models.Place{
+				{Id: "test-place-1"},
+				{Id: "test-place-2"},
+			},
+			scores: []models.PlaceRankingScore{
+				{PlaceId: "test-place-1", Score: 1.2, LikeCount: 3, SavedPlanCount: 1},
+			},
+			placeIds: []string{"test-place-1", "test-place-2"},
+			expected: map[string]float64{"test-place-1": 1.2},
+		},
+		{
+			name: "should update ranking scores already saved",
+			savedPlaces: []models.Place{
+				{Id: "test-place-1"},
+			},
+			savedScores: []models.PlaceRankingScore{
+				{PlaceId: "test-place-1", Score: 0.5},
+			},
+			scores: []models.PlaceRankingScore{
+				{PlaceId: "test-place-1", Score: 0.8, LikeCount: 1},
+			},
+			placeIds: []string{"test-place-1"},
+			expected: map[string]float64{"test-place-1": 0.8},
+		},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Errorf("error initializing place repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, placeRepository.GetDB()); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if err := savePlaces(testContext, placeRepository.GetDB(), c.savedPlaces); err != nil {
+				t.Errorf("error saving places: %v", err)
+			}
+
+			if err := placeRepository.SaveRankingScores(testContext, c.savedScores); err != nil {
+				t.Errorf("error saving ranking scores: %v", err)
+			}
+
+			if err := placeRepository.SaveRankingScores(testContext, c.scores); err != nil {
+				t.Fatalf("error saving ranking scores: %v", err)
+			}
+
+			actual, err := placeRepository.FindRankingScoresByPlaceIds(testContext, c.placeIds)
+			if err != nil {
+				t.Fatalf("error finding ranking scores: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("ranking scores mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
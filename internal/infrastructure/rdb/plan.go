@@ -57,31 +57,36 @@ func (p PlanRepository) Save(ctx context.Context, plan *models.Plan) error {
 	}
 
 	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
-		planEntity := factory.NewPlanEntityFromDomainModel(*plan)
-		if err := planEntity.Insert(ctx, tx, boil.Infer()); err != nil {
-			return fmt.Errorf("failed to insert plan: %w", err)
-		}
+		return savePlan(ctx, tx, *plan)
+	}); err != nil {
+		return err
+	}
 
-		planPlaceSlice := factory.NewPlanPlaceSliceFromDomainMode(plan.Places, plan.Id)
-		if _, err := planPlaceSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
-			return fmt.Errorf("failed to insert plan places: %w", err)
-		}
+	return nil
+}
 
-		if plan.ParentPlanId != nil {
-			planParentChild := generated.PlanParentChild{
-				ID:           uuid.New().String(),
-				ParentPlanID: *plan.ParentPlanId,
-				ChildPlanID:  plan.Id,
-			}
+// savePlan プランとプランに含まれる場所をトランザクション内で保存する
+func savePlan(ctx context.Context, tx *sql.Tx, plan models.Plan) error {
+	planEntity := factory.NewPlanEntityFromDomainModel(plan)
+	if err := planEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert plan: %w", err)
+	}
 
-			if err := planParentChild.Insert(ctx, tx, boil.Infer()); err != nil {
-				return fmt.Errorf("failed to insert plan parent child: %w", err)
-			}
+	planPlaceSlice := factory.NewPlanPlaceSliceFromDomainMode(plan.Places, plan.Id)
+	if _, err := planPlaceSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert plan places: %w", err)
+	}
+
+	if plan.ParentPlanId != nil {
+		planParentChild := generated.PlanParentChild{
+			ID:           uuid.New().String(),
+			ParentPlanID: *plan.ParentPlanId,
+			ChildPlanID:  plan.Id,
 		}
 
-		return nil
-	}); err != nil {
-		return err
+		if err := planParentChild.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert plan parent child: %w", err)
+		}
 	}
 
 	return nil
@@ -90,6 +95,7 @@ func (p PlanRepository) Save(ctx context.Context, plan *models.Plan) error {
 // TODO: QueryCursor をこの関数内で生成する
 func (p PlanRepository) SortedByCreatedAt(ctx context.Context, queryCursor *repository.SortedByCreatedAtQueryCursor, limit int) (*[]models.Plan, *repository.SortedByCreatedAtQueryCursor, error) {
 	planQueryMod := []qm.QueryMod{
+		generated.PlanWhere.Visibility.EQ(string(models.PlanVisibilityPublic)),
 		qm.Load(generated.PlanRels.PlanPlaces),
 		qm.OrderBy(fmt.Sprintf("%s %s, %s %s", generated.PlanColumns.CreatedAt, "desc", generated.PlanColumns.ID, "desc")),
 		qm.Limit(limit),
@@ -192,10 +198,11 @@ func (p PlanRepository) SortedByCreatedAt(ctx context.Context, queryCursor *repo
 	return plans, nextQueryCursor, nil
 }
 
-func (p PlanRepository) Find(ctx context.Context, planId string) (*models.Plan, error) {
+func (p PlanRepository) Find(ctx context.Context, planId string, viewerId *string) (*models.Plan, error) {
 	planEntity, err := generated.Plans(concatQueryMod(
 		[]qm.QueryMod{
 			generated.PlanWhere.ID.EQ(planId),
+			planVisibleTo(viewerId),
 			qm.Load(generated.PlanRels.PlanPlaces),
 			qm.Load(generated.PlanRels.User),
 		},
@@ -265,14 +272,31 @@ func (p PlanRepository) Find(ctx context.Context, planId string) (*models.Plan,
 	return plan, nil
 }
 
-func (p PlanRepository) FindByAuthorId(ctx context.Context, authorId string) (*[]models.Plan, error) {
+func (p PlanRepository) FindByAuthorId(ctx context.Context, authorId string, publicOnly bool) (*[]models.Plan, error) {
+	var queryMods []qm.QueryMod
+	if publicOnly {
+		queryMods = append(queryMods, generated.PlanWhere.Visibility.EQ(string(models.PlanVisibilityPublic)))
+	}
+
+	return p.findByAuthorId(ctx, authorId, queryMods...)
+}
+
+func (p PlanRepository) FindRecentByAuthorId(ctx context.Context, authorId string, limit int) (*[]models.Plan, error) {
+	return p.findByAuthorId(ctx, authorId, qm.Limit(limit))
+}
+
+// findByAuthorId は作者のプランを作成日時の降順で取得する
+func (p PlanRepository) findByAuthorId(ctx context.Context, authorId string, queryMods ...qm.QueryMod) (*[]models.Plan, error) {
+	planQueryMod := []qm.QueryMod{
+		generated.PlanWhere.UserID.EQ(null.StringFrom(authorId)),
+		qm.Load(generated.PlanRels.PlanPlaces),
+		qm.OrderBy(fmt.Sprintf("%s %s", generated.PlanColumns.CreatedAt, "desc")),
+		qm.Load(generated.PlanRels.User),
+	}
+	planQueryMod = append(planQueryMod, queryMods...)
+
 	planEntities, err := generated.Plans(concatQueryMod(
-		[]qm.QueryMod{
-			generated.PlanWhere.UserID.EQ(null.StringFrom(authorId)),
-			qm.Load(generated.PlanRels.PlanPlaces),
-			qm.OrderBy(fmt.Sprintf("%s %s", generated.PlanColumns.CreatedAt, "desc")),
-			qm.Load(generated.PlanRels.User),
-		},
+		planQueryMod,
 		placeQueryModes(generated.PlanRels.PlanPlaces, generated.PlanPlaceRels.Place),
 	)...).All(ctx, p.db)
 	if err != nil {
@@ -352,14 +376,10 @@ func (p PlanRepository) FindByAuthorId(ctx context.Context, authorId string) (*[
 }
 
 func (p PlanRepository) FindByLocation(ctx context.Context, location models.GeoLocation, limit int, searchRange int) (*[]models.Plan, *string, error) {
-	minLocation, maxLocation := location.CalculateMBR(float64(searchRange))
-
 	planEntities, err := generated.Plans(concatQueryMod(
 		[]qm.QueryMod{
-			generated.PlanWhere.Longitude.GT(minLocation.Longitude),
-			generated.PlanWhere.Longitude.LT(maxLocation.Longitude),
-			generated.PlanWhere.Latitude.GT(minLocation.Latitude),
-			generated.PlanWhere.Latitude.LT(maxLocation.Latitude),
+			planWithinRadiusQueryMod(location, float64(searchRange)),
+			generated.PlanWhere.Visibility.EQ(string(models.PlanVisibilityPublic)),
 			qm.OrderBy(fmt.Sprintf("%s %s", generated.PlanColumns.CreatedAt, "desc")),
 			qm.Limit(limit),
 			qm.Load(generated.PlanRels.PlanPlaces),
@@ -604,3 +624,16 @@ func parseSortByCreatedAtQueryCursor(queryCursor repository.SortedByCreatedAtQue
 	dateTime := time.Unix(unixTime, 0)
 	return &dateTime, nil
 }
+
+// planVisibleTo viewerId のユーザーが閲覧できるプランのみに絞り込む
+// 非公開のプランは作者のみが閲覧できる
+func planVisibleTo(viewerId *string) qm.QueryMod {
+	if viewerId == nil {
+		return generated.PlanWhere.Visibility.NEQ(string(models.PlanVisibilityPrivate))
+	}
+
+	return qm.Expr(
+		generated.PlanWhere.Visibility.NEQ(string(models.PlanVisibilityPrivate)),
+		qm.Or2(generated.PlanWhere.UserID.EQ(null.StringFrom(*viewerId))),
+	)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"go.uber.org/zap"
@@ -249,6 +250,11 @@ func (p PlanCandidateRepository) AddPlan(ctx context.Context, planCandidateId st
 
 func (p PlanCandidateRepository) AddPlaceToPlan(ctx context.Context, planCandidateId string, planId string, previousPlaceId string, place models.Place) error {
 	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		placeIdsBefore, err := findPlaceIdsOfPlanCandidate(ctx, tx, planCandidateId, planId)
+		if err != nil {
+			return fmt.Errorf("failed to get places of plan candidate: %w", err)
+		}
+
 		planCandidatePlaceSlice, err := generated.
 			PlanCandidatePlaces(generated.PlanCandidatePlaceWhere.PlanCandidateSetID.EQ(planCandidateId)).
 			All(ctx, tx)
@@ -275,6 +281,10 @@ func (p PlanCandidateRepository) AddPlaceToPlan(ctx context.Context, planCandida
 			return fmt.Errorf("failed to insert plan candidate place: %w", err)
 		}
 
+		if err := addPlanCandidateEditHistory(ctx, tx, planCandidateId, planId, models.PlanCandidateEditTypeAddPlace, placeIdsBefore); err != nil {
+			return fmt.Errorf("failed to add plan candidate edit history: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("failed to run transaction: %w", err)
@@ -285,6 +295,11 @@ func (p PlanCandidateRepository) AddPlaceToPlan(ctx context.Context, planCandida
 
 func (p PlanCandidateRepository) RemovePlaceFromPlan(ctx context.Context, planCandidateId string, planId string, placeId string) error {
 	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		placeIdsBefore, err := findPlaceIdsOfPlanCandidate(ctx, tx, planCandidateId, planId)
+		if err != nil {
+			return fmt.Errorf("failed to get places of plan candidate: %w", err)
+		}
+
 		planCandidateEntity, err := generated.PlanCandidates(
 			generated.PlanCandidateWhere.ID.EQ(planId),
 			generated.PlanCandidateWhere.PlanCandidateSetID.EQ(planCandidateId),
@@ -314,6 +329,10 @@ func (p PlanCandidateRepository) RemovePlaceFromPlan(ctx context.Context, planCa
 			return fmt.Errorf("failed to delete plan candidate place: %w", err)
 		}
 
+		if err := addPlanCandidateEditHistory(ctx, tx, planCandidateId, planId, models.PlanCandidateEditTypeRemovePlace, placeIdsBefore); err != nil {
+			return fmt.Errorf("failed to add plan candidate edit history: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("failed to run transaction: %w", err)
@@ -324,6 +343,11 @@ func (p PlanCandidateRepository) RemovePlaceFromPlan(ctx context.Context, planCa
 
 func (p PlanCandidateRepository) UpdatePlacesOrder(ctx context.Context, planId string, planCandidate string, placeIdsOrdered []string) error {
 	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		placeIdsBefore, err := findPlaceIdsOfPlanCandidate(ctx, tx, planCandidate, planId)
+		if err != nil {
+			return fmt.Errorf("failed to get places of plan candidate: %w", err)
+		}
+
 		planCandidateEntity, err := generated.PlanCandidates(
 			generated.PlanCandidateWhere.ID.EQ(planId),
 			generated.PlanCandidateWhere.PlanCandidateSetID.EQ(planCandidate),
@@ -374,6 +398,10 @@ func (p PlanCandidateRepository) UpdatePlacesOrder(ctx context.Context, planId s
 			}
 		}
 
+		if err := addPlanCandidateEditHistory(ctx, tx, planCandidate, planId, models.PlanCandidateEditTypeReorderPlaces, placeIdsBefore); err != nil {
+			return fmt.Errorf("failed to add plan candidate edit history: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("failed to run transaction: %w", err)
@@ -465,6 +493,11 @@ func (p PlanCandidateRepository) UpdateIsPlaceSearched(ctx context.Context, plan
 
 func (p PlanCandidateRepository) ReplacePlace(ctx context.Context, planCandidateId string, planId string, placeIdToBeReplaced string, placeToReplace models.Place) error {
 	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		placeIdsBefore, err := findPlaceIdsOfPlanCandidate(ctx, tx, planCandidateId, planId)
+		if err != nil {
+			return fmt.Errorf("failed to get places of plan candidate: %w", err)
+		}
+
 		planCandidatePlaceEntity, err := generated.PlanCandidatePlaces(
 			generated.PlanCandidatePlaceWhere.PlanCandidateSetID.EQ(planCandidateId),
 			generated.PlanCandidatePlaceWhere.PlanCandidateID.EQ(planId),
@@ -480,6 +513,10 @@ func (p PlanCandidateRepository) ReplacePlace(ctx context.Context, planCandidate
 			return fmt.Errorf("failed to update plan candidate place: %w", err)
 		}
 
+		if err := addPlanCandidateEditHistory(ctx, tx, planCandidateId, planId, models.PlanCandidateEditTypeReplacePlace, placeIdsBefore); err != nil {
+			return fmt.Errorf("failed to add plan candidate edit history: %w", err)
+		}
+
 		return nil
 	}); err != nil {
 		return fmt.Errorf("failed to run transaction: %w", err)
@@ -528,3 +565,52 @@ func (p PlanCandidateRepository) UpdateLikeToPlaceInPlanCandidateSet(ctx context
 
 	return nil
 }
+
+func (p PlanCandidateRepository) FindCategoriesRejectedByUserId(ctx context.Context, userId string) ([]models.LocationCategory, error) {
+	categoryEntities, err := generated.PlanCandidateSetMetaDataCategories(
+		qm.Select(fmt.Sprintf(
+			"distinct %s.%s, %s.%s",
+			generated.TableNames.PlanCandidateSetMetaDataCategories,
+			generated.PlanCandidateSetMetaDataCategoryColumns.PlanCandidateSetID,
+			generated.TableNames.PlanCandidateSetMetaDataCategories,
+			generated.PlanCandidateSetMetaDataCategoryColumns.Category,
+		)),
+		qm.InnerJoin(fmt.Sprintf(
+			"%s on %s.%s = %s.%s",
+			generated.TableNames.PlanCandidates,
+			generated.TableNames.PlanCandidates,
+			generated.PlanCandidateColumns.PlanCandidateSetID,
+			generated.TableNames.PlanCandidateSetMetaDataCategories,
+			generated.PlanCandidateSetMetaDataCategoryColumns.PlanCandidateSetID,
+		)),
+		qm.InnerJoin(fmt.Sprintf(
+			"%s on %s.%s = %s.%s",
+			generated.TableNames.Plans,
+			generated.TableNames.Plans,
+			generated.PlanColumns.ID,
+			generated.TableNames.PlanCandidates,
+			generated.PlanCandidateColumns.ID,
+		)),
+		generated.PlanWhere.UserID.EQ(null.StringFrom(userId)),
+		generated.PlanCandidateSetMetaDataCategoryWhere.IsSelected.EQ(false),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plan candidate set meta data categories: %w", err)
+	}
+
+	categories := make([]models.LocationCategory, 0, len(categoryEntities))
+	for _, categoryEntity := range categoryEntities {
+		if categoryEntity == nil {
+			continue
+		}
+
+		category := models.GetCategoryOfName(categoryEntity.Category)
+		if category == nil {
+			continue
+		}
+
+		categories = append(categories, *category)
+	}
+
+	return categories, nil
+}
@@ -0,0 +1,147 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func (p PlanCandidateRepository) BindToUser(ctx context.Context, userId string, planCandidateSetIds []string) error {
+	if _, err := generated.PlanCandidateSets(
+		generated.PlanCandidateSetWhere.ID.IN(planCandidateSetIds),
+		generated.PlanCandidateSetWhere.UserID.IsNull(),
+	).UpdateAll(ctx, p.db, generated.M{
+		generated.PlanCandidateSetColumns.UserID: null.StringFrom(userId),
+	}); err != nil {
+		return fmt.Errorf("failed to bind plan candidate sets to user: %w", err)
+	}
+
+	return nil
+}
+
+func (p PlanCandidateRepository) DeleteExpired(ctx context.Context, expiredBefore time.Time, limit int) (int, error) {
+	var deletedCount int
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		// 削除している間にユーザーへ紐付けられないように、削除するプラン候補をロックする
+		planCandidateSetEntities, err := generated.PlanCandidateSets(
+			qm.Select(generated.PlanCandidateSetColumns.ID),
+			generated.PlanCandidateSetWhere.ExpiresAt.LT(expiredBefore),
+			deletablePlanCandidateSetQueryMod(),
+			qm.OrderBy(generated.PlanCandidateSetColumns.ExpiresAt),
+			qm.Limit(limit),
+			qm.For("UPDATE SKIP LOCKED"),
+		).All(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to find expired plan candidate sets: %w", err)
+		}
+
+		if len(planCandidateSetEntities) == 0 {
+			return nil
+		}
+
+		planCandidateSetIds := array.Map(planCandidateSetEntities, func(planCandidateSetEntity *generated.PlanCandidateSet) string {
+			return planCandidateSetEntity.ID
+		})
+
+		// 外部キー制約があるため、プラン候補を参照しているものから順に削除する
+		tables := []interface {
+			DeleteAll(context.Context, boil.ContextExecutor) (int64, error)
+		}{
+			generated.PlanCandidateSetPlaceVotes(generated.PlanCandidateSetPlaceVoteWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetPlanVotes(generated.PlanCandidateSetPlanVoteWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetParticipants(generated.PlanCandidateSetParticipantWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetGroups(generated.PlanCandidateSetGroupWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateEditHistories(generated.PlanCandidateEditHistoryWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetLikePlaces(generated.PlanCandidateSetLikePlaceWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidatePlaces(generated.PlanCandidatePlaceWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetMetaDataCreateByCategories(generated.PlanCandidateSetMetaDataCreateByCategoryWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetMetaDataCategories(generated.PlanCandidateSetMetaDataCategoryWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidateSetMetaData(generated.PlanCandidateSetMetaDatumWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+			generated.PlanCandidates(generated.PlanCandidateWhere.PlanCandidateSetID.IN(planCandidateSetIds)),
+		}
+		for _, table := range tables {
+			if _, err := table.DeleteAll(ctx, tx); err != nil {
+				return fmt.Errorf("failed to delete rows related to expired plan candidate sets: %w", err)
+			}
+		}
+
+		rowsAffected, err := generated.PlanCandidateSets(generated.PlanCandidateSetWhere.ID.IN(planCandidateSetIds)).DeleteAll(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to delete expired plan candidate sets: %w", err)
+		}
+
+		deletedCount = int(rowsAffected)
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return deletedCount, nil
+}
+
+func (p PlanCandidateRepository) CountExpiredRetained(ctx context.Context, expiredBefore time.Time) (int, error) {
+	count, err := generated.PlanCandidateSets(
+		generated.PlanCandidateSetWhere.ExpiresAt.LT(expiredBefore),
+		retainedPlanCandidateSetQueryMod(),
+	).Count(ctx, p.db)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count retained expired plan candidate sets: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// deletablePlanCandidateSetQueryMod はユーザーに紐付けられておらず、プランとして保存されておらず、
+// ログインしたユーザーがグループに参加していないプラン候補に絞り込む
+func deletablePlanCandidateSetQueryMod() qm.QueryMod {
+	return qm.Expr(
+		generated.PlanCandidateSetWhere.UserID.IsNull(),
+		qm.Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %s INNER JOIN %s ON %s = %s WHERE %s = %s)",
+			generated.TableNames.PlanCandidates,
+			generated.TableNames.Plans,
+			generated.PlanTableColumns.ID,
+			generated.PlanCandidateTableColumns.ID,
+			generated.PlanCandidateTableColumns.PlanCandidateSetID,
+			generated.PlanCandidateSetTableColumns.ID,
+		)),
+		qm.Where(fmt.Sprintf(
+			"NOT EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s IS NOT NULL)",
+			generated.TableNames.PlanCandidateSetParticipants,
+			generated.PlanCandidateSetParticipantTableColumns.PlanCandidateSetID,
+			generated.PlanCandidateSetTableColumns.ID,
+			generated.PlanCandidateSetParticipantTableColumns.UserID,
+		)),
+	)
+}
+
+// retainedPlanCandidateSetQueryMod は deletablePlanCandidateSetQueryMod の逆で、
+// ユーザーに紐付けられている・プランとして保存されている・ログインしたユーザーがグループに参加しているプラン候補に絞り込む
+func retainedPlanCandidateSetQueryMod() qm.QueryMod {
+	return qm.Expr(
+		generated.PlanCandidateSetWhere.UserID.IsNotNull(),
+		qm.Or(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s INNER JOIN %s ON %s = %s WHERE %s = %s)",
+			generated.TableNames.PlanCandidates,
+			generated.TableNames.Plans,
+			generated.PlanTableColumns.ID,
+			generated.PlanCandidateTableColumns.ID,
+			generated.PlanCandidateTableColumns.PlanCandidateSetID,
+			generated.PlanCandidateSetTableColumns.ID,
+		)),
+		qm.Or(fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM %s WHERE %s = %s AND %s IS NOT NULL)",
+			generated.TableNames.PlanCandidateSetParticipants,
+			generated.PlanCandidateSetParticipantTableColumns.PlanCandidateSetID,
+			generated.PlanCandidateSetTableColumns.ID,
+			generated.PlanCandidateSetParticipantTableColumns.UserID,
+		)),
+	)
+}
@@ -0,0 +1,150 @@
+package rdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func TestPlanCandidateRepository_DeleteExpired(t *testing.T) {
+	now := time.Date(2024, 7, 26, 0, 0, 0, 0, time.Local)
+	savedPlaces := []models.Place{{Id: "place"}}
+
+	cases := []struct {
+		name                  string
+		savedPlanCandidateSet models.PlanCandidateSet
+		boundUserId           *string
+		savedPlanId           *string
+		expectedDeletedCount  int
+		expectedRetainedCount int
+	}{
+		{
+			name: "expired plan candidate set is deleted",
+			savedPlanCandidateSet: models.PlanCandidateSet{
+				Id:        "plan-candidate-set",
+				ExpiresAt: now.Add(-time.Hour),
+				Plans:     []models.Plan{{Id: "plan-candidate", Places: savedPlaces}},
+			},
+			expectedDeletedCount:  1,
+			expectedRetainedCount: 0,
+		},
+		{
+			name: "plan candidate set not expired is not deleted",
+			savedPlanCandidateSet: models.PlanCandidateSet{
+				Id:        "plan-candidate-set",
+				ExpiresAt: now.Add(time.Hour),
+				Plans:     []models.Plan{{Id: "plan-candidate", Places: savedPlaces}},
+			},
+			expectedDeletedCount:  0,
+			expectedRetainedCount: 0,
+		},
+		{
+			name: "plan candidate set bound to user is not deleted",
+			savedPlanCandidateSet: models.PlanCandidateSet{
+				Id:        "plan-candidate-set",
+				ExpiresAt: now.Add(-time.Hour),
+				Plans:     []models.Plan{{Id: "plan-candidate", Places: savedPlaces}},
+			},
+			boundUserId:           utils.StrPointer("user"),
+			expectedDeletedCount:  0,
+			expectedRetainedCount: 1,
+		},
+		{
+			name: "plan candidate set saved as plan is not deleted",
+			savedPlanCandidateSet: models.PlanCandidateSet{
+				Id:        "plan-candidate-set",
+				ExpiresAt: now.Add(-time.Hour),
+				Plans:     []models.Plan{{Id: "plan-candidate", Places: savedPlaces}},
+			},
+			savedPlanId:           utils.StrPointer("plan-candidate"),
+			expectedDeletedCount:  0,
+			expectedRetainedCount: 1,
+		},
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	for _, c := range cases {
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("failed to cleanup: %v", err)
+				}
+			})
+
+			if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+				t.Fatalf("failed to save places: %v", err)
+			}
+
+			if err := savePlanCandidateSet(testContext, testDB, c.savedPlanCandidateSet); err != nil {
+				t.Fatalf("failed to save plan candidate set: %v", err)
+			}
+
+			if c.boundUserId != nil {
+				userEntity := generated.User{ID: *c.boundUserId, FirebaseUID: *c.boundUserId}
+				if err := userEntity.Insert(testContext, testDB, boil.Infer()); err != nil {
+					t.Fatalf("failed to insert user: %v", err)
+				}
+
+				if err := planCandidateRepository.BindToUser(testContext, *c.boundUserId, []string{c.savedPlanCandidateSet.Id}); err != nil {
+					t.Fatalf("failed to bind plan candidate set to user: %v", err)
+				}
+			}
+
+			if c.savedPlanId != nil {
+				if err := savePlans(testContext, testDB, []models.Plan{{Id: *c.savedPlanId, Places: savedPlaces}}); err != nil {
+					t.Fatalf("failed to save plan: %v", err)
+				}
+			}
+
+			deletedCount, err := planCandidateRepository.DeleteExpired(testContext, now, 10)
+			if err != nil {
+				t.Fatalf("failed to delete expired plan candidate sets: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedDeletedCount, deletedCount); diff != "" {
+				t.Errorf("deleted count mismatch (-want +got):\n%s", diff)
+			}
+
+			retainedCount, err := planCandidateRepository.CountExpiredRetained(testContext, now)
+			if err != nil {
+				t.Fatalf("failed to count retained plan candidate sets: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedRetainedCount, retainedCount); diff != "" {
+				t.Errorf("retained count mismatch (-want +got):\n%s", diff)
+			}
+
+			// 削除された場合は、関連するデータも残っていない
+			planCandidatePlaceEntities, err := generated.PlanCandidatePlaces(
+				generated.PlanCandidatePlaceWhere.PlanCandidateSetID.EQ(c.savedPlanCandidateSet.Id),
+				qm.Select(generated.PlanCandidatePlaceColumns.PlaceID),
+			).All(testContext, testDB)
+			if err != nil {
+				t.Fatalf("failed to find plan candidate places: %v", err)
+			}
+
+			expectedPlaceIds := []string{"place"}
+			if c.expectedDeletedCount > 0 {
+				expectedPlaceIds = nil
+			}
+			if diff := cmp.Diff(expectedPlaceIds, array.Map(planCandidatePlaceEntities, func(planCandidatePlace *generated.PlanCandidatePlace) string {
+				return planCandidatePlace.PlaceID
+			})); diff != "" {
+				t.Errorf("plan candidate places mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func (p PlanCandidateRepository) FindEditHistories(ctx context.Context, planCandidateSetId string) (models.PlanCandidateEditHistories, error) {
+	historyEntities, err := generated.PlanCandidateEditHistories(
+		generated.PlanCandidateEditHistoryWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+		qm.OrderBy(generated.PlanCandidateEditHistoryColumns.SortOrder),
+	).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan candidate edit histories: %w", err)
+	}
+
+	histories := make(models.PlanCandidateEditHistories, 0, len(historyEntities))
+	for _, historyEntity := range historyEntities {
+		if historyEntity == nil {
+			continue
+		}
+
+		history, err := factory.NewPlanCandidateEditHistoryFromEntity(*historyEntity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert plan candidate edit history: %w", err)
+		}
+		histories = append(histories, *history)
+	}
+
+	return histories, nil
+}
+
+func (p PlanCandidateRepository) UndoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error) {
+	return p.applyEditHistory(ctx, planCandidateSetId, true)
+}
+
+func (p PlanCandidateRepository) RedoEdit(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateEditHistory, error) {
+	return p.applyEditHistory(ctx, planCandidateSetId, false)
+}
+
+// applyEditHistory は undo が true の場合は最新の編集を取り消し、false の場合は取り消した編集をやり直す
+func (p PlanCandidateRepository) applyEditHistory(ctx context.Context, planCandidateSetId string, undo bool) (*models.PlanCandidateEditHistory, error) {
+	var historyApplied *models.PlanCandidateEditHistory
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		// 取り消された編集の破棄と競合しないように、編集履歴をロックする
+		historyEntities, err := generated.PlanCandidateEditHistories(
+			generated.PlanCandidateEditHistoryWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+			qm.OrderBy(generated.PlanCandidateEditHistoryColumns.SortOrder),
+			qm.For("UPDATE"),
+		).All(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to get plan candidate edit histories: %w", err)
+		}
+
+		histories := make(models.PlanCandidateEditHistories, 0, len(historyEntities))
+		for _, historyEntity := range historyEntities {
+			history, err := factory.NewPlanCandidateEditHistoryFromEntity(*historyEntity)
+			if err != nil {
+				return fmt.Errorf("failed to convert plan candidate edit history: %w", err)
+			}
+			histories = append(histories, *history)
+		}
+
+		var history *models.PlanCandidateEditHistory
+		var placeIds []string
+		if undo {
+			history = histories.NextToUndo()
+			if history != nil {
+				placeIds = history.PlaceIdsBefore
+			}
+		} else {
+			history = histories.NextToRedo()
+			if history != nil {
+				placeIds = history.PlaceIdsAfter
+			}
+		}
+
+		if history == nil {
+			return nil
+		}
+
+		if err := replacePlacesOfPlanCandidate(ctx, tx, planCandidateSetId, history.PlanId, placeIds); err != nil {
+			return fmt.Errorf("failed to replace places of plan candidate: %w", err)
+		}
+
+		historyEntity, ok := array.Find(historyEntities, func(historyEntity *generated.PlanCandidateEditHistory) bool {
+			return historyEntity.ID == history.Id
+		})
+		if !ok {
+			return fmt.Errorf("plan candidate edit history not found: %s", history.Id)
+		}
+
+		historyEntity.IsUndone = undo
+		if _, err := historyEntity.Update(ctx, tx, boil.Whitelist(generated.PlanCandidateEditHistoryColumns.IsUndone)); err != nil {
+			return fmt.Errorf("failed to update plan candidate edit history: %w", err)
+		}
+
+		history.IsUndone = undo
+		historyApplied = history
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return historyApplied, nil
+}
+
+// addPlanCandidateEditHistory はプランの編集後に呼び出し、編集前後の場所を編集履歴として保存する
+// 新しく編集した時点で、取り消された編集はやり直せなくなるため破棄する
+// 同じプラン候補への編集が同時に行われても表示順が重複しないように、プラン候補の行をロックしてから表示順を求める
+func addPlanCandidateEditHistory(ctx context.Context, tx *sql.Tx, planCandidateSetId string, planId string, editType models.PlanCandidateEditType, placeIdsBefore []string) error {
+	if _, err := generated.PlanCandidateSets(
+		generated.PlanCandidateSetWhere.ID.EQ(planCandidateSetId),
+		qm.For("UPDATE"),
+	).One(ctx, tx); err != nil {
+		return fmt.Errorf("failed to lock plan candidate set: %w", err)
+	}
+
+	placeIdsAfter, err := findPlaceIdsOfPlanCandidate(ctx, tx, planCandidateSetId, planId)
+	if err != nil {
+		return fmt.Errorf("failed to get places of plan candidate: %w", err)
+	}
+
+	if _, err := generated.PlanCandidateEditHistories(
+		generated.PlanCandidateEditHistoryWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+		generated.PlanCandidateEditHistoryWhere.IsUndone.EQ(true),
+	).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete undone plan candidate edit histories: %w", err)
+	}
+
+	sortOrder := 0
+	latestHistoryEntity, err := generated.PlanCandidateEditHistories(
+		generated.PlanCandidateEditHistoryWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+		qm.OrderBy(generated.PlanCandidateEditHistoryColumns.SortOrder+" DESC"),
+	).One(ctx, tx)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("failed to get latest plan candidate edit history: %w", err)
+	}
+	if latestHistoryEntity != nil {
+		sortOrder = latestHistoryEntity.SortOrder + 1
+	}
+
+	historyEntity := factory.NewPlanCandidateEditHistoryEntityFromDomainModel(models.PlanCandidateEditHistory{
+		Id:                 uuid.New().String(),
+		PlanCandidateSetId: planCandidateSetId,
+		PlanId:             planId,
+		Type:               editType,
+		PlaceIdsBefore:     placeIdsBefore,
+		PlaceIdsAfter:      placeIdsAfter,
+	}, sortOrder)
+	if err := historyEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert plan candidate edit history: %w", err)
+	}
+
+	return nil
+}
+
+// findPlaceIdsOfPlanCandidate はプランに含まれる場所の ID を順番通りに取得する
+func findPlaceIdsOfPlanCandidate(ctx context.Context, exec boil.ContextExecutor, planCandidateSetId string, planId string) ([]string, error) {
+	planCandidatePlaceEntities, err := generated.PlanCandidatePlaces(
+		generated.PlanCandidatePlaceWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+		generated.PlanCandidatePlaceWhere.PlanCandidateID.EQ(planId),
+		qm.OrderBy(generated.PlanCandidatePlaceColumns.SortOrder),
+	).All(ctx, exec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get plan candidate places: %w", err)
+	}
+
+	return array.Map(planCandidatePlaceEntities, func(planCandidatePlace *generated.PlanCandidatePlace) string {
+		return planCandidatePlace.PlaceID
+	}), nil
+}
+
+// replacePlacesOfPlanCandidate はプランに含まれる場所を placeIds に置き換える
+func replacePlacesOfPlanCandidate(ctx context.Context, tx *sql.Tx, planCandidateSetId string, planId string, placeIds []string) error {
+	if _, err := generated.PlanCandidatePlaces(
+		generated.PlanCandidatePlaceWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+		generated.PlanCandidatePlaceWhere.PlanCandidateID.EQ(planId),
+	).DeleteAll(ctx, tx); err != nil {
+		return fmt.Errorf("failed to delete plan candidate places: %w", err)
+	}
+
+	var planCandidatePlaceSlice generated.PlanCandidatePlaceSlice
+	for i, placeId := range placeIds {
+		planCandidatePlaceEntity := factory.NewPlanCandidatePlaceEntityFromDomainModel(models.Place{Id: placeId}, i, planCandidateSetId, planId)
+		planCandidatePlaceSlice = append(planCandidatePlaceSlice, &planCandidatePlaceEntity)
+	}
+
+	if _, err := planCandidatePlaceSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert plan candidate places: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package rdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+)
+
+func TestPlanCandidateRepository_UndoEdit_RedoEdit(t *testing.T) {
+	cases := []struct {
+		name                    string
+		planCandidateSetId      string
+		planCandidateId         string
+		savedPlanCandidateSet   models.PlanCandidateSet
+		placeToAdd              models.Place
+		placeIdsOrdered         []string
+		expectedAfterEdit       []string
+		expectedAfterFirstUndo  []string
+		expectedAfterSecondUndo []string
+		expectedAfterRedo       []string
+	}{
+		{
+			name:               "undo and redo add place and reorder places",
+			planCandidateSetId: "test-plan-candidate-set",
+			planCandidateId:    "test-plan-candidate",
+			savedPlanCandidateSet: models.PlanCandidateSet{
+				Id:        "test-plan-candidate-set",
+				ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local),
+				Plans: []models.Plan{
+					{
+						Id: "test-plan-candidate",
+						Places: []models.Place{
+							{Id: "first-place"},
+							{Id: "second-place"},
+						},
+					},
+				},
+			},
+			placeToAdd:              models.Place{Id: "third-place"},
+			placeIdsOrdered:         []string{"third-place", "first-place", "second-place"},
+			expectedAfterEdit:       []string{"third-place", "first-place", "second-place"},
+			expectedAfterFirstUndo:  []string{"first-place", "second-place", "third-place"},
+			expectedAfterSecondUndo: []string{"first-place", "second-place"},
+			expectedAfterRedo:       []string{"first-place", "second-place", "third-place"},
+		},
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	for _, c := range cases {
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				err := cleanup(testContext, testDB)
+				if err != nil {
+					t.Fatalf("failed to cleanup: %v", err)
+				}
+			})
+
+			// 事前にPlaceを作成しておく
+			placesInPlanCandidates := array.Flatten(array.Map(c.savedPlanCandidateSet.Plans, func(plan models.Plan) []models.Place { return plan.Places }))
+			if err := savePlaces(testContext, testDB, append(placesInPlanCandidates, c.placeToAdd)); err != nil {
+				t.Fatalf("failed to save places: %v", err)
+			}
+
+			// 事前にPlanCandidateSetを作成しておく
+			if err := savePlanCandidateSet(testContext, testDB, c.savedPlanCandidateSet); err != nil {
+				t.Fatalf("failed to save plan candidate: %v", err)
+			}
+
+			lastPlace := c.savedPlanCandidateSet.Plans[0].Places[len(c.savedPlanCandidateSet.Plans[0].Places)-1]
+			if err := planCandidateRepository.AddPlaceToPlan(testContext, c.planCandidateSetId, c.planCandidateId, lastPlace.Id, c.placeToAdd); err != nil {
+				t.Fatalf("failed to add place to plan: %v", err)
+			}
+
+			if err := planCandidateRepository.UpdatePlacesOrder(testContext, c.planCandidateId, c.planCandidateSetId, c.placeIdsOrdered); err != nil {
+				t.Fatalf("failed to update places order: %v", err)
+			}
+			assertPlaceIdsOfPlanCandidate(t, testContext, c.planCandidateSetId, c.planCandidateId, c.expectedAfterEdit)
+
+			histories, err := planCandidateRepository.FindEditHistories(testContext, c.planCandidateSetId)
+			if err != nil {
+				t.Fatalf("failed to find edit histories: %v", err)
+			}
+			if diff := cmp.Diff(
+				[]models.PlanCandidateEditType{models.PlanCandidateEditTypeAddPlace, models.PlanCandidateEditTypeReorderPlaces},
+				array.Map(histories, func(history models.PlanCandidateEditHistory) models.PlanCandidateEditType { return history.Type }),
+			); diff != "" {
+				t.Errorf("edit histories mismatch (-want +got):\n%s", diff)
+			}
+
+			if _, err := planCandidateRepository.UndoEdit(testContext, c.planCandidateSetId); err != nil {
+				t.Fatalf("failed to undo edit: %v", err)
+			}
+			assertPlaceIdsOfPlanCandidate(t, testContext, c.planCandidateSetId, c.planCandidateId, c.expectedAfterFirstUndo)
+
+			if _, err := planCandidateRepository.UndoEdit(testContext, c.planCandidateSetId); err != nil {
+				t.Fatalf("failed to undo edit: %v", err)
+			}
+			assertPlaceIdsOfPlanCandidate(t, testContext, c.planCandidateSetId, c.planCandidateId, c.expectedAfterSecondUndo)
+
+			// これ以上取り消せる編集はない
+			historyUndone, err := planCandidateRepository.UndoEdit(testContext, c.planCandidateSetId)
+			if err != nil {
+				t.Fatalf("failed to undo edit: %v", err)
+			}
+			if historyUndone != nil {
+				t.Errorf("expected no edit to undo but got %v", historyUndone)
+			}
+
+			historyRedone, err := planCandidateRepository.RedoEdit(testContext, c.planCandidateSetId)
+			if err != nil {
+				t.Fatalf("failed to redo edit: %v", err)
+			}
+			if historyRedone == nil || historyRedone.Type != models.PlanCandidateEditTypeAddPlace {
+				t.Errorf("expected add place edit to be redone but got %v", historyRedone)
+			}
+			assertPlaceIdsOfPlanCandidate(t, testContext, c.planCandidateSetId, c.planCandidateId, c.expectedAfterRedo)
+		})
+	}
+}
+
+func TestPlanCandidateRepository_AddEditHistory_ShouldDiscardUndoneEdits(t *testing.T) {
+	planCandidateSet := models.PlanCandidateSet{
+		Id:        "test-plan-candidate-set",
+		ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local),
+		Plans: []models.Plan{
+			{
+				Id: "test-plan-candidate",
+				Places: []models.Place{
+					{Id: "first-place"},
+					{Id: "second-place"},
+				},
+			},
+		},
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		err := cleanup(testContext, testDB)
+		if err != nil {
+			t.Fatalf("failed to cleanup: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, planCandidateSet.Plans[0].Places); err != nil {
+		t.Fatalf("failed to save places: %v", err)
+	}
+
+	if err := savePlanCandidateSet(testContext, testDB, planCandidateSet); err != nil {
+		t.Fatalf("failed to save plan candidate: %v", err)
+	}
+
+	if err := planCandidateRepository.UpdatePlacesOrder(testContext, "test-plan-candidate", "test-plan-candidate-set", []string{"second-place", "first-place"}); err != nil {
+		t.Fatalf("failed to update places order: %v", err)
+	}
+
+	if _, err := planCandidateRepository.UndoEdit(testContext, "test-plan-candidate-set"); err != nil {
+		t.Fatalf("failed to undo edit: %v", err)
+	}
+
+	if err := planCandidateRepository.RemovePlaceFromPlan(testContext, "test-plan-candidate-set", "test-plan-candidate", "second-place"); err != nil {
+		t.Fatalf("failed to remove place from plan: %v", err)
+	}
+
+	histories, err := planCandidateRepository.FindEditHistories(testContext, "test-plan-candidate-set")
+	if err != nil {
+		t.Fatalf("failed to find edit histories: %v", err)
+	}
+
+	if diff := cmp.Diff(
+		[]models.PlanCandidateEditType{models.PlanCandidateEditTypeRemovePlace},
+		array.Map(histories, func(history models.PlanCandidateEditHistory) models.PlanCandidateEditType { return history.Type }),
+	); diff != "" {
+		t.Errorf("edit histories mismatch (-want +got):\n%s", diff)
+	}
+
+	historyRedone, err := planCandidateRepository.RedoEdit(testContext, "test-plan-candidate-set")
+	if err != nil {
+		t.Fatalf("failed to redo edit: %v", err)
+	}
+	if historyRedone != nil {
+		t.Errorf("expected no edit to redo but got %v", historyRedone)
+	}
+}
+
+func assertPlaceIdsOfPlanCandidate(t *testing.T, ctx context.Context, planCandidateSetId string, planCandidateId string, expected []string) {
+	t.Helper()
+
+	actual, err := findPlaceIdsOfPlanCandidate(ctx, testDB, planCandidateSetId, planCandidateId)
+	if err != nil {
+		t.Fatalf("failed to get places of plan candidate: %v", err)
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("places of plan candidate mismatch (-want +got):\n%s", diff)
+	}
+}
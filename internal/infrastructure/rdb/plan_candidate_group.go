@@ -0,0 +1,152 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func (p PlanCandidateRepository) CreateGroup(ctx context.Context, planCandidateSetId string, shareToken string) error {
+	// 同時に作成された場合に主キーが重複しないよう、すでにグループがある場合は何もしない
+	query := fmt.Sprintf(
+		"INSERT INTO %s (%s, %s) VALUES (?, ?) ON DUPLICATE KEY UPDATE %s = %s",
+		generated.TableNames.PlanCandidateSetGroups,
+		generated.PlanCandidateSetGroupColumns.PlanCandidateSetID,
+		generated.PlanCandidateSetGroupColumns.ShareToken,
+		generated.PlanCandidateSetGroupColumns.PlanCandidateSetID,
+		generated.PlanCandidateSetGroupColumns.PlanCandidateSetID,
+	)
+	if _, err := queries.Raw(query, planCandidateSetId, shareToken).ExecContext(ctx, p.db); err != nil {
+		return fmt.Errorf("failed to insert plan candidate set group: %w", err)
+	}
+	return nil
+}
+
+func (p PlanCandidateRepository) FindGroup(ctx context.Context, planCandidateSetId string) (*models.PlanCandidateGroup, error) {
+	return p.findGroup(ctx, generated.PlanCandidateSetGroupWhere.PlanCandidateSetID.EQ(planCandidateSetId))
+}
+
+func (p PlanCandidateRepository) FindGroupByShareToken(ctx context.Context, shareToken string) (*models.PlanCandidateGroup, error) {
+	return p.findGroup(ctx, generated.PlanCandidateSetGroupWhere.ShareToken.EQ(shareToken))
+}
+
+func (p PlanCandidateRepository) findGroup(ctx context.Context, queryMod qm.QueryMod) (*models.PlanCandidateGroup, error) {
+	groupEntity, err := generated.PlanCandidateSetGroups(
+		queryMod,
+		qm.Load(generated.PlanCandidateSetGroupRels.PlanCandidateSetPlanCandidateSetParticipants),
+		qm.Load(generated.PlanCandidateSetGroupRels.PlanCandidateSetPlanCandidateSetPlanVotes),
+		qm.Load(generated.PlanCandidateSetGroupRels.PlanCandidateSetPlanCandidateSetPlaceVotes),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find plan candidate set group: %w", err)
+	}
+
+	group := factory.NewPlanCandidateGroupFromEntity(*groupEntity)
+	return &group, nil
+}
+
+func (p PlanCandidateRepository) AddGroupParticipant(ctx context.Context, planCandidateSetId string, participant models.PlanCandidateGroupParticipant) error {
+	participantEntity := factory.NewPlanCandidateSetParticipantEntityFromDomainModel(planCandidateSetId, participant)
+	if err := participantEntity.Insert(ctx, p.db, boil.Infer()); err != nil {
+		return fmt.Errorf("failed to insert plan candidate set participant: %w", err)
+	}
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdatePlanVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, planId string, vote bool) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		whereVote := []qm.QueryMod{
+			generated.PlanCandidateSetPlanVoteWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+			generated.PlanCandidateSetPlanVoteWhere.ParticipantID.EQ(participantId),
+			generated.PlanCandidateSetPlanVoteWhere.PlanCandidateID.EQ(planId),
+		}
+
+		if !vote {
+			if _, err := generated.PlanCandidateSetPlanVotes(whereVote...).DeleteAll(ctx, tx); err != nil {
+				return fmt.Errorf("failed to delete plan candidate set plan vote: %w", err)
+			}
+			return nil
+		}
+
+		isVoted, err := generated.PlanCandidateSetPlanVotes(whereVote...).Exists(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to check plan candidate set plan vote existence: %w", err)
+		}
+
+		if isVoted {
+			// すでに投票している場合は何もしない
+			return nil
+		}
+
+		planVoteEntity := generated.PlanCandidateSetPlanVote{
+			ID:                 uuid.New().String(),
+			PlanCandidateSetID: planCandidateSetId,
+			ParticipantID:      participantId,
+			PlanCandidateID:    planId,
+		}
+		if err := planVoteEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert plan candidate set plan vote: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p PlanCandidateRepository) UpdatePlaceVoteInGroup(ctx context.Context, planCandidateSetId string, participantId string, placeId string, vote bool) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		whereVote := []qm.QueryMod{
+			generated.PlanCandidateSetPlaceVoteWhere.PlanCandidateSetID.EQ(planCandidateSetId),
+			generated.PlanCandidateSetPlaceVoteWhere.ParticipantID.EQ(participantId),
+			generated.PlanCandidateSetPlaceVoteWhere.PlaceID.EQ(placeId),
+		}
+
+		if !vote {
+			if _, err := generated.PlanCandidateSetPlaceVotes(whereVote...).DeleteAll(ctx, tx); err != nil {
+				return fmt.Errorf("failed to delete plan candidate set place vote: %w", err)
+			}
+			return nil
+		}
+
+		isVoted, err := generated.PlanCandidateSetPlaceVotes(whereVote...).Exists(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to check plan candidate set place vote existence: %w", err)
+		}
+
+		if isVoted {
+			// すでに投票している場合は何もしない
+			return nil
+		}
+
+		placeVoteEntity := generated.PlanCandidateSetPlaceVote{
+			ID:                 uuid.New().String(),
+			PlanCandidateSetID: planCandidateSetId,
+			ParticipantID:      participantId,
+			PlaceID:            placeId,
+		}
+		if err := placeVoteEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert plan candidate set place vote: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package rdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func TestPlanCandidateRepository_Group(t *testing.T) {
+	cases := []struct {
+		name                   string
+		planCandidateSetId     string
+		shareToken             string
+		savedUsers             generated.UserSlice
+		savedPlanCandidateSets generated.PlanCandidateSetSlice
+		savedPlanCandidates    generated.PlanCandidateSlice
+		savedPlaces            []models.Place
+		participants           []models.PlanCandidateGroupParticipant
+		planVotes              []models.PlanCandidateGroupPlanVote
+		placeVotes             []models.PlanCandidateGroupPlaceVote
+		planVotesCanceled      []models.PlanCandidateGroupPlanVote
+		expected               models.PlanCandidateGroup
+	}{
+		{
+			name:               "should find group with participants and votes",
+			planCandidateSetId: "test-plan-candidate-set",
+			shareToken:         "test-share-token",
+			savedUsers: generated.UserSlice{
+				{ID: "test-user", FirebaseUID: uuid.New().String()},
+			},
+			savedPlanCandidateSets: generated.PlanCandidateSetSlice{
+				{ID: "test-plan-candidate-set", ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local)},
+			},
+			savedPlanCandidates: generated.PlanCandidateSlice{
+				{ID: "test-plan-1", PlanCandidateSetID: "test-plan-candidate-set", Name: "plan 1", SortOrder: 0},
+				{ID: "test-plan-2", PlanCandidateSetID: "test-plan-candidate-set", Name: "plan 2", SortOrder: 1},
+			},
+			savedPlaces: []models.Place{
+				{Id: "test-place-1"},
+			},
+			participants: []models.PlanCandidateGroupParticipant{
+				{Id: "test-participant-1", UserId: utils.StrPointer("test-user"), DisplayName: "user", Token: "test-participant-token-1"},
+				{Id: "test-participant-2", DisplayName: "guest", Token: "test-participant-token-2"},
+			},
+			planVotes: []models.PlanCandidateGroupPlanVote{
+				{ParticipantId: "test-participant-1", PlanId: "test-plan-1"},
+				{ParticipantId: "test-participant-1", PlanId: "test-plan-1"},
+				{ParticipantId: "test-participant-2", PlanId: "test-plan-1"},
+				{ParticipantId: "test-participant-2", PlanId: "test-plan-2"},
+			},
+			placeVotes: []models.PlanCandidateGroupPlaceVote{
+				{ParticipantId: "test-participant-2", PlaceId: "test-place-1"},
+			},
+			planVotesCanceled: []models.PlanCandidateGroupPlanVote{
+				{ParticipantId: "test-participant-2", PlanId: "test-plan-1"},
+			},
+			expected: models.PlanCandidateGroup{
+				PlanCandidateSetId: "test-plan-candidate-set",
+				ShareToken:         "test-share-token",
+				Participants: []models.PlanCandidateGroupParticipant{
+					{Id: "test-participant-1", UserId: utils.StrPointer("test-user"), DisplayName: "user", Token: "test-participant-token-1"},
+					{Id: "test-participant-2", DisplayName: "guest", Token: "test-participant-token-2"},
+				},
+				PlanVotes: []models.PlanCandidateGroupPlanVote{
+					{ParticipantId: "test-participant-1", PlanId: "test-plan-1"},
+					{ParticipantId: "test-participant-2", PlanId: "test-plan-2"},
+				},
+				PlaceVotes: []models.PlanCandidateGroupPlaceVote{
+					{ParticipantId: "test-participant-2", PlaceId: "test-place-1"},
+				},
+			},
+		},
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	for _, c := range cases {
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				err := cleanup(testContext, testDB)
+				if err != nil {
+					t.Fatalf("failed to cleanup: %v", err)
+				}
+			})
+
+			// データ準備
+			if _, err := c.savedUsers.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save users: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidateSets.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plan candidate sets: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidates.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plan candidates: %v", err)
+			}
+
+			if err := savePlaces(testContext, testDB, c.savedPlaces); err != nil {
+				t.Fatalf("failed to save places: %v", err)
+			}
+
+			if err := planCandidateRepository.CreateGroup(testContext, c.planCandidateSetId, c.shareToken); err != nil {
+				t.Fatalf("failed to create group: %v", err)
+			}
+
+			for _, participant := range c.participants {
+				if err := planCandidateRepository.AddGroupParticipant(testContext, c.planCandidateSetId, participant); err != nil {
+					t.Fatalf("failed to add participant: %v", err)
+				}
+			}
+
+			for _, vote := range c.planVotes {
+				if err := planCandidateRepository.UpdatePlanVoteInGroup(testContext, c.planCandidateSetId, vote.ParticipantId, vote.PlanId, true); err != nil {
+					t.Fatalf("failed to vote to plan: %v", err)
+				}
+			}
+
+			for _, vote := range c.placeVotes {
+				if err := planCandidateRepository.UpdatePlaceVoteInGroup(testContext, c.planCandidateSetId, vote.ParticipantId, vote.PlaceId, true); err != nil {
+					t.Fatalf("failed to vote to place: %v", err)
+				}
+			}
+
+			for _, vote := range c.planVotesCanceled {
+				if err := planCandidateRepository.UpdatePlanVoteInGroup(testContext, c.planCandidateSetId, vote.ParticipantId, vote.PlanId, false); err != nil {
+					t.Fatalf("failed to cancel vote to plan: %v", err)
+				}
+			}
+
+			actual, err := planCandidateRepository.FindGroupByShareToken(testContext, c.shareToken)
+			if err != nil {
+				t.Fatalf("failed to find group: %v", err)
+			}
+
+			if actual == nil {
+				t.Fatalf("group should be found")
+			}
+
+			if diff := cmp.Diff(
+				c.expected,
+				*actual,
+				cmpopts.SortSlices(func(a, b models.PlanCandidateGroupParticipant) bool { return a.Id < b.Id }),
+				cmpopts.SortSlices(func(a, b models.PlanCandidateGroupPlanVote) bool {
+					return a.ParticipantId+a.PlanId < b.ParticipantId+b.PlanId
+				}),
+				cmpopts.SortSlices(func(a, b models.PlanCandidateGroupPlaceVote) bool {
+					return a.ParticipantId+a.PlaceId < b.ParticipantId+b.PlaceId
+				}),
+			); diff != "" {
+				t.Errorf("FindGroupByShareToken() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanCandidateRepository_FindGroup_ShouldReturnNil(t *testing.T) {
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	actual, err := planCandidateRepository.FindGroup(context.Background(), "not-found")
+	if err != nil {
+		t.Fatalf("failed to find group: %v", err)
+	}
+
+	if actual != nil {
+		t.Errorf("group should be nil but got %v", actual)
+	}
+}
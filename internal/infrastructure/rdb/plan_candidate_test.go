@@ -109,6 +109,7 @@ func TestPlanCandidateRepository_Find(t *testing.T) {
 					LocationStart:                 &models.GeoLocation{Latitude: 139.767125, Longitude: 35.681236},
 					CategoriesPreferred:           &[]models.LocationCategory{models.CategoryRestaurant},
 					CategoriesRejected:            &[]models.LocationCategory{models.CategoryCafe},
+					TravelMode:                    models.TravelModeWalking,
 				},
 				Plans: []models.Plan{
 					{
@@ -133,6 +134,7 @@ func TestPlanCandidateRepository_Find(t *testing.T) {
 					LocationStart:                 &models.GeoLocation{Latitude: 139.767125, Longitude: 35.681236},
 					CategoriesPreferred:           &[]models.LocationCategory{models.CategoryRestaurant},
 					CategoriesRejected:            &[]models.LocationCategory{models.CategoryCafe},
+					TravelMode:                    models.TravelModeWalking,
 				},
 				Plans: []models.Plan{
 					{
@@ -210,6 +212,7 @@ func TestPlanCandidateRepository_Find(t *testing.T) {
 						Location:   models.GeoLocation{Latitude: 139.767125, Longitude: 35.681236},
 						RadiusInKm: 1.0,
 					},
+					TravelMode: models.TravelModeWalking,
 				},
 			},
 			expected: &models.PlanCandidateSet{
@@ -224,6 +227,7 @@ func TestPlanCandidateRepository_Find(t *testing.T) {
 						Location:   models.GeoLocation{Latitude: 139.767125, Longitude: 35.681236},
 						RadiusInKm: 1.0,
 					},
+					TravelMode: models.TravelModeWalking,
 				},
 			},
 		},
@@ -1168,13 +1172,27 @@ func TestPlanCandidateRepository_UpdatePlanCandidateMetaData(t *testing.T) {
 				CategoriesRejected:            &[]models.LocationCategory{models.CategorySpa},
 				LocationStart:                 &models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125},
 				FreeTime:                      utils.ToPointer(60),
+				StartTime:                     utils.ToPointer(time.Date(2020, 12, 1, 10, 0, 0, 0, time.Local)),
+				TravelMode:                    models.TravelModeCycling,
+				BudgetMax:                     utils.ToPointer(5000),
+				NumberOfPeople:                utils.ToPointer(2),
+				LocationEnd:                   &models.GeoLocation{Latitude: 35.689487, Longitude: 139.691706},
+				Weather:                       &models.WeatherForecast{PrecipitationProbability: 80, PrecipitationInMm: 2.5},
 			},
 			expectedPlanCandidateSetMetaData: &generated.PlanCandidateSetMetaDatum{
-				PlanCandidateSetID:           "test-plan-candidate-set",
-				LatitudeStart:                35.681236,
-				LongitudeStart:               139.767125,
-				IsCreatedFromCurrentLocation: true,
-				PlanDurationMinutes:          null.IntFrom(60),
+				PlanCandidateSetID:              "test-plan-candidate-set",
+				LatitudeStart:                   35.681236,
+				LongitudeStart:                  139.767125,
+				IsCreatedFromCurrentLocation:    true,
+				PlanDurationMinutes:             null.IntFrom(60),
+				StartAt:                         null.TimeFrom(time.Date(2020, 12, 1, 10, 0, 0, 0, time.Local)),
+				TravelMode:                      string(models.TravelModeCycling),
+				BudgetMax:                       null.IntFrom(5000),
+				NumberOfPeople:                  null.IntFrom(2),
+				LatitudeEnd:                     null.Float64From(35.689487),
+				LongitudeEnd:                    null.Float64From(139.691706),
+				WeatherPrecipitationProbability: null.IntFrom(80),
+				WeatherPrecipitationMM:          null.Float64From(2.5),
 			},
 			expectedPlanCandidateSetMetaDataCategorySlice: generated.PlanCandidateSetMetaDataCategorySlice{
 				{
@@ -1230,6 +1248,7 @@ func TestPlanCandidateRepository_UpdatePlanCandidateMetaData(t *testing.T) {
 				LongitudeStart:               140.767125,
 				IsCreatedFromCurrentLocation: true,
 				PlanDurationMinutes:          null.IntFrom(120),
+				TravelMode:                   string(models.TravelModeWalking),
 			},
 			expectedPlanCandidateSetMetaDataCategorySlice: generated.PlanCandidateSetMetaDataCategorySlice{
 				{
@@ -1276,6 +1295,7 @@ func TestPlanCandidateRepository_UpdatePlanCandidateMetaData(t *testing.T) {
 				LongitudeStart:               140.767125,
 				IsCreatedFromCurrentLocation: true,
 				PlanDurationMinutes:          null.IntFromPtr(nil),
+				TravelMode:                   string(models.TravelModeWalking),
 			},
 			expectedPlanCandidateSetMetaDataCreateByCategory: &generated.PlanCandidateSetMetaDataCreateByCategory{
 				PlanCandidateSetID: "test-plan-candidate-set",
@@ -1790,3 +1810,96 @@ func TestPlanCandidateRepository_UpdateLikeToPlaceInPlanCandidate_Unlike(t *test
 		})
 	}
 }
+
+func TestPlanCandidateRepository_FindCategoriesRejectedByUserId(t *testing.T) {
+	cases := []struct {
+		name                                       string
+		userId                                     string
+		savedUsers                                 generated.UserSlice
+		savedPlanCandidateSets                     generated.PlanCandidateSetSlice
+		savedPlanCandidates                        generated.PlanCandidateSlice
+		savedPlans                                 generated.PlanSlice
+		savedPlanCandidateSetMetaDataCategorySlice generated.PlanCandidateSetMetaDataCategorySlice
+		expected                                   []models.LocationCategory
+	}{
+		{
+			name:   "should find categories rejected when creating plans saved by user",
+			userId: "test-user",
+			savedUsers: generated.UserSlice{
+				{ID: "test-user", FirebaseUID: uuid.New().String()},
+				{ID: "test-other-user", FirebaseUID: uuid.New().String()},
+			},
+			savedPlanCandidateSets: generated.PlanCandidateSetSlice{
+				{ID: "test-plan-candidate-set-1", ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local)},
+				{ID: "test-plan-candidate-set-2", ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local)},
+				{ID: "test-plan-candidate-set-3", ExpiresAt: time.Date(2020, 12, 1, 0, 0, 0, 0, time.Local)},
+			},
+			savedPlanCandidates: generated.PlanCandidateSlice{
+				{ID: "test-plan-1", PlanCandidateSetID: "test-plan-candidate-set-1", Name: "plan 1", SortOrder: 0},
+				{ID: "test-plan-2", PlanCandidateSetID: "test-plan-candidate-set-1", Name: "plan 2", SortOrder: 1},
+				{ID: "test-plan-3", PlanCandidateSetID: "test-plan-candidate-set-2", Name: "plan 3", SortOrder: 0},
+				{ID: "test-plan-4", PlanCandidateSetID: "test-plan-candidate-set-3", Name: "plan 4", SortOrder: 0},
+			},
+			savedPlans: generated.PlanSlice{
+				{ID: "test-plan-1", UserID: null.StringFrom("test-user"), Name: "plan 1"},
+				{ID: "test-plan-2", UserID: null.StringFrom("test-user"), Name: "plan 2"},
+				{ID: "test-plan-3", UserID: null.StringFrom("test-user"), Name: "plan 3"},
+				{ID: "test-plan-4", UserID: null.StringFrom("test-other-user"), Name: "plan 4"},
+			},
+			savedPlanCandidateSetMetaDataCategorySlice: generated.PlanCandidateSetMetaDataCategorySlice{
+				{ID: uuid.New().String(), PlanCandidateSetID: "test-plan-candidate-set-1", Category: models.CategorySpa.Name, IsSelected: false},
+				{ID: uuid.New().String(), PlanCandidateSetID: "test-plan-candidate-set-1", Category: models.CategoryRestaurant.Name, IsSelected: true},
+				{ID: uuid.New().String(), PlanCandidateSetID: "test-plan-candidate-set-2", Category: models.CategorySpa.Name, IsSelected: false},
+				{ID: uuid.New().String(), PlanCandidateSetID: "test-plan-candidate-set-3", Category: models.CategoryBakery.Name, IsSelected: false},
+			},
+			expected: []models.LocationCategory{models.CategorySpa, models.CategorySpa},
+		},
+	}
+
+	planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+	if err != nil {
+		t.Fatalf("failed to create plan candidate repository: %v", err)
+	}
+
+	for _, c := range cases {
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				err := cleanup(testContext, testDB)
+				if err != nil {
+					t.Fatalf("failed to cleanup: %v", err)
+				}
+			})
+
+			// データ準備
+			if _, err := c.savedUsers.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save users: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidateSets.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plan candidate sets: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidates.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plan candidates: %v", err)
+			}
+
+			if _, err := c.savedPlans.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plans: %v", err)
+			}
+
+			if _, err := c.savedPlanCandidateSetMetaDataCategorySlice.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("failed to save plan candidate set meta data category: %v", err)
+			}
+
+			actual, err := planCandidateRepository.FindCategoriesRejectedByUserId(testContext, c.userId)
+			if err != nil {
+				t.Fatalf("failed to find categories rejected: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("FindCategoriesRejectedByUserId() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func (p PlanRepository) UpdateTitleAndDescription(ctx context.Context, planId string, version int, title string, description *string) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		if err := incrementPlanVersion(ctx, tx, planId, version); err != nil {
+			return err
+		}
+
+		if _, err := generated.Plans(generated.PlanWhere.ID.EQ(planId)).UpdateAll(ctx, tx, generated.M{
+			generated.PlanColumns.Name:        title,
+			generated.PlanColumns.Description: null.StringFromPtr(description),
+		}); err != nil {
+			return fmt.Errorf("failed to update plan: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p PlanRepository) UpdateVisibility(ctx context.Context, planId string, version int, visibility models.PlanVisibility) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		if err := incrementPlanVersion(ctx, tx, planId, version); err != nil {
+			return err
+		}
+
+		if _, err := generated.Plans(generated.PlanWhere.ID.EQ(planId)).UpdateAll(ctx, tx, generated.M{
+			generated.PlanColumns.Visibility: string(visibility),
+		}); err != nil {
+			return fmt.Errorf("failed to update plan visibility: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (p PlanRepository) UpdatePlacesOrder(ctx context.Context, planId string, version int, placeIdsOrdered []string) error {
+	return p.editPlaces(ctx, planId, version, func(placeIds []string) ([]string, error) {
+		// 場所のID一覧に過不足がないかを確認
+		if len(placeIdsOrdered) != len(placeIds) {
+			return nil, fmt.Errorf("invalid placeIdsOrdered length")
+		}
+
+		for _, placeId := range placeIdsOrdered {
+			if !array.IsContain(placeIds, placeId) {
+				return nil, fmt.Errorf("invalid placeId %s", placeId)
+			}
+		}
+
+		return placeIdsOrdered, nil
+	})
+}
+
+func (p PlanRepository) AddPlaceToPlan(ctx context.Context, planId string, version int, previousPlaceId string, place models.Place) error {
+	return p.editPlaces(ctx, planId, version, func(placeIds []string) ([]string, error) {
+		if array.IsContain(placeIds, place.Id) {
+			return nil, fmt.Errorf("place %s is already in plan", place.Id)
+		}
+
+		var placeIdsUpdated []string
+		for _, placeId := range placeIds {
+			placeIdsUpdated = append(placeIdsUpdated, placeId)
+			if placeId == previousPlaceId {
+				placeIdsUpdated = append(placeIdsUpdated, place.Id)
+			}
+		}
+
+		if len(placeIdsUpdated) == len(placeIds) {
+			return nil, fmt.Errorf("previous place %s not found in plan", previousPlaceId)
+		}
+
+		return placeIdsUpdated, nil
+	})
+}
+
+func (p PlanRepository) RemovePlaceFromPlan(ctx context.Context, planId string, version int, placeId string) error {
+	return p.editPlaces(ctx, planId, version, func(placeIds []string) ([]string, error) {
+		placeIdsUpdated := array.Filter(placeIds, func(id string) bool {
+			return id != placeId
+		})
+
+		if len(placeIdsUpdated) == len(placeIds) {
+			return nil, fmt.Errorf("place %s not found in plan", placeId)
+		}
+
+		// 少なくとも1つの場所がプランに含まれるようにする
+		if len(placeIdsUpdated) == 0 {
+			return nil, fmt.Errorf("plan must have at least one place")
+		}
+
+		return placeIdsUpdated, nil
+	})
+}
+
+func (p PlanRepository) ReplacePlace(ctx context.Context, planId string, version int, placeIdToBeReplaced string, placeToReplace models.Place) error {
+	return p.editPlaces(ctx, planId, version, func(placeIds []string) ([]string, error) {
+		if !array.IsContain(placeIds, placeIdToBeReplaced) {
+			return nil, fmt.Errorf("place %s not found in plan", placeIdToBeReplaced)
+		}
+
+		if array.IsContain(placeIds, placeToReplace.Id) {
+			return nil, fmt.Errorf("place %s is already in plan", placeToReplace.Id)
+		}
+
+		return array.Map(placeIds, func(placeId string) string {
+			if placeId == placeIdToBeReplaced {
+				return placeToReplace.Id
+			}
+			return placeId
+		}), nil
+	})
+}
+
+// editPlaces は edit で求めた場所の一覧でプランに含まれる場所を置き換える
+// edit にはプランに含まれる場所の ID が順番通りに渡される
+func (p PlanRepository) editPlaces(ctx context.Context, planId string, version int, edit func(placeIds []string) ([]string, error)) error {
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		if err := incrementPlanVersion(ctx, tx, planId, version); err != nil {
+			return err
+		}
+
+		planPlaceEntities, err := generated.PlanPlaces(
+			generated.PlanPlaceWhere.PlanID.EQ(planId),
+			qm.OrderBy(generated.PlanPlaceColumns.SortOrder),
+		).All(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("failed to get plan places: %w", err)
+		}
+
+		placeIds := array.Map(planPlaceEntities, func(planPlace *generated.PlanPlace) string {
+			return planPlace.PlaceID
+		})
+
+		placeIdsUpdated, err := edit(placeIds)
+		if err != nil {
+			return err
+		}
+
+		if _, err := planPlaceEntities.DeleteAll(ctx, tx); err != nil {
+			return fmt.Errorf("failed to delete plan places: %w", err)
+		}
+
+		planPlaceSlice := factory.NewPlanPlaceSliceFromDomainMode(
+			array.Map(placeIdsUpdated, func(placeId string) models.Place { return models.Place{Id: placeId} }),
+			planId,
+		)
+		if _, err := planPlaceSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert plan places: %w", err)
+		}
+
+		// プランから取り除かれた場所の写真はコラージュからも削除する
+		placeIdsRemoved := array.Filter(placeIds, func(placeId string) bool {
+			return !array.IsContain(placeIdsUpdated, placeId)
+		})
+		if err := deletePlanCollagePhotosOfPlaces(ctx, tx, planId, placeIdsRemoved); err != nil {
+			return err
+		}
+
+		// プランの位置は最初の場所の位置とする
+		if len(placeIds) == 0 || placeIds[0] != placeIdsUpdated[0] {
+			googlePlaceEntity, err := generated.GooglePlaces(generated.GooglePlaceWhere.PlaceID.EQ(placeIdsUpdated[0])).One(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to get google place: %w", err)
+			}
+
+			s2CellIds := models.GeoLocation{Latitude: googlePlaceEntity.Latitude, Longitude: googlePlaceEntity.Longitude}.S2CellIds()
+			if _, err := generated.Plans(generated.PlanWhere.ID.EQ(planId)).UpdateAll(ctx, tx, generated.M{
+				generated.PlanColumns.Latitude:        googlePlaceEntity.Latitude,
+				generated.PlanColumns.Longitude:       googlePlaceEntity.Longitude,
+				generated.PlanColumns.S2CellIDLevel10: s2CellIds.Level10,
+				generated.PlanColumns.S2CellIDLevel13: s2CellIds.Level13,
+				generated.PlanColumns.S2CellIDLevel16: s2CellIds.Level16,
+			}); err != nil {
+				return fmt.Errorf("failed to update location of plan: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to run transaction: %w", err)
+	}
+
+	return nil
+}
+
+// incrementPlanVersion は version が保存されているプランのバージョンと一致する場合のみ、バージョンを1つ増やす
+// 一致しない場合は apperrors.ErrVersionConflict を返す
+func incrementPlanVersion(ctx context.Context, tx *sql.Tx, planId string, version int) error {
+	rowsAffected, err := generated.Plans(
+		generated.PlanWhere.ID.EQ(planId),
+		generated.PlanWhere.Version.EQ(version),
+	).UpdateAll(ctx, tx, generated.M{
+		generated.PlanColumns.Version: version + 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update plan version: %w", err)
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	planExists, err := generated.PlanExists(ctx, tx, planId)
+	if err != nil {
+		return fmt.Errorf("failed to check plan exists: %w", err)
+	}
+
+	if !planExists {
+		return fmt.Errorf("plan not found: %s: %w", planId, sql.ErrNoRows)
+	}
+
+	return fmt.Errorf("plan %s is already updated: %w", planId, apperrors.ErrVersionConflict)
+}
+
+// deletePlanCollagePhotosOfPlaces はプランのコラージュから、指定した場所の写真を削除する
+func deletePlanCollagePhotosOfPlaces(ctx context.Context, exec boil.ContextExecutor, planId string, placeIds []string) error {
+	if len(placeIds) == 0 {
+		return nil
+	}
+
+	planCollageEntity, err := generated.PlanCollages(generated.PlanCollageWhere.PlanID.EQ(planId)).One(ctx, exec)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+		return fmt.Errorf("failed to get plan collage: %w", err)
+	}
+
+	if _, err := generated.PlanCollagePhotos(
+		generated.PlanCollagePhotoWhere.PlanCollageID.EQ(planCollageEntity.ID),
+		generated.PlanCollagePhotoWhere.PlaceID.IN(placeIds),
+	).DeleteAll(ctx, exec); err != nil {
+		return fmt.Errorf("failed to delete plan collage photos: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,329 @@
+package rdb
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func TestPlanRepository_UpdateTitleAndDescription(t *testing.T) {
+	cases := []struct {
+		name                string
+		savedPlan           models.Plan
+		version             int
+		title               string
+		description         *string
+		expectedName        string
+		expectedDescription *string
+		expectedVersion     int
+		expectedErr         error
+	}{
+		{
+			name: "update title and description",
+			savedPlan: models.Plan{
+				Id:      "test-plan",
+				Name:    "plan title",
+				Version: 1,
+			},
+			version:             1,
+			title:               "new plan title",
+			description:         utils.StrPointer("new plan description"),
+			expectedName:        "new plan title",
+			expectedDescription: utils.StrPointer("new plan description"),
+			expectedVersion:     2,
+		},
+		{
+			name: "version conflict",
+			savedPlan: models.Plan{
+				Id:      "test-plan",
+				Name:    "plan title",
+				Version: 2,
+			},
+			version:         1,
+			title:           "new plan title",
+			expectedName:    "plan title",
+			expectedVersion: 2,
+			expectedErr:     apperrors.ErrVersionConflict,
+		},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error initializing plan repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if err := savePlans(testContext, testDB, []models.Plan{c.savedPlan}); err != nil {
+				t.Fatalf("error saving plan: %v", err)
+			}
+
+			err := planRepository.UpdateTitleAndDescription(testContext, c.savedPlan.Id, c.version, c.title, c.description)
+			if c.expectedErr != nil {
+				if !errors.Is(err, c.expectedErr) {
+					t.Fatalf("expected error %v but got %v", c.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("error updating title and description: %v", err)
+			}
+
+			planEntity, err := generated.FindPlan(testContext, testDB, c.savedPlan.Id)
+			if err != nil {
+				t.Fatalf("error finding plan: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedName, planEntity.Name); diff != "" {
+				t.Errorf("name mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedDescription, planEntity.Description.Ptr()); diff != "" {
+				t.Errorf("description mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedVersion, planEntity.Version); diff != "" {
+				t.Errorf("version mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanRepository_UpdateVisibility(t *testing.T) {
+	cases := []struct {
+		name               string
+		savedPlan          models.Plan
+		version            int
+		visibility         models.PlanVisibility
+		expectedVisibility string
+		expectedVersion    int
+		expectedErr        error
+	}{
+		{
+			name: "update visibility",
+			savedPlan: models.Plan{
+				Id:      "test-plan",
+				Name:    "plan title",
+				Version: 1,
+			},
+			version:            1,
+			visibility:         models.PlanVisibilityPrivate,
+			expectedVisibility: "PRIVATE",
+			expectedVersion:    2,
+		},
+		{
+			name: "version conflict",
+			savedPlan: models.Plan{
+				Id:         "test-plan",
+				Name:       "plan title",
+				Version:    2,
+				Visibility: models.PlanVisibilityUnlisted,
+			},
+			version:            1,
+			visibility:         models.PlanVisibilityPrivate,
+			expectedVisibility: "UNLISTED",
+			expectedVersion:    2,
+			expectedErr:        apperrors.ErrVersionConflict,
+		},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error initializing plan repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if err := savePlans(testContext, testDB, []models.Plan{c.savedPlan}); err != nil {
+				t.Fatalf("error saving plan: %v", err)
+			}
+
+			err := planRepository.UpdateVisibility(testContext, c.savedPlan.Id, c.version, c.visibility)
+			if c.expectedErr != nil {
+				if !errors.Is(err, c.expectedErr) {
+					t.Fatalf("expected error %v but got %v", c.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("error updating visibility: %v", err)
+			}
+
+			planEntity, err := generated.FindPlan(testContext, testDB, c.savedPlan.Id)
+			if err != nil {
+				t.Fatalf("error finding plan: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedVisibility, planEntity.Visibility); diff != "" {
+				t.Errorf("visibility mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedVersion, planEntity.Version); diff != "" {
+				t.Errorf("version mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPlanRepository_EditPlaces(t *testing.T) {
+	savedPlaces := []models.Place{
+		{
+			Id:       "first-place",
+			Location: models.GeoLocation{Latitude: 35.0, Longitude: 139.0},
+			Google:   models.GooglePlace{PlaceId: "first-google-place", Location: models.GeoLocation{Latitude: 35.0, Longitude: 139.0}},
+		},
+		{
+			Id:       "second-place",
+			Location: models.GeoLocation{Latitude: 36.0, Longitude: 140.0},
+			Google:   models.GooglePlace{PlaceId: "second-google-place", Location: models.GeoLocation{Latitude: 36.0, Longitude: 140.0}},
+		},
+		{
+			Id:       "third-place",
+			Location: models.GeoLocation{Latitude: 37.0, Longitude: 141.0},
+			Google:   models.GooglePlace{PlaceId: "third-google-place", Location: models.GeoLocation{Latitude: 37.0, Longitude: 141.0}},
+		},
+	}
+
+	savedPlan := models.Plan{
+		Id:      "test-plan",
+		Name:    "plan title",
+		Places:  []models.Place{savedPlaces[0], savedPlaces[1]},
+		Version: 1,
+	}
+
+	cases := []struct {
+		name             string
+		edit             func(ctx context.Context, planRepository *PlanRepository) error
+		expectedPlaceIds []string
+		expectedLocation models.GeoLocation
+		expectedVersion  int
+		expectedErr      error
+	}{
+		{
+			name: "reorder places",
+			edit: func(ctx context.Context, planRepository *PlanRepository) error {
+				return planRepository.UpdatePlacesOrder(ctx, "test-plan", 1, []string{"second-place", "first-place"})
+			},
+			expectedPlaceIds: []string{"second-place", "first-place"},
+			expectedLocation: models.GeoLocation{Latitude: 36.0, Longitude: 140.0},
+			expectedVersion:  2,
+		},
+		{
+			name: "add place after first place",
+			edit: func(ctx context.Context, planRepository *PlanRepository) error {
+				return planRepository.AddPlaceToPlan(ctx, "test-plan", 1, "first-place", savedPlaces[2])
+			},
+			expectedPlaceIds: []string{"first-place", "third-place", "second-place"},
+			expectedLocation: models.GeoLocation{Latitude: 35.0, Longitude: 139.0},
+			expectedVersion:  2,
+		},
+		{
+			name: "remove first place",
+			edit: func(ctx context.Context, planRepository *PlanRepository) error {
+				return planRepository.RemovePlaceFromPlan(ctx, "test-plan", 1, "first-place")
+			},
+			expectedPlaceIds: []string{"second-place"},
+			expectedLocation: models.GeoLocation{Latitude: 36.0, Longitude: 140.0},
+			expectedVersion:  2,
+		},
+		{
+			name: "replace second place",
+			edit: func(ctx context.Context, planRepository *PlanRepository) error {
+				return planRepository.ReplacePlace(ctx, "test-plan", 1, "second-place", savedPlaces[2])
+			},
+			expectedPlaceIds: []string{"first-place", "third-place"},
+			expectedLocation: models.GeoLocation{Latitude: 35.0, Longitude: 139.0},
+			expectedVersion:  2,
+		},
+		{
+			name: "version conflict",
+			edit: func(ctx context.Context, planRepository *PlanRepository) error {
+				return planRepository.RemovePlaceFromPlan(ctx, "test-plan", 0, "first-place")
+			},
+			expectedPlaceIds: []string{"first-place", "second-place"},
+			expectedLocation: models.GeoLocation{Latitude: 35.0, Longitude: 139.0},
+			expectedVersion:  1,
+			expectedErr:      apperrors.ErrVersionConflict,
+		},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error initializing plan repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+				t.Fatalf("error saving places: %v", err)
+			}
+
+			if err := savePlans(testContext, testDB, []models.Plan{savedPlan}); err != nil {
+				t.Fatalf("error saving plan: %v", err)
+			}
+
+			err := c.edit(testContext, planRepository)
+			if c.expectedErr != nil {
+				if !errors.Is(err, c.expectedErr) {
+					t.Fatalf("expected error %v but got %v", c.expectedErr, err)
+				}
+			} else if err != nil {
+				t.Fatalf("error editing places: %v", err)
+			}
+
+			planPlaceEntities, err := generated.PlanPlaces(
+				generated.PlanPlaceWhere.PlanID.EQ(savedPlan.Id),
+				qm.OrderBy(generated.PlanPlaceColumns.SortOrder),
+			).All(testContext, testDB)
+			if err != nil {
+				t.Fatalf("error finding plan places: %v", err)
+			}
+
+			placeIds := array.Map(planPlaceEntities, func(planPlace *generated.PlanPlace) string { return planPlace.PlaceID })
+			if diff := cmp.Diff(c.expectedPlaceIds, placeIds); diff != "" {
+				t.Errorf("places mismatch (-want +got):\n%s", diff)
+			}
+
+			planEntity, err := generated.FindPlan(testContext, testDB, savedPlan.Id)
+			if err != nil {
+				t.Fatalf("error finding plan: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expectedLocation, models.GeoLocation{Latitude: planEntity.Latitude, Longitude: planEntity.Longitude}); diff != "" {
+				t.Errorf("location mismatch (-want +got):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedVersion, planEntity.Version); diff != "" {
+				t.Errorf("version mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/repository"
 	"poroto.app/poroto/planner/internal/domain/utils"
@@ -91,11 +92,15 @@ func TestPlanRepository_Save(t *testing.T) {
 				ParentPlanId: utils.ToPointer("plan_parent"),
 			},
 			expectedPlan: generated.Plan{
-				ID:        "plan",
-				Name:      "plan title",
-				UserID:    null.StringFrom("user_id_1"),
-				Latitude:  35.681236,
-				Longitude: 139.767125,
+				ID:              "plan",
+				Name:            "plan title",
+				UserID:          null.StringFrom("user_id_1"),
+				Latitude:        35.681236,
+				Longitude:       139.767125,
+				Visibility:      "PUBLIC",
+				S2CellIDLevel10: null.Uint64From(models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125}.S2CellIds().Level10),
+				S2CellIDLevel13: null.Uint64From(models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125}.S2CellIds().Level13),
+				S2CellIDLevel16: null.Uint64From(models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125}.S2CellIds().Level16),
 			},
 			expectedPlanPlaces: generated.PlanPlaceSlice{
 				{
@@ -277,6 +282,7 @@ func TestPlanRepository_Find(t *testing.T) {
 					Id:          "8fde8eff-4b18-4276-b71f-2fec30ea65c8",
 					FirebaseUID: "firebase_uid_1",
 				},
+				Visibility: models.PlanVisibilityPublic,
 			},
 		},
 		{
@@ -287,9 +293,10 @@ func TestPlanRepository_Find(t *testing.T) {
 				Places: []models.Place{},
 			},
 			expected: models.Plan{
-				Id:     "f2c98d68-3904-455b-8832-a0f723a96735",
-				Name:   "plan title",
-				Places: []models.Place{},
+				Id:         "f2c98d68-3904-455b-8832-a0f723a96735",
+				Name:       "plan title",
+				Places:     []models.Place{},
+				Visibility: models.PlanVisibilityPublic,
 			},
 		},
 	}
@@ -324,7 +331,7 @@ func TestPlanRepository_Find(t *testing.T) {
 				t.Errorf("error saving plan: %v", err)
 			}
 
-			plan, err := planRepository.Find(textContext, c.savedPlan.Id)
+			plan, err := planRepository.Find(textContext, c.savedPlan.Id, nil)
 			if err != nil {
 				t.Errorf("error finding plan: %v", err)
 			}
@@ -398,6 +405,7 @@ func TestPlanRepository_Find_WithPlaceLikeCount(t *testing.T) {
 						LikeCount: 2,
 					},
 				},
+				Visibility: models.PlanVisibilityPublic,
 			},
 		},
 	}
@@ -442,7 +450,7 @@ func TestPlanRepository_Find_WithPlaceLikeCount(t *testing.T) {
 				t.Errorf("error saving user like place: %v", err)
 			}
 
-			plan, err := planRepository.Find(textContext, c.planId)
+			plan, err := planRepository.Find(textContext, c.planId, nil)
 			if err != nil {
 				t.Errorf("error finding plan: %v", err)
 			}
@@ -534,6 +542,7 @@ func TestPlanRepository_FindByAuthorId(t *testing.T) {
 						Id:          "8fde8eff-4b18-4276-b71f-2fec30ea65c8",
 						FirebaseUID: "firebase_uid_1",
 					},
+					Visibility: models.PlanVisibilityPublic,
 				},
 				{
 					Id:   "c61a8b42-2c07-4957-913d-6930f0d881ec",
@@ -552,6 +561,7 @@ func TestPlanRepository_FindByAuthorId(t *testing.T) {
 						Id:          "8fde8eff-4b18-4276-b71f-2fec30ea65c8",
 						FirebaseUID: "firebase_uid_1",
 					},
+					Visibility: models.PlanVisibilityPublic,
 				},
 			},
 		},
@@ -613,7 +623,7 @@ func TestPlanRepository_FindByAuthorId(t *testing.T) {
 				t.Errorf("error saving plan: %v", err)
 			}
 
-			plans, err := planRepository.FindByAuthorId(textContext, c.authorId)
+			plans, err := planRepository.FindByAuthorId(textContext, c.authorId, false)
 			if err != nil {
 				t.Errorf("error finding plans: %v", err)
 			}
@@ -629,6 +639,103 @@ func TestPlanRepository_FindByAuthorId(t *testing.T) {
 	}
 }
 
+func TestPlanRepository_FindPlans_ShouldFilterByVisibility(t *testing.T) {
+	author := models.User{Id: "8fde8eff-4b18-4276-b71f-2fec30ea65c8", FirebaseUID: "firebase_uid_1"}
+	location := models.GeoLocation{Latitude: 35.681236, Longitude: 139.767125}
+
+	savedPlaces := []models.Place{
+		{Id: "public-place", Location: location, Google: models.GooglePlace{PlaceId: "public-google-place", Location: location}},
+		{Id: "unlisted-place", Location: location, Google: models.GooglePlace{PlaceId: "unlisted-google-place", Location: location}},
+		{Id: "private-place", Location: location, Google: models.GooglePlace{PlaceId: "private-google-place", Location: location}},
+	}
+
+	savedPlans := []models.Plan{
+		{Id: "public-plan", Places: []models.Place{savedPlaces[0]}, Author: &author, Visibility: models.PlanVisibilityPublic},
+		{Id: "unlisted-plan", Places: []models.Place{savedPlaces[1]}, Author: &author, Visibility: models.PlanVisibilityUnlisted},
+		{Id: "private-plan", Places: []models.Place{savedPlaces[2]}, Author: &author, Visibility: models.PlanVisibilityPrivate},
+	}
+
+	cases := []struct {
+		name            string
+		find            func(ctx context.Context, planRepository *PlanRepository) (*[]models.Plan, error)
+		expectedPlanIds []string
+	}{
+		{
+			name: "sorted by created at should return only public plans",
+			find: func(ctx context.Context, planRepository *PlanRepository) (*[]models.Plan, error) {
+				plans, _, err := planRepository.SortedByCreatedAt(ctx, nil, 10)
+				return plans, err
+			},
+			expectedPlanIds: []string{"public-plan"},
+		},
+		{
+			name: "find by location should return only public plans",
+			find: func(ctx context.Context, planRepository *PlanRepository) (*[]models.Plan, error) {
+				plans, _, err := planRepository.FindByLocation(ctx, location, 10, 1000)
+				return plans, err
+			},
+			expectedPlanIds: []string{"public-plan"},
+		},
+		{
+			name: "find by author id should return only public plans if publicOnly is true",
+			find: func(ctx context.Context, planRepository *PlanRepository) (*[]models.Plan, error) {
+				return planRepository.FindByAuthorId(ctx, author.Id, true)
+			},
+			expectedPlanIds: []string{"public-plan"},
+		},
+		{
+			name: "find by author id should return all plans if publicOnly is false",
+			find: func(ctx context.Context, planRepository *PlanRepository) (*[]models.Plan, error) {
+				return planRepository.FindByAuthorId(ctx, author.Id, false)
+			},
+			expectedPlanIds: []string{"private-plan", "public-plan", "unlisted-plan"},
+		},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error initializing plan repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, testDB); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			userEntity := generated.User{ID: author.Id, FirebaseUID: author.FirebaseUID}
+			if err := userEntity.Insert(testContext, testDB, boil.Infer()); err != nil {
+				t.Fatalf("error saving user: %v", err)
+			}
+
+			if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+				t.Fatalf("error saving places: %v", err)
+			}
+
+			if err := savePlans(testContext, testDB, savedPlans); err != nil {
+				t.Fatalf("error saving plans: %v", err)
+			}
+
+			plans, err := c.find(testContext, planRepository)
+			if err != nil {
+				t.Fatalf("error finding plans: %v", err)
+			}
+
+			if diff := cmp.Diff(
+				c.expectedPlanIds,
+				array.Map(*plans, func(plan models.Plan) string { return plan.Id }),
+				cmpopts.SortSlices(func(a, b string) bool { return a < b }),
+			); diff != "" {
+				t.Errorf("plans mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestPlanRepository_SortedByCreatedAt(t *testing.T) {
 	cases := []struct {
 		name                string
@@ -706,6 +813,7 @@ func TestPlanRepository_SortedByCreatedAt(t *testing.T) {
 						Id:          "28a52fdd-c252-4e32-a918-fcab5ed88ad8",
 						FirebaseUID: "firebase_uid_2",
 					},
+					Visibility: models.PlanVisibilityPublic,
 				},
 				{
 					Id:   "f2c98d68-3904-455b-8832-a0f723a96735",
@@ -720,6 +828,7 @@ func TestPlanRepository_SortedByCreatedAt(t *testing.T) {
 						Id:          "8fde8eff-4b18-4276-b71f-2fec30ea65c8",
 						FirebaseUID: "firebase_uid_1",
 					},
+					Visibility: models.PlanVisibilityPublic,
 				},
 			},
 		},
@@ -743,9 +852,10 @@ func TestPlanRepository_SortedByCreatedAt(t *testing.T) {
 			limit: 10,
 			expected: []models.Plan{
 				{
-					Id:     "f2c98d68-3904-455b-8832-a0f723a96735",
-					Name:   "plan title 1",
-					Places: []models.Place{},
+					Id:         "f2c98d68-3904-455b-8832-a0f723a96735",
+					Name:       "plan title 1",
+					Places:     []models.Place{},
+					Visibility: models.PlanVisibilityPublic,
 				},
 			},
 		},
@@ -906,6 +1016,7 @@ func TestPlanRepository_FindByLocation(t *testing.T) {
 						Id:          "8fde8eff-4b18-4276-b71f-2fec30ea65c8",
 						FirebaseUID: "firebase_uid_1",
 					},
+					Visibility: models.PlanVisibilityPublic,
 				},
 			},
 		},
@@ -995,8 +1106,9 @@ func TestPlanRepository_UpdatePlanAuthorUserByPlanCandidateSet(t *testing.T) {
 			},
 			expectedUserPlans: generated.PlanSlice{
 				{
-					ID:     "f2c98d68-3904-455b-8832-a0f723a96735",
-					UserID: null.StringFrom("8fde8eff-4b18-4276-b71f-2fec30ea65c8"),
+					ID:         "f2c98d68-3904-455b-8832-a0f723a96735",
+					UserID:     null.StringFrom("8fde8eff-4b18-4276-b71f-2fec30ea65c8"),
+					Visibility: "PUBLIC",
 				},
 			},
 		},
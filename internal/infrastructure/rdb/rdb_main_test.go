@@ -75,7 +75,13 @@ func cleanup(ctx context.Context, db *sql.DB) error {
 		generated.PlanCollages(),
 		// PlaceRecommendation
 		generated.PlaceRecommendations(),
+		// PlanCandidateGroup
+		generated.PlanCandidateSetPlaceVotes(),
+		generated.PlanCandidateSetPlanVotes(),
+		generated.PlanCandidateSetParticipants(),
+		generated.PlanCandidateSetGroups(),
 		// PlanCandidate
+		generated.PlanCandidateEditHistories(),
 		generated.PlanCandidateSetLikePlaces(),
 		generated.PlanCandidatePlaces(),
 		generated.PlanCandidateSetMetaDataCreateByCategories(),
@@ -83,6 +89,9 @@ func cleanup(ctx context.Context, db *sql.DB) error {
 		generated.PlanCandidateSetMetaData(),
 		generated.PlanCandidates(),
 		generated.PlanCandidateSets(),
+		// Trip
+		generated.TripPlans(),
+		generated.Trips(),
 		// Plan
 		generated.PlanParentChildren(),
 		generated.PlanPlaces(),
@@ -96,6 +105,7 @@ func cleanup(ctx context.Context, db *sql.DB) error {
 		generated.GooglePlaceTypes(),
 		generated.GooglePlaces(),
 		// Place
+		generated.PlaceRankingScores(),
 		generated.UserLikePlaces(),
 		generated.PlacePhotos(),
 		generated.Places(),
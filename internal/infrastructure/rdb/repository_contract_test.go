@@ -0,0 +1,45 @@
+package rdb
+
+import (
+	"context"
+	"testing"
+
+	"poroto.app/poroto/planner/internal/domain/repository/repositorytest"
+)
+
+func TestRepositoryContract(t *testing.T) {
+	repositorytest.Run(t, func(t *testing.T) repositorytest.Repositories {
+		t.Cleanup(func() {
+			if err := cleanup(context.Background(), testDB); err != nil {
+				t.Errorf("error cleaning up: %v", err)
+			}
+		})
+
+		placeRepository, err := NewPlaceRepository(testDB)
+		if err != nil {
+			t.Fatalf("error initializing place repository: %v", err)
+		}
+
+		planRepository, err := NewPlanRepository(testDB)
+		if err != nil {
+			t.Fatalf("error initializing plan repository: %v", err)
+		}
+
+		planCandidateRepository, err := NewPlanCandidateRepository(testDB)
+		if err != nil {
+			t.Fatalf("error initializing plan candidate repository: %v", err)
+		}
+
+		userRepository, err := NewUserRepository(testDB)
+		if err != nil {
+			t.Fatalf("error initializing user repository: %v", err)
+		}
+
+		return repositorytest.Repositories{
+			Place:         placeRepository,
+			Plan:          planRepository,
+			PlanCandidate: planCandidateRepository,
+			User:          userRepository,
+		}
+	})
+}
@@ -0,0 +1,197 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+// googlePlaceWithinRadiusQueryMod location を中心とする半径 radius メートル以内にある場所を取得する
+// S2 セルで候補を絞り込んだうえで、S2 セルは範囲より広い領域を覆うため SQL で距離による絞り込みも行う
+// 距離による絞り込みを SQL で行うことで、qm.Limit と組み合わせても範囲内の場所が取得できる
+func googlePlaceWithinRadiusQueryMod(location models.GeoLocation, radius float64) qm.QueryMod {
+	level, cellIds := location.S2CellCovering(radius)
+	minLocation, maxLocation := location.CalculateMBR(radius)
+
+	var s2CellIdColumn string
+	var s2CellQueryMod qm.QueryMod
+	switch level {
+	case models.S2CellLevel10:
+		s2CellIdColumn = generated.GooglePlaceColumns.S2CellIDLevel10
+		s2CellQueryMod = generated.GooglePlaceWhere.S2CellIDLevel10.IN(cellIds)
+	case models.S2CellLevel13:
+		s2CellIdColumn = generated.GooglePlaceColumns.S2CellIDLevel13
+		s2CellQueryMod = generated.GooglePlaceWhere.S2CellIDLevel13.IN(cellIds)
+	default:
+		s2CellIdColumn = generated.GooglePlaceColumns.S2CellIDLevel16
+		s2CellQueryMod = generated.GooglePlaceWhere.S2CellIDLevel16.IN(cellIds)
+	}
+
+	return qm.Expr(
+		qm.Expr(
+			s2CellQueryMod,
+			// S2 セルの ID が保存されていない場所は、バックフィルが完了するまで緯度・経度の範囲で検索する
+			qm.Or2(qm.Expr(
+				qm.Where(fmt.Sprintf("%s.%s IS NULL", generated.TableNames.GooglePlaces, s2CellIdColumn)),
+				generated.GooglePlaceWhere.Latitude.GT(minLocation.Latitude),
+				generated.GooglePlaceWhere.Latitude.LT(maxLocation.Latitude),
+				generated.GooglePlaceWhere.Longitude.GT(minLocation.Longitude),
+				generated.GooglePlaceWhere.Longitude.LT(maxLocation.Longitude),
+			)),
+		),
+		distanceWithinQueryMod(generated.TableNames.GooglePlaces, generated.GooglePlaceColumns.Latitude, generated.GooglePlaceColumns.Longitude, location, radius),
+	)
+}
+
+// planWithinRadiusQueryMod location を中心とする半径 radius メートル以内にあるプランを取得する
+// S2 セルで候補を絞り込んだうえで、S2 セルは範囲より広い領域を覆うため SQL で距離による絞り込みも行う
+// 距離による絞り込みを SQL で行うことで、qm.Limit と組み合わせても範囲内のプランが取得できる
+func planWithinRadiusQueryMod(location models.GeoLocation, radius float64) qm.QueryMod {
+	level, cellIds := location.S2CellCovering(radius)
+	minLocation, maxLocation := location.CalculateMBR(radius)
+
+	var s2CellIdColumn string
+	var s2CellQueryMod qm.QueryMod
+	switch level {
+	case models.S2CellLevel10:
+		s2CellIdColumn = generated.PlanColumns.S2CellIDLevel10
+		s2CellQueryMod = generated.PlanWhere.S2CellIDLevel10.IN(cellIds)
+	case models.S2CellLevel13:
+		s2CellIdColumn = generated.PlanColumns.S2CellIDLevel13
+		s2CellQueryMod = generated.PlanWhere.S2CellIDLevel13.IN(cellIds)
+	default:
+		s2CellIdColumn = generated.PlanColumns.S2CellIDLevel16
+		s2CellQueryMod = generated.PlanWhere.S2CellIDLevel16.IN(cellIds)
+	}
+
+	return qm.Expr(
+		qm.Expr(
+			s2CellQueryMod,
+			// S2 セルの ID が保存されていないプランは、バックフィルが完了するまで緯度・経度の範囲で検索する
+			qm.Or2(qm.Expr(
+				qm.Where(fmt.Sprintf("%s.%s IS NULL", generated.TableNames.Plans, s2CellIdColumn)),
+				generated.PlanWhere.Latitude.GT(minLocation.Latitude),
+				generated.PlanWhere.Latitude.LT(maxLocation.Latitude),
+				generated.PlanWhere.Longitude.GT(minLocation.Longitude),
+				generated.PlanWhere.Longitude.LT(maxLocation.Longitude),
+			)),
+		),
+		distanceWithinQueryMod(generated.TableNames.Plans, generated.PlanColumns.Latitude, generated.PlanColumns.Longitude, location, radius),
+	)
+}
+
+// distanceWithinQueryMod table の位置が location から radius メートル以内にあるレコードに絞り込む
+// models.GeoLocation.DistanceInMeter と同じ計算式を用いる
+func distanceWithinQueryMod(table string, latitudeColumn string, longitudeColumn string, location models.GeoLocation, radius float64) qm.QueryMod {
+	latitude := fmt.Sprintf("%s.%s", table, latitudeColumn)
+	longitude := fmt.Sprintf("%s.%s", table, longitudeColumn)
+	return qm.Where(
+		fmt.Sprintf(
+			"DEGREES(ACOS(LEAST(1, SIN(RADIANS(%s)) * SIN(RADIANS(?)) + COS(RADIANS(%s)) * COS(RADIANS(?)) * COS(RADIANS(%s - ?))))) * 60 * 1.1515 * 1.609344 * 1000 <= ?",
+			latitude, latitude, longitude,
+		),
+		location.Latitude, location.Latitude, location.Longitude, radius,
+	)
+}
+
+// BackfillS2CellIds S2 セルの ID が保存されていない場所に、位置から求めた S2 セルの ID を保存する
+// batchSize 件ずつ更新し、更新した場所の件数を返す
+func (p PlaceRepository) BackfillS2CellIds(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be greater than 0")
+	}
+
+	count := 0
+	for {
+		googlePlaceEntities, err := generated.GooglePlaces(
+			generated.GooglePlaceWhere.S2CellIDLevel10.IsNull(),
+			qm.Limit(batchSize),
+		).All(ctx, p.db)
+		if err != nil {
+			return count, fmt.Errorf("failed to find google places: %w", err)
+		}
+
+		if len(googlePlaceEntities) == 0 {
+			return count, nil
+		}
+
+		if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+			for _, googlePlaceEntity := range googlePlaceEntities {
+				s2CellIds := models.GeoLocation{Latitude: googlePlaceEntity.Latitude, Longitude: googlePlaceEntity.Longitude}.S2CellIds()
+				googlePlaceEntity.S2CellIDLevel10 = null.Uint64From(s2CellIds.Level10)
+				googlePlaceEntity.S2CellIDLevel13 = null.Uint64From(s2CellIds.Level13)
+				googlePlaceEntity.S2CellIDLevel16 = null.Uint64From(s2CellIds.Level16)
+				if _, err := googlePlaceEntity.Update(ctx, tx, boil.Whitelist(
+					generated.GooglePlaceColumns.S2CellIDLevel10,
+					generated.GooglePlaceColumns.S2CellIDLevel13,
+					generated.GooglePlaceColumns.S2CellIDLevel16,
+				)); err != nil {
+					return fmt.Errorf("failed to update google place: %w", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			return count, fmt.Errorf("failed to run transaction: %w", err)
+		}
+
+		count += len(googlePlaceEntities)
+	}
+}
+
+// BackfillS2CellIds S2 セルの ID が保存されていないプランに、位置から求めた S2 セルの ID を保存する
+// 場所を含まないプランは位置が不明なため対象外とし、batchSize 件ずつ更新して更新したプランの件数を返す
+func (p PlanRepository) BackfillS2CellIds(ctx context.Context, batchSize int) (int, error) {
+	if batchSize <= 0 {
+		return 0, fmt.Errorf("batch size must be greater than 0")
+	}
+
+	count := 0
+	for {
+		planEntities, err := generated.Plans(
+			generated.PlanWhere.S2CellIDLevel10.IsNull(),
+			qm.Where(fmt.Sprintf(
+				"EXISTS (SELECT 1 FROM %s WHERE %s.%s = %s.%s)",
+				generated.TableNames.PlanPlaces,
+				generated.TableNames.PlanPlaces,
+				generated.PlanPlaceColumns.PlanID,
+				generated.TableNames.Plans,
+				generated.PlanColumns.ID,
+			)),
+			qm.Limit(batchSize),
+		).All(ctx, p.db)
+		if err != nil {
+			return count, fmt.Errorf("failed to find plans: %w", err)
+		}
+
+		if len(planEntities) == 0 {
+			return count, nil
+		}
+
+		if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+			for _, planEntity := range planEntities {
+				s2CellIds := models.GeoLocation{Latitude: planEntity.Latitude, Longitude: planEntity.Longitude}.S2CellIds()
+				planEntity.S2CellIDLevel10 = null.Uint64From(s2CellIds.Level10)
+				planEntity.S2CellIDLevel13 = null.Uint64From(s2CellIds.Level13)
+				planEntity.S2CellIDLevel16 = null.Uint64From(s2CellIds.Level16)
+				if _, err := planEntity.Update(ctx, tx, boil.Whitelist(
+					generated.PlanColumns.S2CellIDLevel10,
+					generated.PlanColumns.S2CellIDLevel13,
+					generated.PlanColumns.S2CellIDLevel16,
+				)); err != nil {
+					return fmt.Errorf("failed to update plan: %w", err)
+				}
+			}
+			return nil
+		}); err != nil {
+			return count, fmt.Errorf("failed to run transaction: %w", err)
+		}
+
+		count += len(planEntities)
+	}
+}
@@ -0,0 +1,83 @@
+package rdb
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+// BenchmarkFindGooglePlacesNearLocation 緯度・経度の範囲による検索と S2 セルによる検索を比較する
+func BenchmarkFindGooglePlacesNearLocation(b *testing.B) {
+	const (
+		numPlaces = 5000
+		radius    = 2000
+	)
+
+	// 東京駅
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+	testContext := context.Background()
+
+	b.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			b.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	// 東京駅を中心に 約 50km 四方の範囲に場所を配置する
+	random := rand.New(rand.NewSource(0))
+	placeSlice := make(generated.PlaceSlice, 0, numPlaces)
+	googlePlaceSlice := make(generated.GooglePlaceSlice, 0, numPlaces)
+	for i := 0; i < numPlaces; i++ {
+		placeId := fmt.Sprintf("place-%d", i)
+		googlePlaceEntity := factory.NewGooglePlaceEntityFromGooglePlace(models.GooglePlace{
+			PlaceId: fmt.Sprintf("google-place-%d", i),
+			Location: models.GeoLocation{
+				Latitude:  location.Latitude + (random.Float64()-0.5)*0.45,
+				Longitude: location.Longitude + (random.Float64()-0.5)*0.55,
+			},
+		}, placeId)
+		placeSlice = append(placeSlice, &generated.Place{ID: placeId})
+		googlePlaceSlice = append(googlePlaceSlice, &googlePlaceEntity)
+	}
+
+	if _, err := placeSlice.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+		b.Fatalf("error saving places: %v", err)
+	}
+
+	if _, err := googlePlaceSlice.InsertAll(testContext, testDB, boil.Infer()); err != nil {
+		b.Fatalf("error saving google places: %v", err)
+	}
+
+	b.Run("mbr", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			minLocation, maxLocation := location.CalculateMBR(radius)
+			if _, err := generated.GooglePlaces(
+				generated.GooglePlaceWhere.Latitude.GT(minLocation.Latitude),
+				generated.GooglePlaceWhere.Latitude.LT(maxLocation.Latitude),
+				generated.GooglePlaceWhere.Longitude.GT(minLocation.Longitude),
+				generated.GooglePlaceWhere.Longitude.LT(maxLocation.Longitude),
+				qm.Select(generated.GooglePlaceColumns.GooglePlaceID, generated.GooglePlaceColumns.Latitude, generated.GooglePlaceColumns.Longitude),
+			).All(testContext, testDB); err != nil {
+				b.Fatalf("error finding google places: %v", err)
+			}
+		}
+	})
+
+	b.Run("s2 cell", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := generated.GooglePlaces(
+				googlePlaceWithinRadiusQueryMod(location, radius),
+				qm.Select(generated.GooglePlaceColumns.GooglePlaceID, generated.GooglePlaceColumns.Latitude, generated.GooglePlaceColumns.Longitude),
+			).All(testContext, testDB); err != nil {
+				b.Fatalf("error finding google places: %v", err)
+			}
+		}
+	})
+}
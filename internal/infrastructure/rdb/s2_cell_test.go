@@ -0,0 +1,288 @@
+package rdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/volatiletech/null/v8"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+func TestPlaceRepository_FindByLocation_ShouldFilterByDistance(t *testing.T) {
+	// 東京駅
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+
+	savedPlaces := []models.Place{
+		{
+			// 東京駅から約 1km
+			Id:     "place-in-range",
+			Google: models.GooglePlace{PlaceId: "google-place-in-range", Location: models.GeoLocation{Latitude: 35.6895, Longitude: 139.7649361}},
+		},
+		{
+			// 緯度・経度の範囲には含まれるが、東京駅から 2km 以上離れている
+			Id:     "place-in-corner",
+			Google: models.GooglePlace{PlaceId: "google-place-in-corner", Location: models.GeoLocation{Latitude: 35.6972, Longitude: 139.7845}},
+		},
+		{
+			// 東京駅から約 10km
+			Id:     "place-out-of-range",
+			Google: models.GooglePlace{PlaceId: "google-place-out-of-range", Location: models.GeoLocation{Latitude: 35.7712, Longitude: 139.7649361}},
+		},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	places, err := placeRepository.FindByLocation(testContext, location, 2000)
+	if err != nil {
+		t.Fatalf("error finding places: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"place-in-range"}, array.Map(places, func(place models.Place) string { return place.Id })); diff != "" {
+		t.Errorf("places mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlaceRepository_FindByLocation_ShouldFindPlacesWithoutS2CellIds(t *testing.T) {
+	// 東京駅
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+
+	savedPlaces := []models.Place{
+		{
+			// 東京駅から約 1km
+			Id:     "place-in-range",
+			Google: models.GooglePlace{PlaceId: "google-place-in-range", Location: models.GeoLocation{Latitude: 35.6895, Longitude: 139.7649361}},
+		},
+		{
+			// 東京駅から約 10km
+			Id:     "place-out-of-range",
+			Google: models.GooglePlace{PlaceId: "google-place-out-of-range", Location: models.GeoLocation{Latitude: 35.7712, Longitude: 139.7649361}},
+		},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	// バックフィルが完了していない状態にする
+	if _, err := generated.GooglePlaces().UpdateAll(testContext, testDB, generated.M{
+		generated.GooglePlaceColumns.S2CellIDLevel10: nil,
+		generated.GooglePlaceColumns.S2CellIDLevel13: nil,
+		generated.GooglePlaceColumns.S2CellIDLevel16: nil,
+	}); err != nil {
+		t.Fatalf("error clearing s2 cell ids: %v", err)
+	}
+
+	places, err := placeRepository.FindByLocation(testContext, location, 2000)
+	if err != nil {
+		t.Fatalf("error finding places: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"place-in-range"}, array.Map(places, func(place models.Place) string { return place.Id })); diff != "" {
+		t.Errorf("places mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlanRepository_FindByLocation_ShouldLimitAfterFilteringByDistance(t *testing.T) {
+	// 東京駅
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+
+	placeInRange := models.Place{
+		// 東京駅から約 1km
+		Id:       "place-in-range",
+		Location: models.GeoLocation{Latitude: 35.6895, Longitude: 139.7649361},
+		Google:   models.GooglePlace{PlaceId: "google-place-in-range", Location: models.GeoLocation{Latitude: 35.6895, Longitude: 139.7649361}},
+	}
+	placeInCorner := models.Place{
+		// 緯度・経度の範囲には含まれるが、東京駅から 2km 以上離れている
+		Id:       "place-in-corner",
+		Location: models.GeoLocation{Latitude: 35.6972, Longitude: 139.7845},
+		Google:   models.GooglePlace{PlaceId: "google-place-in-corner", Location: models.GeoLocation{Latitude: 35.6972, Longitude: 139.7845}},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing plan repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, []models.Place{placeInRange, placeInCorner}); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	if err := savePlans(testContext, testDB, []models.Plan{
+		{Id: "plan-in-range", Places: []models.Place{placeInRange}},
+		{Id: "plan-in-corner", Places: []models.Place{placeInCorner}},
+	}); err != nil {
+		t.Fatalf("error saving plans: %v", err)
+	}
+
+	// 範囲外のプランの方が新しい状態にする
+	now := time.Now()
+	for planId, createdAt := range map[string]time.Time{"plan-in-range": now.Add(-time.Hour), "plan-in-corner": now} {
+		if _, err := generated.Plans(generated.PlanWhere.ID.EQ(planId)).UpdateAll(testContext, testDB, generated.M{
+			generated.PlanColumns.CreatedAt: createdAt,
+		}); err != nil {
+			t.Fatalf("error updating created at: %v", err)
+		}
+	}
+
+	plans, _, err := planRepository.FindByLocation(testContext, location, 1, 2000)
+	if err != nil {
+		t.Fatalf("error finding plans: %v", err)
+	}
+
+	if diff := cmp.Diff([]string{"plan-in-range"}, array.Map(*plans, func(plan models.Plan) string { return plan.Id })); diff != "" {
+		t.Errorf("plans mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPlaceRepository_BackfillS2CellIds(t *testing.T) {
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+	savedPlaces := []models.Place{
+		{Id: "place-1", Google: models.GooglePlace{PlaceId: "google-place-1", Location: location}},
+		{Id: "place-2", Google: models.GooglePlace{PlaceId: "google-place-2", Location: location}},
+		{Id: "place-3", Google: models.GooglePlace{PlaceId: "google-place-3", Location: location}},
+	}
+
+	placeRepository, err := NewPlaceRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing place repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, savedPlaces); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	// S2 セルの ID が保存されていない状態にする
+	if _, err := generated.GooglePlaces().UpdateAll(testContext, testDB, generated.M{
+		generated.GooglePlaceColumns.S2CellIDLevel10: nil,
+		generated.GooglePlaceColumns.S2CellIDLevel13: nil,
+		generated.GooglePlaceColumns.S2CellIDLevel16: nil,
+	}); err != nil {
+		t.Fatalf("error clearing s2 cell ids: %v", err)
+	}
+
+	count, err := placeRepository.BackfillS2CellIds(testContext, 2)
+	if err != nil {
+		t.Fatalf("error backfilling s2 cell ids: %v", err)
+	}
+
+	if diff := cmp.Diff(len(savedPlaces), count); diff != "" {
+		t.Errorf("count mismatch (-want +got):\n%s", diff)
+	}
+
+	googlePlaceEntities, err := generated.GooglePlaces().All(testContext, testDB)
+	if err != nil {
+		t.Fatalf("error finding google places: %v", err)
+	}
+
+	s2CellIds := location.S2CellIds()
+	for _, googlePlaceEntity := range googlePlaceEntities {
+		if diff := cmp.Diff(
+			[]null.Uint64{null.Uint64From(s2CellIds.Level10), null.Uint64From(s2CellIds.Level13), null.Uint64From(s2CellIds.Level16)},
+			[]null.Uint64{googlePlaceEntity.S2CellIDLevel10, googlePlaceEntity.S2CellIDLevel13, googlePlaceEntity.S2CellIDLevel16},
+		); diff != "" {
+			t.Errorf("s2 cell ids mismatch (-want +got):\n%s", diff)
+		}
+	}
+}
+
+func TestPlanRepository_BackfillS2CellIds(t *testing.T) {
+	location := models.GeoLocation{Latitude: 35.6812362, Longitude: 139.7649361}
+	savedPlace := models.Place{Id: "place-1", Location: location, Google: models.GooglePlace{PlaceId: "google-place-1", Location: location}}
+	savedPlans := []models.Plan{
+		{Id: "plan-with-place", Places: []models.Place{savedPlace}},
+		{Id: "plan-without-place", Places: []models.Place{}},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Fatalf("error while initializing plan repository: %v", err)
+	}
+
+	testContext := context.Background()
+	t.Cleanup(func() {
+		if err := cleanup(testContext, testDB); err != nil {
+			t.Errorf("error cleaning up: %v", err)
+		}
+	})
+
+	if err := savePlaces(testContext, testDB, []models.Place{savedPlace}); err != nil {
+		t.Fatalf("error saving places: %v", err)
+	}
+
+	if err := savePlans(testContext, testDB, savedPlans); err != nil {
+		t.Fatalf("error saving plans: %v", err)
+	}
+
+	// S2 セルの ID が保存されていない状態にする
+	if _, err := generated.Plans().UpdateAll(testContext, testDB, generated.M{
+		generated.PlanColumns.S2CellIDLevel10: nil,
+		generated.PlanColumns.S2CellIDLevel13: nil,
+		generated.PlanColumns.S2CellIDLevel16: nil,
+	}); err != nil {
+		t.Fatalf("error clearing s2 cell ids: %v", err)
+	}
+
+	count, err := planRepository.BackfillS2CellIds(testContext, 10)
+	if err != nil {
+		t.Fatalf("error backfilling s2 cell ids: %v", err)
+	}
+
+	// 場所を含まないプランは対象外
+	if diff := cmp.Diff(1, count); diff != "" {
+		t.Errorf("count mismatch (-want +got):\n%s", diff)
+	}
+
+	planEntity, err := generated.FindPlan(testContext, testDB, "plan-with-place")
+	if err != nil {
+		t.Fatalf("error finding plan: %v", err)
+	}
+
+	if diff := cmp.Diff(null.Uint64From(location.S2CellIds().Level13), planEntity.S2CellIDLevel13); diff != "" {
+		t.Errorf("s2 cell id mismatch (-want +got):\n%s", diff)
+	}
+}
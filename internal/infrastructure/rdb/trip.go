@@ -0,0 +1,192 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/array"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/entities"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/factory"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+)
+
+// SaveTrip 旅行と1日ごとのプランを保存する
+func (p PlanRepository) SaveTrip(ctx context.Context, trip models.Trip) error {
+	if len(trip.Plans) == 0 {
+		return fmt.Errorf("trip plans is empty")
+	}
+
+	for _, plan := range trip.Plans {
+		if len(plan.Places) == 0 {
+			return fmt.Errorf("plan places is empty")
+		}
+	}
+
+	if err := runTransaction(ctx, p, func(ctx context.Context, tx *sql.Tx) error {
+		tripEntity := factory.NewTripEntityFromDomainModel(trip)
+		if err := tripEntity.Insert(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert trip: %w", err)
+		}
+
+		for _, plan := range trip.Plans {
+			if err := savePlan(ctx, tx, plan); err != nil {
+				return err
+			}
+		}
+
+		trip.Id = tripEntity.ID
+		tripPlanSlice := factory.NewTripPlanSliceFromDomainModel(trip)
+		if _, err := tripPlanSlice.InsertAll(ctx, tx, boil.Infer()); err != nil {
+			return fmt.Errorf("failed to insert trip plans: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindTrip 旅行と1日ごとのプランを日付順に取得する
+// viewerId のユーザーが閲覧できないプランは旅行に含めない
+// 旅行が存在しない場合は nil を返す
+func (p PlanRepository) FindTrip(ctx context.Context, tripId string, viewerId *string) (*models.Trip, error) {
+	tripEntity, err := generated.Trips(
+		generated.TripWhere.ID.EQ(tripId),
+		qm.Load(generated.TripRels.TripPlans),
+		qm.Load(generated.TripRels.User),
+	).One(ctx, p.db)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find trip: %w", err)
+	}
+
+	if tripEntity.R == nil {
+		return nil, fmt.Errorf("tripEntity.R is nil")
+	}
+
+	tripPlans := tripEntity.R.TripPlans
+	sort.Slice(tripPlans, func(i, j int) bool {
+		return tripPlans[i].DayIndex < tripPlans[j].DayIndex
+	})
+
+	plans, err := p.findPlansOfTrip(ctx, tripPlans, viewerId)
+	if err != nil {
+		return nil, err
+	}
+
+	var author *models.User
+	if tripEntity.R.User != nil {
+		author = factory.NewUserFromUserEntity(*tripEntity.R.User)
+	}
+
+	return factory.NewTripFromEntity(*tripEntity, plans, author), nil
+}
+
+// findPlansOfTrip 旅行に含まれるプランをまとめて取得し、tripPlans の順に並べる
+// viewerId のユーザーが閲覧できないプランは含めない
+func (p PlanRepository) findPlansOfTrip(ctx context.Context, tripPlans generated.TripPlanSlice, viewerId *string) ([]models.Plan, error) {
+	if len(tripPlans) == 0 {
+		return []models.Plan{}, nil
+	}
+
+	planEntities, err := generated.Plans(concatQueryMod(
+		[]qm.QueryMod{
+			generated.PlanWhere.ID.IN(array.Map(tripPlans, func(tripPlan *generated.TripPlan) string {
+				return tripPlan.PlanID
+			})),
+			planVisibleTo(viewerId),
+			qm.Load(generated.PlanRels.PlanPlaces),
+			qm.Load(generated.PlanRels.User),
+		},
+		placeQueryModes(generated.PlanRels.PlanPlaces, generated.PlanPlaceRels.Place),
+	)...).All(ctx, p.db)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find plans of trip: %w", err)
+	}
+
+	planCandidateSetPlaceLikeCounts, err := countPlaceLikeCounts(ctx, p.db, array.FlatMap(planEntities, func(planEntity *generated.Plan) []string {
+		if planEntity.R == nil {
+			return nil
+		}
+
+		return array.Map(planEntity.R.PlanPlaces, func(planPlace *generated.PlanPlace) string {
+			return planPlace.PlaceID
+		})
+	})...)
+	if err != nil {
+		// いいね数の取得に失敗してもエラーにしない
+		p.logger.Warn("failed to count place like counts", zap.Error(err))
+	}
+
+	planEntityById := make(map[string]*generated.Plan, len(planEntities))
+	for _, planEntity := range planEntities {
+		planEntityById[planEntity.ID] = planEntity
+	}
+
+	plans := make([]models.Plan, 0, len(tripPlans))
+	for _, tripPlan := range tripPlans {
+		planEntity, ok := planEntityById[tripPlan.PlanID]
+		if !ok {
+			// 閲覧できないプランは含めない
+			continue
+		}
+
+		if planEntity.R == nil {
+			return nil, fmt.Errorf("planEntity.R is nil")
+		}
+
+		places, err := array.MapWithErr(planEntity.R.PlanPlaces, func(planPlace *generated.PlanPlace) (*models.Place, error) {
+			if planPlace.R == nil {
+				return nil, fmt.Errorf("planPlace.R is nil")
+			}
+
+			if planPlace.R.Place == nil {
+				return nil, fmt.Errorf("planPlace.R.Place is nil")
+			}
+
+			if len(planPlace.R.Place.R.GooglePlaces) == 0 || planPlace.R.Place.R.GooglePlaces[0].R == nil {
+				return nil, fmt.Errorf("planPlace.R.Place.R.GooglePlaces is nil")
+			}
+
+			return factory.NewPlaceFromEntity(
+				*planPlace.R.Place,
+				planPlace.R.Place.R.PlacePhotos,
+				*planPlace.R.Place.R.GooglePlaces[0],
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlaceTypes,
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlacePhotoReferences,
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlacePhotoAttributions,
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlacePhotos,
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlaceReviews,
+				planPlace.R.Place.R.GooglePlaces[0].R.GooglePlaceOpeningPeriods,
+				entities.CountLikeOfPlace(planCandidateSetPlaceLikeCounts, planPlace.PlaceID),
+			)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to map plan places: %w", err)
+		}
+
+		var author *models.User
+		if planEntity.R.User != nil {
+			author = factory.NewUserFromUserEntity(*planEntity.R.User)
+		}
+
+		plan, err := factory.NewPlanFromEntity(*planEntity, planEntity.R.PlanPlaces, *places, author)
+		if err != nil {
+			return nil, fmt.Errorf("failed to map plan of trip: %w", err)
+		}
+		plans = append(plans, *plan)
+	}
+
+	return plans, nil
+}
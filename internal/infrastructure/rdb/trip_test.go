@@ -0,0 +1,123 @@
+package rdb
+
+import (
+	"context"
+	"github.com/google/go-cmp/cmp"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/infrastructure/rdb/generated"
+	"testing"
+	"time"
+)
+
+func TestPlanRepository_SaveTrip(t *testing.T) {
+	startTime := time.Date(2024, 7, 6, 10, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		name        string
+		savedUsers  generated.UserSlice
+		savedPlaces []models.Place
+		trip        models.Trip
+		expected    models.Trip
+	}{
+		{
+			name: "should save trip with plans ordered by day",
+			savedUsers: generated.UserSlice{
+				{ID: "user_id_1", FirebaseUID: "firebase_uid_1"},
+			},
+			savedPlaces: []models.Place{
+				{Id: "place_id_1", Google: models.GooglePlace{PlaceId: "google_place_id_1"}},
+				{Id: "lodging_id_1", Google: models.GooglePlace{PlaceId: "google_lodging_id_1"}},
+				{Id: "place_id_2", Google: models.GooglePlace{PlaceId: "google_place_id_2"}},
+			},
+			trip: models.Trip{
+				Id:   "trip_id_1",
+				Name: "2日間の旅",
+				Plans: []models.Plan{
+					{
+						Id:     "plan_day_1",
+						Name:   "day 1",
+						Places: []models.Place{{Id: "place_id_1"}, {Id: "lodging_id_1"}},
+						Author: &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+					},
+					{
+						Id:     "plan_day_2",
+						Name:   "day 2",
+						Places: []models.Place{{Id: "place_id_2"}},
+						Author: &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+					},
+				},
+				Author:     &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+				StartTime:  &startTime,
+				TravelMode: models.TravelModeTransit,
+			},
+			expected: models.Trip{
+				Id:   "trip_id_1",
+				Name: "2日間の旅",
+				Plans: []models.Plan{
+					{
+						Id:   "plan_day_1",
+						Name: "day 1",
+						Places: []models.Place{
+							{Id: "place_id_1", Google: models.GooglePlace{PlaceId: "google_place_id_1"}},
+							{Id: "lodging_id_1", Google: models.GooglePlace{PlaceId: "google_lodging_id_1"}},
+						},
+						Author:     &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+						Visibility: models.PlanVisibilityPublic,
+					},
+					{
+						Id:   "plan_day_2",
+						Name: "day 2",
+						Places: []models.Place{
+							{Id: "place_id_2", Google: models.GooglePlace{PlaceId: "google_place_id_2"}},
+						},
+						Author:     &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+						Visibility: models.PlanVisibilityPublic,
+					},
+				},
+				Author:     &models.User{Id: "user_id_1", FirebaseUID: "firebase_uid_1"},
+				StartTime:  &startTime,
+				TravelMode: models.TravelModeTransit,
+			},
+		},
+	}
+
+	planRepository, err := NewPlanRepository(testDB)
+	if err != nil {
+		t.Errorf("error initializing plan repository: %v", err)
+	}
+
+	for _, c := range cases {
+		c := c
+		testContext := context.Background()
+		t.Run(c.name, func(t *testing.T) {
+			t.Cleanup(func() {
+				if err := cleanup(testContext, planRepository.GetDB()); err != nil {
+					t.Errorf("error cleaning up: %v", err)
+				}
+			})
+
+			// 事前にデータを保存
+			if _, err := c.savedUsers.InsertAll(testContext, planRepository.GetDB(), boil.Infer()); err != nil {
+				t.Errorf("error saving user: %v", err)
+			}
+
+			if err := savePlaces(testContext, planRepository.GetDB(), c.savedPlaces); err != nil {
+				t.Errorf("error saving places: %v", err)
+			}
+
+			if err := planRepository.SaveTrip(testContext, c.trip); err != nil {
+				t.Fatalf("error saving trip: %v", err)
+			}
+
+			trip, err := planRepository.FindTrip(testContext, c.trip.Id, nil)
+			if err != nil {
+				t.Fatalf("error finding trip: %v", err)
+			}
+
+			if diff := cmp.Diff(c.expected, *trip); diff != "" {
+				t.Errorf("trip mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -114,3 +114,26 @@ func (u UserRepository) UpdateProfile(ctx context.Context, userId string, name *
 
 	return nil
 }
+
+func (u UserRepository) UpdatePersonalizationDisabled(ctx context.Context, userId string, disabled bool) error {
+	if err := runTransaction(ctx, u, func(ctx context.Context, tx *sql.Tx) error {
+		userEntity, err := generated.FindUser(ctx, tx, userId)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return fmt.Errorf("user not found")
+			}
+			return fmt.Errorf("error while finding user: %v", err)
+		}
+
+		userEntity.PersonalizationDisabled = disabled
+		if _, err := userEntity.Update(ctx, tx, boil.Whitelist(generated.UserColumns.PersonalizationDisabled)); err != nil {
+			return fmt.Errorf("error while updating user: %v", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("error while updating user personalization: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,13 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func BudgetFromDomainModel(budget models.Budget) *graphql.Budget {
+	return &graphql.Budget{
+		Min: budget.Min,
+		Max: budget.Max,
+	}
+}
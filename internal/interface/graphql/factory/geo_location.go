@@ -0,0 +1,18 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// GeoLocationToDomainModel 座標が指定されていない場合は nil を返す
+func GeoLocationToDomainModel(location *graphql.GeoLocationInput) *models.GeoLocation {
+	if location == nil {
+		return nil
+	}
+
+	return &models.GeoLocation{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
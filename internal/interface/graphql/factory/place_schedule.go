@@ -0,0 +1,14 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func PlaceScheduleFromDomainModel(schedule models.PlaceSchedule) *graphql.PlaceSchedule {
+	return &graphql.PlaceSchedule{
+		PlaceID:     schedule.PlaceId,
+		ArrivalAt:   schedule.ArrivalAt,
+		DepartureAt: schedule.DepartureAt,
+	}
+}
@@ -1,20 +1,25 @@
 package factory
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"go.uber.org/zap"
 	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+	"time"
 
 	"poroto.app/poroto/planner/internal/domain/models"
 )
 
-func PlansFromDomainModel(plans *[]models.Plan, startLocation *models.GeoLocation) []*graphql.Plan {
+// PlansFromDomainModel 移動時間は routingProvider を用いて travelMode で移動したときの時間を求める
+// endLocation が指定された場合は、最後の場所から到着地点への移動も含める
+// startTime が指定された場合は、各場所への到着・出発時刻も含める
+func PlansFromDomainModel(ctx context.Context, logger *zap.Logger, routingProvider models.RoutingProvider, plans *[]models.Plan, startLocation *models.GeoLocation, endLocation *models.GeoLocation, startTime *time.Time, travelMode models.TravelMode) []*graphql.Plan {
 	graphqlPlans := make([]*graphql.Plan, 0)
 
 	for _, plan := range *plans {
-		graphqlPlan, err := PlanFromDomainModel(plan, startLocation)
+		graphqlPlan, err := PlanFromDomainModel(ctx, logger, routingProvider, plan, startLocation, endLocation, startTime, travelMode)
 		if err != nil {
-			log.Println("error while converting plan to graphql model: ", err)
+			logger.Warn("error while converting plan to graphql model", zap.String("planId", plan.Id), zap.Error(err))
 			continue
 		}
 		graphqlPlans = append(graphqlPlans, graphqlPlan)
@@ -23,14 +28,20 @@ func PlansFromDomainModel(plans *[]models.Plan, startLocation *models.GeoLocatio
 	return graphqlPlans
 }
 
-func PlanFromDomainModel(plan models.Plan, startLocation *models.GeoLocation) (*graphql.Plan, error) {
+func PlanFromDomainModel(ctx context.Context, logger *zap.Logger, routingProvider models.RoutingProvider, plan models.Plan, startLocation *models.GeoLocation, endLocation *models.GeoLocation, startTime *time.Time, travelMode models.TravelMode) (*graphql.Plan, error) {
 	places := make([]*graphql.Place, len(plan.Places))
 	for i, place := range plan.Places {
 		places[i] = PlaceFromDomainModel(&place)
 	}
 
-	transitions := plan.Transitions(startLocation)
-	graphqlTransitionEntities := make([]*graphql.Transition, len(plan.Transitions(startLocation)))
+	transitions, err := plan.TransitionsWithRouting(ctx, routingProvider, startLocation, travelMode)
+	if err != nil {
+		// 経路探索に失敗した場合は、直線距離から移動時間を推定する
+		logger.Warn("error while calculating transitions with routing provider", zap.String("planId", plan.Id), zap.Error(err))
+		transitions = plan.Transitions(startLocation, travelMode)
+	}
+
+	graphqlTransitionEntities := make([]*graphql.Transition, len(transitions))
 	for i, t := range transitions {
 		var placeFrom *models.Place
 		if t.FromPlaceId != nil {
@@ -52,6 +63,25 @@ func PlanFromDomainModel(plan models.Plan, startLocation *models.GeoLocation) (*
 		}
 	}
 
+	// 到着地点への移動は場所間の移動と区別して返す
+	var graphqlTransitionToEndLocation *graphql.TransitionToEndLocation
+	timeInMinutes := plan.TimeInMinutesWithTransitions(transitions)
+	if endLocation != nil && len(plan.Places) > 0 {
+		transitionToEndLocation := models.CreateTransitionToEndLocation(ctx, routingProvider, plan.Places, *endLocation, travelMode)
+		graphqlTransitionToEndLocation, err = TransitionToEndLocationFromDomainModel(transitionToEndLocation, plan.Places)
+		if err != nil {
+			return nil, fmt.Errorf("error while converting transition to end location: %v", err)
+		}
+		timeInMinutes += transitionToEndLocation.Duration
+	}
+
+	graphqlPlaceSchedules := make([]*graphql.PlaceSchedule, 0)
+	if startTime != nil {
+		for _, schedule := range models.CreatePlaceSchedules(plan.Places, transitions, *startTime) {
+			graphqlPlaceSchedules = append(graphqlPlaceSchedules, PlaceScheduleFromDomainModel(schedule))
+		}
+	}
+
 	var author *graphql.User
 	if plan.Author != nil {
 		author = UserFromDomainModel(plan.Author)
@@ -65,12 +95,18 @@ func PlanFromDomainModel(plan models.Plan, startLocation *models.GeoLocation) (*
 	}
 
 	return &graphql.Plan{
-		ID:            plan.Id,
-		Name:          plan.Name,
-		Places:        places,
-		TimeInMinutes: int(plan.TimeInMinutes(startLocation)),
-		Transitions:   graphqlTransitionEntities,
-		Author:        author,
-		Collage:       collage,
+		ID:                      plan.Id,
+		Name:                    plan.Name,
+		Description:             plan.Description,
+		Places:                  places,
+		TimeInMinutes:           int(timeInMinutes),
+		Transitions:             graphqlTransitionEntities,
+		TransitionToEndLocation: graphqlTransitionToEndLocation,
+		Schedules:               graphqlPlaceSchedules,
+		EstimatedBudget:         BudgetFromDomainModel(plan.EstimatedBudget()),
+		Author:                  author,
+		Collage:                 collage,
+		Version:                 plan.Version,
+		Visibility:              PlanVisibilityFromDomainModel(plan.Visibility),
 	}, nil
 }
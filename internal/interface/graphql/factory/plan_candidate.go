@@ -1,19 +1,22 @@
 package factory
 
 import (
+	"context"
+	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
 	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
 )
 
-func PlanCandidateSetFromDomainModel(planCandidateSet *models.PlanCandidateSet) *graphql.PlanCandidate {
+func PlanCandidateSetFromDomainModel(ctx context.Context, logger *zap.Logger, routingProvider models.RoutingProvider, planCandidateSet *models.PlanCandidateSet) *graphql.PlanCandidate {
 	if planCandidateSet == nil {
 		return nil
 	}
 
 	return &graphql.PlanCandidate{
 		ID:                            planCandidateSet.Id,
-		Plans:                         PlansFromDomainModel(&planCandidateSet.Plans, planCandidateSet.MetaData.GetLocationStart()),
+		Plans:                         PlansFromDomainModel(ctx, logger, routingProvider, &planCandidateSet.Plans, planCandidateSet.MetaData.GetLocationStart(), planCandidateSet.MetaData.LocationEnd, planCandidateSet.MetaData.StartTime, planCandidateSet.MetaData.GetTravelMode()),
 		LikedPlaceIds:                 planCandidateSet.LikedPlaceIds,
 		CreatedBasedOnCurrentLocation: planCandidateSet.MetaData.CreatedBasedOnCurrentLocation,
+		TravelMode:                    TravelModeFromDomainModel(planCandidateSet.MetaData.GetTravelMode()),
 	}
 }
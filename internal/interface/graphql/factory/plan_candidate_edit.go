@@ -0,0 +1,30 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func PlanCandidateEditFromDomainModel(history *models.PlanCandidateEditHistory) *graphql.PlanCandidateEdit {
+	if history == nil {
+		return nil
+	}
+
+	return &graphql.PlanCandidateEdit{
+		ID:             history.Id,
+		PlanID:         history.PlanId,
+		Type:           graphql.PlanCandidateEditType(history.Type),
+		PlaceIdsBefore: history.PlaceIdsBefore,
+		PlaceIdsAfter:  history.PlaceIdsAfter,
+		IsUndone:       history.IsUndone,
+		CreatedAt:      history.CreatedAt,
+	}
+}
+
+func PlanCandidateEditsFromDomainModel(histories models.PlanCandidateEditHistories) []*graphql.PlanCandidateEdit {
+	edits := make([]*graphql.PlanCandidateEdit, len(histories))
+	for i := range histories {
+		edits[i] = PlanCandidateEditFromDomainModel(&histories[i])
+	}
+	return edits
+}
@@ -0,0 +1,16 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func PlanCandidateUpdatedEventFromDomainModel(event models.PlanCandidateEvent, plan *graphql.Plan) *graphql.PlanCandidateUpdatedEvent {
+	return &graphql.PlanCandidateUpdatedEvent{
+		PlanCandidateID: event.PlanCandidateSetId,
+		Type:            graphql.PlanCandidateUpdatedEventType(event.Type),
+		Plan:            plan,
+		PlaceID:         event.PlaceId,
+		OccurredAt:      event.OccurredAt,
+	}
+}
@@ -0,0 +1,71 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// PlanCandidateGroupFromDomainModel は参加者の Token を含めずにグループを変換する
+func PlanCandidateGroupFromDomainModel(group models.PlanCandidateGroup, winningPlan *models.Plan) *graphql.PlanCandidateGroup {
+	participants := make([]*graphql.PlanCandidateGroupParticipant, len(group.Participants))
+	for i, participant := range group.Participants {
+		participants[i] = PlanCandidateGroupParticipantFromDomainModel(participant)
+	}
+
+	planVotes := newVoteCounts()
+	for _, vote := range group.PlanVotes {
+		planVotes.add(vote.PlanId, vote.ParticipantId)
+	}
+
+	placeVotes := newVoteCounts()
+	for _, vote := range group.PlaceVotes {
+		placeVotes.add(vote.PlaceId, vote.ParticipantId)
+	}
+
+	var winningPlanId *string
+	if winningPlan != nil {
+		winningPlanId = &winningPlan.Id
+	}
+
+	return &graphql.PlanCandidateGroup{
+		PlanCandidateID: group.PlanCandidateSetId,
+		ShareToken:      group.ShareToken,
+		Participants:    participants,
+		PlanVotes:       planVotes.counts,
+		PlaceVotes:      placeVotes.counts,
+		WinningPlanID:   winningPlanId,
+	}
+}
+
+func PlanCandidateGroupParticipantFromDomainModel(participant models.PlanCandidateGroupParticipant) *graphql.PlanCandidateGroupParticipant {
+	return &graphql.PlanCandidateGroupParticipant{
+		ID:          participant.Id,
+		UserID:      participant.UserId,
+		DisplayName: participant.DisplayName,
+	}
+}
+
+// voteCounts は投票を、投票された順にプランまたは場所ごとに集計する
+type voteCounts struct {
+	counts []*graphql.PlanCandidateGroupVoteCount
+}
+
+func newVoteCounts() *voteCounts {
+	return &voteCounts{counts: make([]*graphql.PlanCandidateGroupVoteCount, 0)}
+}
+
+func (v *voteCounts) add(id string, participantId string) {
+	for _, count := range v.counts {
+		if count.ID == id {
+			count.Count++
+			count.ParticipantIds = append(count.ParticipantIds, participantId)
+			return
+		}
+	}
+
+	v.counts = append(v.counts, &graphql.PlanCandidateGroupVoteCount{
+		ID:             id,
+		Count:          1,
+		ParticipantIds: []string{participantId},
+	})
+}
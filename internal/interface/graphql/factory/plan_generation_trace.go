@@ -0,0 +1,35 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func PlanGenerationTraceFromDomainModel(trace models.PlanGenerationTrace) *graphql.PlanGenerationTrace {
+	basePlaces := make([]*graphql.PlanGenerationTraceBasePlace, len(trace.BasePlaces))
+	for i, basePlace := range trace.BasePlaces {
+		rejectedPlaces := make([]*graphql.PlanGenerationTraceRejectedPlace, len(basePlace.RejectedPlaces))
+		for j, rejectedPlace := range basePlace.RejectedPlaces {
+			rejectedPlaces[j] = &graphql.PlanGenerationTraceRejectedPlace{
+				PlaceID:   rejectedPlace.PlaceId,
+				PlaceName: rejectedPlace.PlaceName,
+				Reason:    graphql.PlanGenerationRejectReason(rejectedPlace.Reason),
+			}
+		}
+
+		basePlaces[i] = &graphql.PlanGenerationTraceBasePlace{
+			PlaceID:         basePlace.PlaceId,
+			PlaceName:       basePlace.PlaceName,
+			SearchRadius:    basePlace.SearchRadius,
+			PlanCreated:     basePlace.PlanCreated,
+			Selected:        basePlace.Selected,
+			NumPlacesInPlan: basePlace.NumPlacesInPlan,
+			Error:           basePlace.Error,
+			RejectedPlaces:  rejectedPlaces,
+		}
+	}
+
+	return &graphql.PlanGenerationTrace{
+		BasePlaces: basePlaces,
+	}
+}
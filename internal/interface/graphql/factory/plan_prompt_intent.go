@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func PlanPromptIntentFromDomainModel(intent models.PlanPromptIntent) *graphql.PlanPromptIntent {
+	return &graphql.PlanPromptIntent{
+		Prompt:              intent.Prompt,
+		CategoriesPreferred: intent.CategoriesPreferred,
+		CategoriesDisliked:  intent.CategoriesDisliked,
+		FreeTime:            intent.FreeTime,
+		BudgetMax:           intent.BudgetMax,
+		StartTime:           intent.StartTime,
+	}
+}
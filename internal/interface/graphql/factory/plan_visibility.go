@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/utils"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// PlanVisibilityFromDomainModel 保存されていないプランの場合は nil を返す
+func PlanVisibilityFromDomainModel(visibility models.PlanVisibility) *graphql.PlanVisibility {
+	switch visibility {
+	case models.PlanVisibilityPublic:
+		return utils.ToPointer(graphql.PlanVisibilityPublic)
+	case models.PlanVisibilityUnlisted:
+		return utils.ToPointer(graphql.PlanVisibilityUnlisted)
+	case models.PlanVisibilityPrivate:
+		return utils.ToPointer(graphql.PlanVisibilityPrivate)
+	default:
+		return nil
+	}
+}
+
+func PlanVisibilityToDomainModel(visibility graphql.PlanVisibility) models.PlanVisibility {
+	switch visibility {
+	case graphql.PlanVisibilityUnlisted:
+		return models.PlanVisibilityUnlisted
+	case graphql.PlanVisibilityPrivate:
+		return models.PlanVisibilityPrivate
+	default:
+		return models.PlanVisibilityPublic
+	}
+}
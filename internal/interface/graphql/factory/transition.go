@@ -32,3 +32,21 @@ func TransitionFromDomainModel(transition models.Transition, places []models.Pla
 		Duration: int(transition.Duration),
 	}, nil
 }
+
+func TransitionToEndLocationFromDomainModel(transition models.TransitionToEndLocation, places []models.Place) (*graphql.TransitionToEndLocation, error) {
+	placeFrom, found := array.Find(places, func(p models.Place) bool {
+		return p.Id == transition.FromPlaceId
+	})
+	if !found {
+		return nil, fmt.Errorf("could not find place %s", transition.FromPlaceId)
+	}
+
+	return &graphql.TransitionToEndLocation{
+		From: PlaceFromDomainModel(&placeFrom),
+		Location: &graphql.GeoLocation{
+			Latitude:  transition.Location.Latitude,
+			Longitude: transition.Location.Longitude,
+		},
+		Duration: int(transition.Duration),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package factory
+
+import (
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+func TravelModeFromDomainModel(travelMode models.TravelMode) graphql.TravelMode {
+	switch travelMode {
+	case models.TravelModeCycling:
+		return graphql.TravelModeCycling
+	case models.TravelModeTransit:
+		return graphql.TravelModeTransit
+	case models.TravelModeDriving:
+		return graphql.TravelModeDriving
+	default:
+		return graphql.TravelModeWalking
+	}
+}
+
+// TravelModeToDomainModel 指定がない場合は徒歩として扱う
+func TravelModeToDomainModel(travelMode *graphql.TravelMode) models.TravelMode {
+	if travelMode == nil {
+		return models.TravelModeWalking
+	}
+
+	switch *travelMode {
+	case graphql.TravelModeCycling:
+		return models.TravelModeCycling
+	case graphql.TravelModeTransit:
+		return models.TravelModeTransit
+	case graphql.TravelModeDriving:
+		return models.TravelModeDriving
+	default:
+		return models.TravelModeWalking
+	}
+}
@@ -0,0 +1,32 @@
+package factory
+
+import (
+	"context"
+	"fmt"
+	"go.uber.org/zap"
+
+	"poroto.app/poroto/planner/internal/domain/models"
+	graphql "poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// TripFromDomainModel 旅行を GraphQL のモデルに変換する
+// 1日ごとのプランは、その日の開始時刻をもとにスケジュールを計算する
+func TripFromDomainModel(ctx context.Context, logger *zap.Logger, routingProvider models.RoutingProvider, trip models.Trip) (*graphql.Trip, error) {
+	plans := make([]*graphql.Plan, 0, len(trip.Plans))
+	for day, plan := range trip.Plans {
+		graphqlPlan, err := PlanFromDomainModel(ctx, logger, routingProvider, plan, nil, nil, trip.StartTimeOfDay(day), trip.TravelMode)
+		if err != nil {
+			return nil, fmt.Errorf("error while converting plan of day %d: %w", day+1, err)
+		}
+		plans = append(plans, graphqlPlan)
+	}
+
+	return &graphql.Trip{
+		ID:         trip.Id,
+		Name:       trip.Name,
+		Plans:      plans,
+		Author:     UserFromDomainModel(trip.Author),
+		StartTime:  trip.StartTime,
+		TravelMode: TravelModeFromDomainModel(trip.TravelMode),
+	}, nil
+}
@@ -14,5 +14,7 @@ func UserFromDomainModel(user *models.User) *graphql.User {
 		ID:       user.Id,
 		Name:     user.Name,
 		PhotoURL: user.PhotoUrl,
+
+		PersonalizationDisabled: user.PersonalizationDisabled,
 	}
 }
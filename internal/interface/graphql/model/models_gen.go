@@ -6,8 +6,18 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
+type AddPlaceToPlanAfterPlaceInput struct {
+	PlanID            string `json:"planId"`
+	Version           int    `json:"version"`
+	UserID            string `json:"userId"`
+	FirebaseAuthToken string `json:"firebaseAuthToken"`
+	PreviousPlaceID   string `json:"previousPlaceId"`
+	PlaceID           string `json:"placeId"`
+}
+
 type AddPlaceToPlanCandidateAfterPlaceInput struct {
 	PlanCandidateID string `json:"planCandidateId"`
 	PlanID          string `json:"planId"`
@@ -23,11 +33,14 @@ type AddPlaceToPlanCandidateAfterPlaceOutput struct {
 type AutoReorderPlacesInPlanCandidateInput struct {
 	PlanCandidateID string `json:"planCandidateId"`
 	PlanID          string `json:"planId"`
+	FixLastPlace    *bool  `json:"fixLastPlace,omitempty"`
+	ReturnToStart   *bool  `json:"returnToStart,omitempty"`
 }
 
 type AutoReorderPlacesInPlanCandidateOutput struct {
-	PlanCandidateID string `json:"planCandidateId"`
-	Plan            *Plan  `json:"plan"`
+	PlanCandidateID    string `json:"planCandidateId"`
+	Plan               *Plan  `json:"plan"`
+	TimeSavedInMinutes int    `json:"timeSavedInMinutes"`
 }
 
 type AvailablePlacesForPlan struct {
@@ -48,6 +61,11 @@ type BindPlanCandidateSetToUserOutput struct {
 	User *User `json:"user"`
 }
 
+type Budget struct {
+	Min int `json:"min"`
+	Max int `json:"max"`
+}
+
 type CategoryGroupedPlaces struct {
 	Category *PlaceCategory `json:"category"`
 	Places   []*Place       `json:"places"`
@@ -65,11 +83,22 @@ type ChangePlacesOrderInPlanCandidateOutput struct {
 	Plan *Plan `json:"plan"`
 }
 
+type ChangePlacesOrderInPlanInput struct {
+	PlanID            string   `json:"planId"`
+	Version           int      `json:"version"`
+	UserID            string   `json:"userId"`
+	FirebaseAuthToken string   `json:"firebaseAuthToken"`
+	PlaceIds          []string `json:"placeIds"`
+}
+
 type CreatePlanByCategoryInput struct {
-	CategoryID string  `json:"categoryId"`
-	Latitude   float64 `json:"latitude"`
-	Longitude  float64 `json:"longitude"`
-	RadiusInKm float64 `json:"radiusInKm"`
+	CategoryID     string      `json:"categoryId"`
+	Latitude       float64     `json:"latitude"`
+	Longitude      float64     `json:"longitude"`
+	RadiusInKm     float64     `json:"radiusInKm"`
+	TravelMode     *TravelMode `json:"travelMode,omitempty"`
+	BudgetMax      *int        `json:"budgetMax,omitempty"`
+	NumberOfPeople *int        `json:"numberOfPeople,omitempty"`
 }
 
 type CreatePlanByCategoryOutput struct {
@@ -90,19 +119,27 @@ type CreatePlanByGooglePlaceIDOutput struct {
 }
 
 type CreatePlanByLocationInput struct {
-	Session                       *string  `json:"session,omitempty"`
-	Latitude                      float64  `json:"latitude"`
-	Longitude                     float64  `json:"longitude"`
-	GooglePlaceID                 *string  `json:"googlePlaceId,omitempty"`
-	CategoriesPreferred           []string `json:"categoriesPreferred,omitempty"`
-	CategoriesDisliked            []string `json:"categoriesDisliked,omitempty"`
-	FreeTime                      *int     `json:"freeTime,omitempty"`
-	CreatedBasedOnCurrentLocation *bool    `json:"createdBasedOnCurrentLocation,omitempty"`
+	Session                       *string           `json:"session,omitempty"`
+	Latitude                      float64           `json:"latitude"`
+	Longitude                     float64           `json:"longitude"`
+	GooglePlaceID                 *string           `json:"googlePlaceId,omitempty"`
+	CategoriesPreferred           []string          `json:"categoriesPreferred,omitempty"`
+	CategoriesDisliked            []string          `json:"categoriesDisliked,omitempty"`
+	FreeTime                      *int              `json:"freeTime,omitempty"`
+	CreatedBasedOnCurrentLocation *bool             `json:"createdBasedOnCurrentLocation,omitempty"`
+	StartTime                     *time.Time        `json:"startTime,omitempty"`
+	TravelMode                    *TravelMode       `json:"travelMode,omitempty"`
+	BudgetMax                     *int              `json:"budgetMax,omitempty"`
+	NumberOfPeople                *int              `json:"numberOfPeople,omitempty"`
+	LocationEnd                   *GeoLocationInput `json:"locationEnd,omitempty"`
+	Debug                         *bool             `json:"debug,omitempty"`
+	DisablePersonalization        *bool             `json:"disablePersonalization,omitempty"`
 }
 
 type CreatePlanByLocationOutput struct {
-	Session string  `json:"session"`
-	Plans   []*Plan `json:"plans"`
+	Session         string               `json:"session"`
+	Plans           []*Plan              `json:"plans"`
+	GenerationTrace *PlanGenerationTrace `json:"generationTrace,omitempty"`
 }
 
 type CreatePlanByPlaceInput struct {
@@ -115,6 +152,21 @@ type CreatePlanByPlaceOutput struct {
 	Plan    *Plan  `json:"plan"`
 }
 
+type CreatePlanByPromptInput struct {
+	Prompt                        string      `json:"prompt"`
+	Latitude                      float64     `json:"latitude"`
+	Longitude                     float64     `json:"longitude"`
+	CreatedBasedOnCurrentLocation *bool       `json:"createdBasedOnCurrentLocation,omitempty"`
+	TravelMode                    *TravelMode `json:"travelMode,omitempty"`
+	DisablePersonalization        *bool       `json:"disablePersonalization,omitempty"`
+}
+
+type CreatePlanByPromptOutput struct {
+	Session string            `json:"session"`
+	Plans   []*Plan           `json:"plans"`
+	Intent  *PlanPromptIntent `json:"intent"`
+}
+
 type CreatePlanCandidateSetFromSavedPlanInput struct {
 	UserID            *string `json:"userId,omitempty"`
 	FirebaseAuthToken *string `json:"firebaseAuthToken,omitempty"`
@@ -138,6 +190,21 @@ type CreatePlanPlaceCategorySet struct {
 	Categories    []*CreatePlanPlaceCategory `json:"categories"`
 }
 
+type CreateTripPlanInput struct {
+	Latitude            float64     `json:"latitude"`
+	Longitude           float64     `json:"longitude"`
+	FreeTime            int         `json:"freeTime"`
+	StartTime           *time.Time  `json:"startTime,omitempty"`
+	TravelMode          *TravelMode `json:"travelMode,omitempty"`
+	CategoriesPreferred []string    `json:"categoriesPreferred,omitempty"`
+	CategoriesDisliked  []string    `json:"categoriesDisliked,omitempty"`
+	FirebaseAuthToken   *string     `json:"firebaseAuthToken,omitempty"`
+}
+
+type CreateTripPlanOutput struct {
+	Trip *Trip `json:"trip"`
+}
+
 type DeletePlaceFromPlanCandidateInput struct {
 	PlanCandidateID string `json:"planCandidateId"`
 	PlanID          string `json:"planId"`
@@ -149,6 +216,14 @@ type DeletePlaceFromPlanCandidateOutput struct {
 	Plan            *Plan  `json:"plan"`
 }
 
+type DeletePlaceFromPlanInput struct {
+	PlanID            string `json:"planId"`
+	Version           int    `json:"version"`
+	UserID            string `json:"userId"`
+	FirebaseAuthToken string `json:"firebaseAuthToken"`
+	PlaceID           string `json:"placeId"`
+}
+
 type DestinationCandidatePlacesForPlanCandidateInput struct {
 	PlanCandidateSetID string `json:"planCandidateSetId"`
 }
@@ -157,6 +232,10 @@ type DestinationCandidatePlacesForPlanCandidateOutput struct {
 	PlacesForPlanCandidates []*PlacesForPlanCandidate `json:"placesForPlanCandidates"`
 }
 
+type EditPlanOutput struct {
+	Plan *Plan `json:"plan"`
+}
+
 type EditPlanTitleOfPlanCandidateInput struct {
 	PlanCandidateID string `json:"planCandidateId"`
 	PlanID          string `json:"planId"`
@@ -178,6 +257,11 @@ type GeoLocation struct {
 	Longitude float64 `json:"longitude"`
 }
 
+type GeoLocationInput struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
 type GooglePlaceReview struct {
 	Rating           int     `json:"rating"`
 	Text             *string `json:"text,omitempty"`
@@ -198,6 +282,17 @@ type Image struct {
 	Author  *User   `json:"author,omitempty"`
 }
 
+type JoinGroupPlanningInput struct {
+	ShareToken  string  `json:"shareToken"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type JoinGroupPlanningOutput struct {
+	Group            *PlanCandidateGroup            `json:"group"`
+	Participant      *PlanCandidateGroupParticipant `json:"participant"`
+	ParticipantToken string                         `json:"participantToken"`
+}
+
 type LikePlacesInput struct {
 	UserID            string `json:"userId"`
 	FirebaseAuthToken string `json:"firebaseAuthToken"`
@@ -271,6 +366,12 @@ type PlaceCategory struct {
 	Name string `json:"name"`
 }
 
+type PlaceSchedule struct {
+	PlaceID     string    `json:"placeId"`
+	ArrivalAt   time.Time `json:"arrivalAt"`
+	DepartureAt time.Time `json:"departureAt"`
+}
+
 type PlacesForPlanCandidate struct {
 	PlanCandidateID string   `json:"planCandidateId"`
 	Places          []*Place `json:"places"`
@@ -312,22 +413,70 @@ type PlacesToReplaceForPlanCandidateOutput struct {
 }
 
 type Plan struct {
-	ID            string        `json:"id"`
-	Name          string        `json:"name"`
-	Places        []*Place      `json:"places"`
-	TimeInMinutes int           `json:"timeInMinutes"`
-	Description   *string       `json:"description,omitempty"`
-	Transitions   []*Transition `json:"transitions"`
-	Author        *User         `json:"author,omitempty"`
-	Collage       *PlanCollage  `json:"collage"`
-	NearbyPlans   []*Plan       `json:"nearbyPlans"`
+	ID                      string                   `json:"id"`
+	Name                    string                   `json:"name"`
+	Places                  []*Place                 `json:"places"`
+	TimeInMinutes           int                      `json:"timeInMinutes"`
+	Description             *string                  `json:"description,omitempty"`
+	Transitions             []*Transition            `json:"transitions"`
+	TransitionToEndLocation *TransitionToEndLocation `json:"transitionToEndLocation,omitempty"`
+	Schedules               []*PlaceSchedule         `json:"schedules"`
+	EstimatedBudget         *Budget                  `json:"estimatedBudget"`
+	Author                  *User                    `json:"author,omitempty"`
+	Collage                 *PlanCollage             `json:"collage"`
+	NearbyPlans             []*Plan                  `json:"nearbyPlans"`
+	Version                 int                      `json:"version"`
+	Visibility              *PlanVisibility          `json:"visibility,omitempty"`
 }
 
 type PlanCandidate struct {
-	ID                            string   `json:"id"`
-	Plans                         []*Plan  `json:"plans"`
-	LikedPlaceIds                 []string `json:"likedPlaceIds"`
-	CreatedBasedOnCurrentLocation bool     `json:"createdBasedOnCurrentLocation"`
+	ID                            string               `json:"id"`
+	Plans                         []*Plan              `json:"plans"`
+	LikedPlaceIds                 []string             `json:"likedPlaceIds"`
+	CreatedBasedOnCurrentLocation bool                 `json:"createdBasedOnCurrentLocation"`
+	TravelMode                    TravelMode           `json:"travelMode"`
+	History                       []*PlanCandidateEdit `json:"history"`
+}
+
+type PlanCandidateEdit struct {
+	ID             string                `json:"id"`
+	PlanID         string                `json:"planId"`
+	Type           PlanCandidateEditType `json:"type"`
+	PlaceIdsBefore []string              `json:"placeIdsBefore"`
+	PlaceIdsAfter  []string              `json:"placeIdsAfter"`
+	IsUndone       bool                  `json:"isUndone"`
+	CreatedAt      time.Time             `json:"createdAt"`
+}
+
+type PlanCandidateGroup struct {
+	PlanCandidateID string                           `json:"planCandidateId"`
+	ShareToken      string                           `json:"shareToken"`
+	Participants    []*PlanCandidateGroupParticipant `json:"participants"`
+	PlanVotes       []*PlanCandidateGroupVoteCount   `json:"planVotes"`
+	PlaceVotes      []*PlanCandidateGroupVoteCount   `json:"placeVotes"`
+	WinningPlanID   *string                          `json:"winningPlanId,omitempty"`
+}
+
+type PlanCandidateGroupInput struct {
+	PlanCandidateID  *string `json:"planCandidateId,omitempty"`
+	ShareToken       *string `json:"shareToken,omitempty"`
+	ParticipantToken *string `json:"participantToken,omitempty"`
+}
+
+type PlanCandidateGroupOutput struct {
+	Group *PlanCandidateGroup `json:"group,omitempty"`
+}
+
+type PlanCandidateGroupParticipant struct {
+	ID          string  `json:"id"`
+	UserID      *string `json:"userId,omitempty"`
+	DisplayName string  `json:"displayName"`
+}
+
+type PlanCandidateGroupVoteCount struct {
+	ID             string   `json:"id"`
+	Count          int      `json:"count"`
+	ParticipantIds []string `json:"participantIds"`
 }
 
 type PlanCandidateInput struct {
@@ -340,6 +489,18 @@ type PlanCandidateOutput struct {
 	PlanCandidate *PlanCandidate `json:"planCandidate,omitempty"`
 }
 
+type PlanCandidateUpdatedEvent struct {
+	PlanCandidateID string                        `json:"planCandidateId"`
+	Type            PlanCandidateUpdatedEventType `json:"type"`
+	Plan            *Plan                         `json:"plan,omitempty"`
+	PlaceID         *string                       `json:"placeId,omitempty"`
+	OccurredAt      time.Time                     `json:"occurredAt"`
+}
+
+type PlanCandidateUpdatedInput struct {
+	PlanCandidateID string `json:"planCandidateId"`
+}
+
 type PlanCollage struct {
 	Images []*PlanCollageImage `json:"images"`
 }
@@ -349,6 +510,27 @@ type PlanCollageImage struct {
 	Image   *Image `json:"image,omitempty"`
 }
 
+type PlanGenerationTrace struct {
+	BasePlaces []*PlanGenerationTraceBasePlace `json:"basePlaces"`
+}
+
+type PlanGenerationTraceBasePlace struct {
+	PlaceID         string                              `json:"placeId"`
+	PlaceName       string                              `json:"placeName"`
+	SearchRadius    *int                                `json:"searchRadius,omitempty"`
+	PlanCreated     bool                                `json:"planCreated"`
+	Selected        bool                                `json:"selected"`
+	NumPlacesInPlan int                                 `json:"numPlacesInPlan"`
+	Error           *string                             `json:"error,omitempty"`
+	RejectedPlaces  []*PlanGenerationTraceRejectedPlace `json:"rejectedPlaces"`
+}
+
+type PlanGenerationTraceRejectedPlace struct {
+	PlaceID   string                     `json:"placeId"`
+	PlaceName string                     `json:"placeName"`
+	Reason    PlanGenerationRejectReason `json:"reason"`
+}
+
 type PlanInput struct {
 	PlanID string `json:"planID"`
 }
@@ -357,6 +539,15 @@ type PlanOutput struct {
 	Plan *Plan `json:"plan,omitempty"`
 }
 
+type PlanPromptIntent struct {
+	Prompt              string     `json:"prompt"`
+	CategoriesPreferred []string   `json:"categoriesPreferred"`
+	CategoriesDisliked  []string   `json:"categoriesDisliked"`
+	FreeTime            *int       `json:"freeTime,omitempty"`
+	BudgetMax           *int       `json:"budgetMax,omitempty"`
+	StartTime           *time.Time `json:"startTime,omitempty"`
+}
+
 type PlansByLocationInput struct {
 	Latitude  float64 `json:"latitude"`
 	Longitude float64 `json:"longitude"`
@@ -393,6 +584,16 @@ type PriceRange struct {
 	GooglePriceLevel int `json:"googlePriceLevel"`
 }
 
+type RedoPlanCandidateEditInput struct {
+	PlanCandidateID string `json:"planCandidateId"`
+}
+
+type RedoPlanCandidateEditOutput struct {
+	PlanCandidateID string             `json:"planCandidateId"`
+	Edit            *PlanCandidateEdit `json:"edit,omitempty"`
+	Plan            *Plan              `json:"plan,omitempty"`
+}
+
 type ReplacePlaceOfPlanCandidateInput struct {
 	PlanCandidateID  string `json:"planCandidateId"`
 	PlanID           string `json:"planId"`
@@ -405,22 +606,70 @@ type ReplacePlaceOfPlanCandidateOutput struct {
 	Plan            *Plan  `json:"plan"`
 }
 
+type ReplacePlaceOfPlanInput struct {
+	PlanID            string `json:"planId"`
+	Version           int    `json:"version"`
+	UserID            string `json:"userId"`
+	FirebaseAuthToken string `json:"firebaseAuthToken"`
+	PlaceIDToRemove   string `json:"placeIdToRemove"`
+	PlaceIDToReplace  string `json:"placeIdToReplace"`
+}
+
 type SavePlanFromCandidateInput struct {
-	Session   string  `json:"session"`
-	PlanID    string  `json:"planId"`
-	AuthToken *string `json:"authToken,omitempty"`
+	Session    string          `json:"session"`
+	PlanID     string          `json:"planId"`
+	AuthToken  *string         `json:"authToken,omitempty"`
+	Visibility *PlanVisibility `json:"visibility,omitempty"`
 }
 
 type SavePlanFromCandidateOutput struct {
 	Plan *Plan `json:"plan"`
 }
 
+type StartGroupPlanningInput struct {
+	PlanCandidateID string  `json:"planCandidateId"`
+	DisplayName     *string `json:"displayName,omitempty"`
+}
+
 type Transition struct {
 	From     *Place `json:"from,omitempty"`
 	To       *Place `json:"to"`
 	Duration int    `json:"duration"`
 }
 
+type TransitionToEndLocation struct {
+	From     *Place       `json:"from"`
+	Location *GeoLocation `json:"location"`
+	Duration int          `json:"duration"`
+}
+
+type Trip struct {
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Plans      []*Plan    `json:"plans"`
+	Author     *User      `json:"author,omitempty"`
+	StartTime  *time.Time `json:"startTime,omitempty"`
+	TravelMode TravelMode `json:"travelMode"`
+}
+
+type TripInput struct {
+	TripID string `json:"tripId"`
+}
+
+type TripOutput struct {
+	Trip *Trip `json:"trip,omitempty"`
+}
+
+type UndoPlanCandidateEditInput struct {
+	PlanCandidateID string `json:"planCandidateId"`
+}
+
+type UndoPlanCandidateEditOutput struct {
+	PlanCandidateID string             `json:"planCandidateId"`
+	Edit            *PlanCandidateEdit `json:"edit,omitempty"`
+	Plan            *Plan              `json:"plan,omitempty"`
+}
+
 type UpdatePlanCollageImageInput struct {
 	PlanID            string `json:"planId"`
 	UserID            string `json:"userId"`
@@ -433,10 +682,28 @@ type UpdatePlanCollageImageOutput struct {
 	Plan *Plan `json:"plan"`
 }
 
+type UpdatePlanTitleAndDescriptionInput struct {
+	PlanID            string  `json:"planId"`
+	Version           int     `json:"version"`
+	UserID            string  `json:"userId"`
+	FirebaseAuthToken string  `json:"firebaseAuthToken"`
+	Title             string  `json:"title"`
+	Description       *string `json:"description,omitempty"`
+}
+
+type UpdatePlanVisibilityInput struct {
+	PlanID            string         `json:"planId"`
+	Version           int            `json:"version"`
+	UserID            string         `json:"userId"`
+	FirebaseAuthToken string         `json:"firebaseAuthToken"`
+	Visibility        PlanVisibility `json:"visibility"`
+}
+
 type UpdateUserProfileInput struct {
-	UserID          string  `json:"userId"`
-	Name            *string `json:"name,omitempty"`
-	ProfileImageURL *string `json:"profileImageUrl,omitempty"`
+	UserID                  string  `json:"userId"`
+	Name                    *string `json:"name,omitempty"`
+	ProfileImageURL         *string `json:"profileImageUrl,omitempty"`
+	PersonalizationDisabled *bool   `json:"personalizationDisabled,omitempty"`
 }
 
 type UpdateUserProfileOutput struct {
@@ -455,11 +722,30 @@ type UploadPlacePhotoInPlanOutput struct {
 }
 
 type User struct {
-	ID          string   `json:"id"`
-	Name        string   `json:"name"`
-	PhotoURL    *string  `json:"photoUrl,omitempty"`
-	Plans       []*Plan  `json:"plans"`
-	LikedPlaces []*Place `json:"likedPlaces"`
+	ID                      string   `json:"id"`
+	Name                    string   `json:"name"`
+	PhotoURL                *string  `json:"photoUrl,omitempty"`
+	PersonalizationDisabled bool     `json:"personalizationDisabled"`
+	Plans                   []*Plan  `json:"plans"`
+	LikedPlaces             []*Place `json:"likedPlaces"`
+}
+
+type VoteInGroupOutput struct {
+	Group *PlanCandidateGroup `json:"group"`
+}
+
+type VoteToPlaceInGroupInput struct {
+	PlanCandidateID  string `json:"planCandidateId"`
+	ParticipantToken string `json:"participantToken"`
+	PlaceID          string `json:"placeId"`
+	Vote             bool   `json:"vote"`
+}
+
+type VoteToPlanInGroupInput struct {
+	PlanCandidateID  string `json:"planCandidateId"`
+	ParticipantToken string `json:"participantToken"`
+	PlanID           string `json:"planId"`
+	Vote             bool   `json:"vote"`
 }
 
 type ImageSize string
@@ -502,3 +788,240 @@ func (e *ImageSize) UnmarshalGQL(v interface{}) error {
 func (e ImageSize) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type PlanCandidateEditType string
+
+const (
+	PlanCandidateEditTypeAddPlace      PlanCandidateEditType = "ADD_PLACE"
+	PlanCandidateEditTypeRemovePlace   PlanCandidateEditType = "REMOVE_PLACE"
+	PlanCandidateEditTypeReplacePlace  PlanCandidateEditType = "REPLACE_PLACE"
+	PlanCandidateEditTypeReorderPlaces PlanCandidateEditType = "REORDER_PLACES"
+)
+
+var AllPlanCandidateEditType = []PlanCandidateEditType{
+	PlanCandidateEditTypeAddPlace,
+	PlanCandidateEditTypeRemovePlace,
+	PlanCandidateEditTypeReplacePlace,
+	PlanCandidateEditTypeReorderPlaces,
+}
+
+func (e PlanCandidateEditType) IsValid() bool {
+	switch e {
+	case PlanCandidateEditTypeAddPlace, PlanCandidateEditTypeRemovePlace, PlanCandidateEditTypeReplacePlace, PlanCandidateEditTypeReorderPlaces:
+		return true
+	}
+	return false
+}
+
+func (e PlanCandidateEditType) String() string {
+	return string(e)
+}
+
+func (e *PlanCandidateEditType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlanCandidateEditType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlanCandidateEditType", str)
+	}
+	return nil
+}
+
+func (e PlanCandidateEditType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PlanCandidateUpdatedEventType string
+
+const (
+	PlanCandidateUpdatedEventTypePlaceAdded      PlanCandidateUpdatedEventType = "PLACE_ADDED"
+	PlanCandidateUpdatedEventTypePlaceDeleted    PlanCandidateUpdatedEventType = "PLACE_DELETED"
+	PlanCandidateUpdatedEventTypePlaceReplaced   PlanCandidateUpdatedEventType = "PLACE_REPLACED"
+	PlanCandidateUpdatedEventTypePlacesReordered PlanCandidateUpdatedEventType = "PLACES_REORDERED"
+	PlanCandidateUpdatedEventTypePlaceLiked      PlanCandidateUpdatedEventType = "PLACE_LIKED"
+	PlanCandidateUpdatedEventTypeGroupUpdated    PlanCandidateUpdatedEventType = "GROUP_UPDATED"
+	PlanCandidateUpdatedEventTypeEditUndone      PlanCandidateUpdatedEventType = "EDIT_UNDONE"
+	PlanCandidateUpdatedEventTypeEditRedone      PlanCandidateUpdatedEventType = "EDIT_REDONE"
+)
+
+var AllPlanCandidateUpdatedEventType = []PlanCandidateUpdatedEventType{
+	PlanCandidateUpdatedEventTypePlaceAdded,
+	PlanCandidateUpdatedEventTypePlaceDeleted,
+	PlanCandidateUpdatedEventTypePlaceReplaced,
+	PlanCandidateUpdatedEventTypePlacesReordered,
+	PlanCandidateUpdatedEventTypePlaceLiked,
+	PlanCandidateUpdatedEventTypeGroupUpdated,
+	PlanCandidateUpdatedEventTypeEditUndone,
+	PlanCandidateUpdatedEventTypeEditRedone,
+}
+
+func (e PlanCandidateUpdatedEventType) IsValid() bool {
+	switch e {
+	case PlanCandidateUpdatedEventTypePlaceAdded, PlanCandidateUpdatedEventTypePlaceDeleted, PlanCandidateUpdatedEventTypePlaceReplaced, PlanCandidateUpdatedEventTypePlacesReordered, PlanCandidateUpdatedEventTypePlaceLiked, PlanCandidateUpdatedEventTypeGroupUpdated, PlanCandidateUpdatedEventTypeEditUndone, PlanCandidateUpdatedEventTypeEditRedone:
+		return true
+	}
+	return false
+}
+
+func (e PlanCandidateUpdatedEventType) String() string {
+	return string(e)
+}
+
+func (e *PlanCandidateUpdatedEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlanCandidateUpdatedEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlanCandidateUpdatedEventType", str)
+	}
+	return nil
+}
+
+func (e PlanCandidateUpdatedEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PlanGenerationRejectReason string
+
+const (
+	PlanGenerationRejectReasonAlreadyInPlan    PlanGenerationRejectReason = "ALREADY_IN_PLAN"
+	PlanGenerationRejectReasonCategoryLimit    PlanGenerationRejectReason = "CATEGORY_LIMIT"
+	PlanGenerationRejectReasonOverBudget       PlanGenerationRejectReason = "OVER_BUDGET"
+	PlanGenerationRejectReasonClosedAtArrival  PlanGenerationRejectReason = "CLOSED_AT_ARRIVAL"
+	PlanGenerationRejectReasonOverTime         PlanGenerationRejectReason = "OVER_TIME"
+	PlanGenerationRejectReasonInOtherPlan      PlanGenerationRejectReason = "IN_OTHER_PLAN"
+	PlanGenerationRejectReasonDislikedCategory PlanGenerationRejectReason = "DISLIKED_CATEGORY"
+)
+
+var AllPlanGenerationRejectReason = []PlanGenerationRejectReason{
+	PlanGenerationRejectReasonAlreadyInPlan,
+	PlanGenerationRejectReasonCategoryLimit,
+	PlanGenerationRejectReasonOverBudget,
+	PlanGenerationRejectReasonClosedAtArrival,
+	PlanGenerationRejectReasonOverTime,
+	PlanGenerationRejectReasonInOtherPlan,
+	PlanGenerationRejectReasonDislikedCategory,
+}
+
+func (e PlanGenerationRejectReason) IsValid() bool {
+	switch e {
+	case PlanGenerationRejectReasonAlreadyInPlan, PlanGenerationRejectReasonCategoryLimit, PlanGenerationRejectReasonOverBudget, PlanGenerationRejectReasonClosedAtArrival, PlanGenerationRejectReasonOverTime, PlanGenerationRejectReasonInOtherPlan, PlanGenerationRejectReasonDislikedCategory:
+		return true
+	}
+	return false
+}
+
+func (e PlanGenerationRejectReason) String() string {
+	return string(e)
+}
+
+func (e *PlanGenerationRejectReason) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlanGenerationRejectReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlanGenerationRejectReason", str)
+	}
+	return nil
+}
+
+func (e PlanGenerationRejectReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type PlanVisibility string
+
+const (
+	PlanVisibilityPublic   PlanVisibility = "PUBLIC"
+	PlanVisibilityUnlisted PlanVisibility = "UNLISTED"
+	PlanVisibilityPrivate  PlanVisibility = "PRIVATE"
+)
+
+var AllPlanVisibility = []PlanVisibility{
+	PlanVisibilityPublic,
+	PlanVisibilityUnlisted,
+	PlanVisibilityPrivate,
+}
+
+func (e PlanVisibility) IsValid() bool {
+	switch e {
+	case PlanVisibilityPublic, PlanVisibilityUnlisted, PlanVisibilityPrivate:
+		return true
+	}
+	return false
+}
+
+func (e PlanVisibility) String() string {
+	return string(e)
+}
+
+func (e *PlanVisibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PlanVisibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PlanVisibility", str)
+	}
+	return nil
+}
+
+func (e PlanVisibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TravelMode string
+
+const (
+	TravelModeWalking TravelMode = "WALKING"
+	TravelModeCycling TravelMode = "CYCLING"
+	TravelModeTransit TravelMode = "TRANSIT"
+	TravelModeDriving TravelMode = "DRIVING"
+)
+
+var AllTravelMode = []TravelMode{
+	TravelModeWalking,
+	TravelModeCycling,
+	TravelModeTransit,
+	TravelModeDriving,
+}
+
+func (e TravelMode) IsValid() bool {
+	switch e {
+	case TravelModeWalking, TravelModeCycling, TravelModeTransit, TravelModeDriving:
+		return true
+	}
+	return false
+}
+
+func (e TravelMode) String() string {
+	return string(e)
+}
+
+func (e *TravelMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TravelMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TravelMode", str)
+	}
+	return nil
+}
+
+func (e TravelMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -13,6 +13,7 @@ import (
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/place"
 	"poroto.app/poroto/planner/internal/domain/utils"
+	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
 	"poroto.app/poroto/planner/internal/interface/graphql/factory"
 	"poroto.app/poroto/planner/internal/interface/graphql/model"
 )
@@ -28,6 +29,7 @@ func (r *queryResolver) PlacesNearPlan(ctx context.Context, input model.PlacesNe
 	places, err := r.PlaceService.FetchPlacesNearPlan(ctx, place.PlacesNearPlanInput{
 		PlanID: input.PlanID,
 		Limit:  utils.FromPointerOrZero(input.Limit),
+		Viewer: gcontext.GetAuthUser(ctx),
 	})
 	if err != nil {
 		r.Logger.Error("error while fetching places near plan", zap.Error(err))
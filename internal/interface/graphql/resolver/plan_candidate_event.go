@@ -0,0 +1,105 @@
+package resolver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	lru "github.com/hashicorp/golang-lru/v2"
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
+	"poroto.app/poroto/planner/internal/interface/graphql/factory"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// planCandidateUpdatedEventPayloadCacheSize は通知の内容を保持しておくイベントの数
+const planCandidateUpdatedEventPayloadCacheSize = 128
+
+// PlanCandidateUpdatedEventPayloads は同じイベントを購読している複数のクライアントに送る通知を、イベントごとに一度だけ作成する
+type PlanCandidateUpdatedEventPayloads struct {
+	cache *lru.Cache[string, *planCandidateUpdatedEventPayload]
+}
+
+type planCandidateUpdatedEventPayload struct {
+	once  sync.Once
+	event *model.PlanCandidateUpdatedEvent
+}
+
+func NewPlanCandidateUpdatedEventPayloads() (*PlanCandidateUpdatedEventPayloads, error) {
+	cache, err := lru.New[string, *planCandidateUpdatedEventPayload](planCandidateUpdatedEventPayloadCacheSize)
+	if err != nil {
+		return nil, err
+	}
+	return &PlanCandidateUpdatedEventPayloads{cache: cache}, nil
+}
+
+// getOrCreate は eventId に対応する通知を返し、まだ作成されていない場合は create で作成する
+func (p *PlanCandidateUpdatedEventPayloads) getOrCreate(eventId string, create func() *model.PlanCandidateUpdatedEvent) *model.PlanCandidateUpdatedEvent {
+	if p == nil {
+		return create()
+	}
+
+	payload := &planCandidateUpdatedEventPayload{}
+	if previous, ok, _ := p.cache.PeekOrAdd(eventId, payload); ok {
+		payload = previous
+	}
+
+	payload.once.Do(func() {
+		payload.event = create()
+	})
+	return payload.event
+}
+
+// publishPlanCandidateEvent はプラン候補の編集を購読しているクライアントに通知する
+// 編集自体は完了しているため、通知に失敗してもエラーは返さずログに残す
+func (r *Resolver) publishPlanCandidateEvent(ctx context.Context, planCandidateSetId string, eventType models.PlanCandidateEventType, planId *string, placeId *string) {
+	if r.PlanCandidateEventBroker == nil {
+		return
+	}
+
+	if err := r.PlanCandidateEventBroker.Publish(ctx, models.PlanCandidateEvent{
+		Id:                 uuid.New().String(),
+		PlanCandidateSetId: planCandidateSetId,
+		Type:               eventType,
+		PlanId:             planId,
+		PlaceId:            placeId,
+		OccurredAt:         time.Now(),
+	}); err != nil {
+		r.Logger.Warn(
+			"error while publishing plan candidate event",
+			zap.String("planCandidateId", planCandidateSetId),
+			zap.String("type", string(eventType)),
+			zap.Error(err),
+		)
+	}
+}
+
+// planCandidateUpdatedEventFromDomainModel はイベントを購読者に送る通知に変換する
+// 編集されたプランを含めるため、同じイベントに対しては一度だけ作成して購読者の間で共有する
+func (r *Resolver) planCandidateUpdatedEventFromDomainModel(ctx context.Context, event models.PlanCandidateEvent) *model.PlanCandidateUpdatedEvent {
+	return r.PlanCandidateUpdatedEventPayloads.getOrCreate(event.Id, func() *model.PlanCandidateUpdatedEvent {
+		// 最初に受け取った購読者が切断しても、他の購読者に送る通知は作成する
+		ctx := context.WithoutCancel(ctx)
+
+		var graphqlPlan *model.Plan
+		if event.PlanId != nil {
+			planCandidateSet, err := r.PlanCandidateService.Find(ctx, plancandidate.FindPlanCandidateSetInput{
+				PlanCandidateSetId: event.PlanCandidateSetId,
+			})
+			if err != nil {
+				r.Logger.Warn("error while finding plan candidate", zap.Error(err))
+			} else if planCandidateSet != nil {
+				if plan := planCandidateSet.GetPlan(*event.PlanId); plan != nil {
+					graphqlPlan, err = factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *plan, planCandidateSet.MetaData.GetLocationStart(), planCandidateSet.MetaData.LocationEnd, planCandidateSet.MetaData.StartTime, planCandidateSet.MetaData.GetTravelMode())
+					if err != nil {
+						r.Logger.Warn("error while converting plan to graphql model", zap.Error(err))
+					}
+				}
+			}
+		}
+
+		return factory.PlanCandidateUpdatedEventFromDomainModel(event, graphqlPlan)
+	})
+}
@@ -6,15 +6,18 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/apperrors"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
 	"poroto.app/poroto/planner/internal/domain/services/plangen"
 	"poroto.app/poroto/planner/internal/domain/utils"
+	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
 	"poroto.app/poroto/planner/internal/interface/graphql/factory"
 	"poroto.app/poroto/planner/internal/interface/graphql/model"
 )
@@ -32,6 +35,13 @@ func (r *mutationResolver) CreatePlanByLocation(ctx context.Context, input model
 		Longitude: input.Longitude,
 	}
 
+	locationEnd := factory.GeoLocationToDomainModel(input.LocationEnd)
+
+	travelMode := factory.TravelModeToDomainModel(input.TravelMode)
+
+	// プランを巡る時間帯の天気予報を取得
+	weather := r.PlanGenService.FetchWeatherForecast(ctx, locationStart, input.StartTime, input.FreeTime)
+
 	// プラン候補の作成
 	var planCandidateSetId string
 	if input.Session != nil {
@@ -44,18 +54,38 @@ func (r *mutationResolver) CreatePlanByLocation(ctx context.Context, input model
 		}
 	}
 
+	// ログインしている場合は、ユーザーの好みをプランの作成に反映する
+	var userPreference *models.UserPreferenceProfile
+	if !utils.FromPointerOrZero(input.DisablePersonalization) {
+		userPreference = r.PlanGenService.FetchUserPreferenceProfile(ctx, gcontext.GetAuthUser(ctx))
+	}
+
+	// デバッグ時は、プランを作成する過程を記録する
+	ctxCreatePlan := ctx
+	var tracer *plangen.GenerationTracer
+	if utils.FromPointerOrZero(input.Debug) {
+		ctxCreatePlan, tracer = plangen.WithGenerationTracer(ctx)
+	}
+
 	// プランの作成
 	plans, err := r.PlanGenService.CreatePlanByLocation(
-		ctx,
+		ctxCreatePlan,
 		plangen.CreatePlanByLocationInput{
 			PlanCandidateSetId:           planCandidateSetId,
 			LocationStart:                locationStart,
+			LocationEnd:                  locationEnd,
 			GooglePlaceId:                input.GooglePlaceID,
 			CategoryNamesPreferred:       &input.CategoriesPreferred,
 			CategoryNamesDisliked:        &input.CategoriesDisliked,
 			FreeTime:                     input.FreeTime,
+			StartTime:                    input.StartTime,
+			TravelMode:                   travelMode,
+			BudgetMax:                    input.BudgetMax,
+			NumberOfPeople:               utils.FromPointerOrZero(input.NumberOfPeople),
+			Weather:                      weather,
 			CreateBasedOnCurrentLocation: createBasedOnCurrentLocation,
-			ShouldOpenWhileTraveling:     false,
+			ShouldOpenWhileTraveling:     true,
+			UserPreference:               userPreference,
 		},
 	)
 	if err != nil {
@@ -68,18 +98,29 @@ func (r *mutationResolver) CreatePlanByLocation(ctx context.Context, input model
 		PlanCandidateSetId:           planCandidateSetId,
 		Plans:                        *plans,
 		LocationStart:                &locationStart,
+		LocationEnd:                  locationEnd,
 		CategoryNamesPreferred:       &input.CategoriesPreferred,
 		CategoryNamesRejected:        &input.CategoriesDisliked,
 		FreeTime:                     input.FreeTime,
+		StartTime:                    input.StartTime,
+		TravelMode:                   travelMode,
+		BudgetMax:                    input.BudgetMax,
+		NumberOfPeople:               input.NumberOfPeople,
+		Weather:                      weather,
 		CreateBasedOnCurrentLocation: createBasedOnCurrentLocation,
 	}); err != nil {
 		r.Logger.Error("error while saving plans", zap.Error(err))
 	}
 
-	return &model.CreatePlanByLocationOutput{
+	output := &model.CreatePlanByLocationOutput{
 		Session: planCandidateSetId,
-		Plans:   factory.PlansFromDomainModel(plans, &locationStart),
-	}, nil
+		Plans:   factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, &locationStart, locationEnd, input.StartTime, travelMode),
+	}
+	if tracer != nil {
+		output.GenerationTrace = factory.PlanGenerationTraceFromDomainModel(tracer.Trace())
+	}
+
+	return output, nil
 }
 
 // CreatePlanByPlace is the resolver for the createPlanByPlace field.
@@ -94,7 +135,15 @@ func (r *mutationResolver) CreatePlanByPlace(ctx context.Context, input model.Cr
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(*planCreated, nil)
+	planCandidate, err := r.PlanCandidateService.Find(ctx, plancandidate.FindPlanCandidateSetInput{
+		PlanCandidateSetId: input.Session,
+	})
+	if err != nil {
+		r.Logger.Error("error while finding plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planCreated, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
@@ -134,16 +183,27 @@ func (r *mutationResolver) CreatePlanByCategory(ctx context.Context, input model
 		return nil, fmt.Errorf("invalid category id")
 	}
 
+	travelMode := factory.TravelModeToDomainModel(input.TravelMode)
+
+	location := models.GeoLocation{
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+	}
+
+	// プランを巡る時間帯の天気予報を取得
+	weather := r.PlanGenService.FetchWeatherForecast(ctx, location, nil, nil)
+
 	plans, err := r.PlanGenService.CreatePlanByCategory(
 		ctx,
 		plangen.CreatePlanByCategoryInput{
 			PlanCandidateSetId: planCandidateSetId,
 			Category:           category,
-			Location: models.GeoLocation{
-				Latitude:  input.Latitude,
-				Longitude: input.Longitude,
-			},
-			RadiusInKm: input.RadiusInKm,
+			Location:           location,
+			RadiusInKm:         input.RadiusInKm,
+			TravelMode:         travelMode,
+			BudgetMax:          input.BudgetMax,
+			NumberOfPeople:     utils.FromPointerOrZero(input.NumberOfPeople),
+			Weather:            weather,
 		},
 	)
 	if err != nil {
@@ -154,17 +214,15 @@ func (r *mutationResolver) CreatePlanByCategory(ctx context.Context, input model
 	if err := r.PlanCandidateService.SavePlans(ctx, plancandidate.SavePlansInput{
 		PlanCandidateSetId: planCandidateSetId,
 		Plans:              *plans,
-		LocationStart: &models.GeoLocation{
-			Latitude:  input.Latitude,
-			Longitude: input.Longitude,
-		},
+		TravelMode:         travelMode,
+		BudgetMax:          input.BudgetMax,
+		NumberOfPeople:     input.NumberOfPeople,
+		Weather:            weather,
+		LocationStart:      &location,
 		CreateByCategoryMetaData: &models.CreateByCategoryMetaData{
 			Category:   category,
 			RadiusInKm: input.RadiusInKm,
-			Location: models.GeoLocation{
-				Latitude:  input.Latitude,
-				Longitude: input.Longitude,
-			},
+			Location:   location,
 		},
 	}); err != nil {
 		r.Logger.Error("error while saving plans", zap.Error(err))
@@ -172,7 +230,70 @@ func (r *mutationResolver) CreatePlanByCategory(ctx context.Context, input model
 
 	return &model.CreatePlanByCategoryOutput{
 		PlanCandidateSetID: planCandidateSetId,
-		Plans:              factory.PlansFromDomainModel(plans, nil),
+		Plans:              factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, nil, nil, nil, travelMode),
+	}, nil
+}
+
+// CreatePlanByPrompt is the resolver for the createPlanByPrompt field.
+func (r *mutationResolver) CreatePlanByPrompt(ctx context.Context, input model.CreatePlanByPromptInput) (*model.CreatePlanByPromptOutput, error) {
+	createBasedOnCurrentLocation := utils.FromPointerOrZero(input.CreatedBasedOnCurrentLocation)
+
+	location := models.GeoLocation{
+		Latitude:  input.Latitude,
+		Longitude: input.Longitude,
+	}
+
+	travelMode := factory.TravelModeToDomainModel(input.TravelMode)
+
+	// プラン候補の作成
+	planCandidateSetId := uuid.New().String()
+	if err := r.PlanCandidateService.CreatePlanCandidateSet(ctx, planCandidateSetId); err != nil {
+		r.Logger.Error("error while creating plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	// ログインしている場合は、ユーザーの好みをプランの作成に反映する
+	var userPreference *models.UserPreferenceProfile
+	if !utils.FromPointerOrZero(input.DisablePersonalization) {
+		userPreference = r.PlanGenService.FetchUserPreferenceProfile(ctx, gcontext.GetAuthUser(ctx))
+	}
+
+	// 要望から条件を抽出してプランを作成
+	output, err := r.PlanGenService.CreatePlanByPrompt(ctx, plangen.CreatePlanByPromptInput{
+		PlanCandidateSetId:           planCandidateSetId,
+		Prompt:                       input.Prompt,
+		Location:                     location,
+		TravelMode:                   travelMode,
+		CreateBasedOnCurrentLocation: createBasedOnCurrentLocation,
+		UserPreference:               userPreference,
+	})
+	if err != nil {
+		r.Logger.Error("error while creating plan by prompt", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	// 作成されたプランと抽出された条件の保存
+	if err := r.PlanCandidateService.SavePlans(ctx, plancandidate.SavePlansInput{
+		PlanCandidateSetId:           planCandidateSetId,
+		Plans:                        output.Plans,
+		LocationStart:                &location,
+		CategoryNamesPreferred:       &output.Intent.CategoriesPreferred,
+		CategoryNamesRejected:        &output.Intent.CategoriesDisliked,
+		FreeTime:                     output.Intent.FreeTime,
+		StartTime:                    output.Intent.StartTime,
+		TravelMode:                   travelMode,
+		BudgetMax:                    output.Intent.BudgetMax,
+		Weather:                      output.Weather,
+		PromptIntent:                 &output.Intent,
+		CreateBasedOnCurrentLocation: createBasedOnCurrentLocation,
+	}); err != nil {
+		r.Logger.Error("error while saving plans", zap.Error(err))
+	}
+
+	return &model.CreatePlanByPromptOutput{
+		Session: planCandidateSetId,
+		Plans:   factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, &output.Plans, &location, nil, output.Intent.StartTime, travelMode),
+		Intent:  factory.PlanPromptIntentFromDomainModel(output.Intent),
 	}, nil
 }
 
@@ -195,7 +316,7 @@ func (r *mutationResolver) CreatePlanCandidateSetFromSavedPlan(ctx context.Conte
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	planGraphQLModel := factory.PlanCandidateSetFromDomainModel(&output.PlanCandidateSet)
+	planGraphQLModel := factory.PlanCandidateSetFromDomainModel(ctx, r.Logger, r.RoutingProvider, &output.PlanCandidateSet)
 
 	if err != nil {
 		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
@@ -222,12 +343,14 @@ func (r *mutationResolver) ChangePlacesOrderInPlanCandidate(ctx context.Context,
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(*planUpdated, planCandidate.MetaData.GetLocationStart())
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planUpdated, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
+	r.publishPlanCandidateEvent(ctx, input.Session, models.PlanCandidateEventTypePlacesReordered, &input.PlanID, nil)
+
 	return &model.ChangePlacesOrderInPlanCandidateOutput{
 		Plan: graphqlPlan,
 	}, nil
@@ -241,13 +364,18 @@ func (r *mutationResolver) SavePlanFromCandidate(ctx context.Context, input mode
 		zap.String("planId", input.PlanID),
 	)
 
-	planSaved, err := r.PlanService.SavePlanFromPlanCandidateSet(ctx, input.Session, input.PlanID, input.AuthToken)
+	var visibility *models.PlanVisibility
+	if input.Visibility != nil {
+		visibility = utils.ToPointer(factory.PlanVisibilityToDomainModel(*input.Visibility))
+	}
+
+	planSaved, err := r.PlanService.SavePlanFromPlanCandidateSet(ctx, input.Session, input.PlanID, input.AuthToken, visibility)
 	if err != nil {
 		r.Logger.Error("error while saving plan from plan candidate", zap.Error(err))
 		return nil, fmt.Errorf("could not save plan")
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(*planSaved, nil)
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planSaved, nil, nil, nil, models.TravelModeWalking)
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
@@ -274,12 +402,14 @@ func (r *mutationResolver) AddPlaceToPlanCandidateAfterPlace(ctx context.Context
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(*planInPlanCandidate, planCandidate.MetaData.GetLocationStart())
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planInPlanCandidate, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypePlaceAdded, &input.PlanID, &input.PlaceID)
+
 	return &model.AddPlaceToPlanCandidateAfterPlaceOutput{
 		Plan: graphqlPlanInPlanCandidate,
 	}, nil
@@ -301,12 +431,14 @@ func (r *mutationResolver) DeletePlaceFromPlanCandidate(ctx context.Context, inp
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(*planUpdated, planCandidate.MetaData.GetLocationStart())
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planUpdated, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypePlaceDeleted, &input.PlanID, &input.PlaceID)
+
 	return &model.DeletePlaceFromPlanCandidateOutput{
 		PlanCandidateID: input.PlanCandidateID,
 		Plan:            graphqlPlanInPlanCandidate,
@@ -329,12 +461,14 @@ func (r *mutationResolver) ReplacePlaceOfPlanCandidate(ctx context.Context, inpu
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(*plan, planCandidate.MetaData.GetLocationStart())
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *plan, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypePlaceReplaced, &input.PlanID, &input.PlaceIDToReplace)
+
 	return &model.ReplacePlaceOfPlanCandidateOutput{
 		PlanCandidateID: input.PlanCandidateID,
 		Plan:            graphqlPlanInPlanCandidate,
@@ -367,24 +501,29 @@ func (r *mutationResolver) AutoReorderPlacesInPlanCandidate(ctx context.Context,
 		return nil, fmt.Errorf("plan candidate not found")
 	}
 
-	planUpdated, err := r.PlanCandidateService.AutoReorderPlaces(ctx, plancandidate.AutoReorderPlacesInput{
+	output, err := r.PlanCandidateService.AutoReorderPlaces(ctx, plancandidate.AutoReorderPlacesInput{
 		PlanCandidateSetId: input.PlanCandidateID,
 		PlanId:             input.PlanID,
+		FixLastPlace:       utils.FromPointerOrZero(input.FixLastPlace),
+		ReturnToStart:      utils.FromPointerOrZero(input.ReturnToStart),
 	})
 	if err != nil {
 		r.Logger.Error("error while auto reordering places in plan candidate", zap.Error(err))
 		return nil, fmt.Errorf("could not auto reorder places in plan candidate")
 	}
 
-	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(*planUpdated, planCandidateSet.MetaData.GetLocationStart())
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, output.Plan, planCandidateSet.MetaData.GetLocationStart(), planCandidateSet.MetaData.LocationEnd, planCandidateSet.MetaData.StartTime, planCandidateSet.MetaData.GetTravelMode())
 	if err != nil {
 		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypePlacesReordered, &input.PlanID, nil)
+
 	return &model.AutoReorderPlacesInPlanCandidateOutput{
-		PlanCandidateID: input.PlanCandidateID,
-		Plan:            graphqlPlanInPlanCandidate,
+		PlanCandidateID:    input.PlanCandidateID,
+		Plan:               graphqlPlanInPlanCandidate,
+		TimeSavedInMinutes: output.TimeSavedInMinutes,
 	}, nil
 }
 
@@ -410,8 +549,182 @@ func (r *mutationResolver) LikeToPlaceInPlanCandidate(ctx context.Context, input
 		return nil, fmt.Errorf("could not like to place in plan candidate")
 	}
 
-	graphqlPlanCandidate := factory.PlanCandidateSetFromDomainModel(planCandidateUpdated)
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypePlaceLiked, nil, &input.PlaceID)
+
+	graphqlPlanCandidate := factory.PlanCandidateSetFromDomainModel(ctx, r.Logger, r.RoutingProvider, planCandidateUpdated)
 	return &model.LikeToPlaceInPlanCandidateOutput{
 		PlanCandidate: graphqlPlanCandidate,
 	}, nil
 }
+
+// UndoPlanCandidateEdit is the resolver for the undoPlanCandidateEdit field.
+func (r *mutationResolver) UndoPlanCandidateEdit(ctx context.Context, input model.UndoPlanCandidateEditInput) (*model.UndoPlanCandidateEditOutput, error) {
+	r.Logger.Info(
+		"UndoPlanCandidateEdit",
+		zap.String("planCandidateId", input.PlanCandidateID),
+	)
+
+	output, err := r.PlanCandidateService.UndoEdit(ctx, input.PlanCandidateID)
+	if err != nil {
+		r.Logger.Error("error while undoing edit of plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("could not undo edit of plan candidate")
+	}
+
+	if output.History == nil || output.Plan == nil {
+		return &model.UndoPlanCandidateEditOutput{
+			PlanCandidateID: input.PlanCandidateID,
+		}, nil
+	}
+
+	planCandidate, err := r.PlanCandidateService.Find(ctx, plancandidate.FindPlanCandidateSetInput{
+		PlanCandidateSetId: input.PlanCandidateID,
+	})
+	if err != nil {
+		r.Logger.Error("error while finding plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *output.Plan, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
+	if err != nil {
+		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypeEditUndone, &output.History.PlanId, nil)
+
+	return &model.UndoPlanCandidateEditOutput{
+		PlanCandidateID: input.PlanCandidateID,
+		Edit:            factory.PlanCandidateEditFromDomainModel(output.History),
+		Plan:            graphqlPlanInPlanCandidate,
+	}, nil
+}
+
+// RedoPlanCandidateEdit is the resolver for the redoPlanCandidateEdit field.
+func (r *mutationResolver) RedoPlanCandidateEdit(ctx context.Context, input model.RedoPlanCandidateEditInput) (*model.RedoPlanCandidateEditOutput, error) {
+	r.Logger.Info(
+		"RedoPlanCandidateEdit",
+		zap.String("planCandidateId", input.PlanCandidateID),
+	)
+
+	output, err := r.PlanCandidateService.RedoEdit(ctx, input.PlanCandidateID)
+	if err != nil {
+		r.Logger.Error("error while redoing edit of plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("could not redo edit of plan candidate")
+	}
+
+	if output.History == nil || output.Plan == nil {
+		return &model.RedoPlanCandidateEditOutput{
+			PlanCandidateID: input.PlanCandidateID,
+		}, nil
+	}
+
+	planCandidate, err := r.PlanCandidateService.Find(ctx, plancandidate.FindPlanCandidateSetInput{
+		PlanCandidateSetId: input.PlanCandidateID,
+	})
+	if err != nil {
+		r.Logger.Error("error while finding plan candidate", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	graphqlPlanInPlanCandidate, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *output.Plan, planCandidate.MetaData.GetLocationStart(), planCandidate.MetaData.LocationEnd, planCandidate.MetaData.StartTime, planCandidate.MetaData.GetTravelMode())
+	if err != nil {
+		r.Logger.Error("error while converting plan to graphql model", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypeEditRedone, &output.History.PlanId, nil)
+
+	return &model.RedoPlanCandidateEditOutput{
+		PlanCandidateID: input.PlanCandidateID,
+		Edit:            factory.PlanCandidateEditFromDomainModel(output.History),
+		Plan:            graphqlPlanInPlanCandidate,
+	}, nil
+}
+
+// StartGroupPlanning is the resolver for the startGroupPlanning field.
+func (r *mutationResolver) StartGroupPlanning(ctx context.Context, input model.StartGroupPlanningInput) (*model.JoinGroupPlanningOutput, error) {
+	output, err := r.PlanCandidateService.StartGroupPlanning(ctx, plancandidate.StartGroupPlanningInput{
+		PlanCandidateSetId: input.PlanCandidateID,
+		User:               gcontext.GetAuthUser(ctx),
+		DisplayName:        utils.FromPointerOrZero(input.DisplayName),
+	})
+	if err != nil {
+		r.Logger.Error("error while starting group planning", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypeGroupUpdated, nil, nil)
+
+	return &model.JoinGroupPlanningOutput{
+		Group:            factory.PlanCandidateGroupFromDomainModel(output.Group, output.WinningPlan),
+		Participant:      factory.PlanCandidateGroupParticipantFromDomainModel(*output.Participant),
+		ParticipantToken: output.Participant.Token,
+	}, nil
+}
+
+// JoinGroupPlanning is the resolver for the joinGroupPlanning field.
+func (r *mutationResolver) JoinGroupPlanning(ctx context.Context, input model.JoinGroupPlanningInput) (*model.JoinGroupPlanningOutput, error) {
+	output, err := r.PlanCandidateService.JoinGroupPlanning(ctx, plancandidate.JoinGroupPlanningInput{
+		ShareToken:  input.ShareToken,
+		User:        gcontext.GetAuthUser(ctx),
+		DisplayName: utils.FromPointerOrZero(input.DisplayName),
+	})
+	if err != nil {
+		r.Logger.Error("error while joining group planning", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, output.Group.PlanCandidateSetId, models.PlanCandidateEventTypeGroupUpdated, nil, nil)
+
+	return &model.JoinGroupPlanningOutput{
+		Group:            factory.PlanCandidateGroupFromDomainModel(output.Group, output.WinningPlan),
+		Participant:      factory.PlanCandidateGroupParticipantFromDomainModel(*output.Participant),
+		ParticipantToken: output.Participant.Token,
+	}, nil
+}
+
+// VoteToPlanInGroup is the resolver for the voteToPlanInGroup field.
+func (r *mutationResolver) VoteToPlanInGroup(ctx context.Context, input model.VoteToPlanInGroupInput) (*model.VoteInGroupOutput, error) {
+	output, err := r.PlanCandidateService.VoteToPlanInGroup(ctx, plancandidate.VoteInGroupInput{
+		PlanCandidateSetId: input.PlanCandidateID,
+		ParticipantToken:   input.ParticipantToken,
+		Vote:               input.Vote,
+	}, input.PlanID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUnauthorized) {
+			r.Logger.Error("participant not found while voting to plan", zap.String("planCandidateId", input.PlanCandidateID))
+			return nil, fmt.Errorf("not authorized")
+		}
+		r.Logger.Error("error while voting to plan", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypeGroupUpdated, &input.PlanID, nil)
+
+	return &model.VoteInGroupOutput{
+		Group: factory.PlanCandidateGroupFromDomainModel(output.Group, output.WinningPlan),
+	}, nil
+}
+
+// VoteToPlaceInGroup is the resolver for the voteToPlaceInGroup field.
+func (r *mutationResolver) VoteToPlaceInGroup(ctx context.Context, input model.VoteToPlaceInGroupInput) (*model.VoteInGroupOutput, error) {
+	output, err := r.PlanCandidateService.VoteToPlaceInGroup(ctx, plancandidate.VoteInGroupInput{
+		PlanCandidateSetId: input.PlanCandidateID,
+		ParticipantToken:   input.ParticipantToken,
+		Vote:               input.Vote,
+	}, input.PlaceID)
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUnauthorized) {
+			r.Logger.Error("participant not found while voting to place", zap.String("planCandidateId", input.PlanCandidateID))
+			return nil, fmt.Errorf("not authorized")
+		}
+		r.Logger.Error("error while voting to place", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	r.publishPlanCandidateEvent(ctx, input.PlanCandidateID, models.PlanCandidateEventTypeGroupUpdated, nil, &input.PlaceID)
+
+	return &model.VoteInGroupOutput{
+		Group: factory.PlanCandidateGroupFromDomainModel(output.Group, output.WinningPlan),
+	}, nil
+}
@@ -6,14 +6,17 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/apperrors"
 	"poroto.app/poroto/planner/internal/domain/array"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/place"
 	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
+	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
 	"poroto.app/poroto/planner/internal/interface/graphql/factory"
 	"poroto.app/poroto/planner/internal/interface/graphql/model"
 )
@@ -34,7 +37,7 @@ func (r *queryResolver) PlanCandidate(ctx context.Context, input model.PlanCandi
 		return nil, err
 	}
 
-	graphqlPlanCandidate := factory.PlanCandidateSetFromDomainModel(planCandidate)
+	graphqlPlanCandidate := factory.PlanCandidateSetFromDomainModel(ctx, r.Logger, r.RoutingProvider, planCandidate)
 	return &model.PlanCandidateOutput{
 		PlanCandidate: graphqlPlanCandidate,
 	}, nil
@@ -129,6 +132,39 @@ func (r *queryResolver) PlaceCategories(ctx context.Context) ([]*model.CreatePla
 	return graphqlCreatePlanPlaceCategorySet, nil
 }
 
+// PlanCandidateGroup is the resolver for the planCandidateGroup field.
+func (r *queryResolver) PlanCandidateGroup(ctx context.Context, input model.PlanCandidateGroupInput) (*model.PlanCandidateGroupOutput, error) {
+	var output *plancandidate.GroupPlanningOutput
+	var err error
+	if input.PlanCandidateID != nil {
+		output, err = r.PlanCandidateService.FindGroup(ctx, plancandidate.FindGroupInput{
+			PlanCandidateSetId: *input.PlanCandidateID,
+			ParticipantToken:   input.ParticipantToken,
+			User:               gcontext.GetAuthUser(ctx),
+		})
+	} else if input.ShareToken != nil {
+		output, err = r.PlanCandidateService.FindGroupByShareToken(ctx, *input.ShareToken)
+	} else {
+		return nil, fmt.Errorf("planCandidateId or shareToken is required")
+	}
+
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUnauthorized) {
+			return nil, fmt.Errorf("not authorized")
+		}
+		r.Logger.Error("error while fetching plan candidate group", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	if output == nil {
+		return &model.PlanCandidateGroupOutput{Group: nil}, nil
+	}
+
+	return &model.PlanCandidateGroupOutput{
+		Group: factory.PlanCandidateGroupFromDomainModel(output.Group, output.WinningPlan),
+	}, nil
+}
+
 // PlacesToAddForPlanCandidate is the resolver for the placesToAddForPlanCandidate field.
 func (r *queryResolver) PlacesToAddForPlanCandidate(ctx context.Context, input model.PlacesToAddForPlanCandidateInput) (*model.PlacesToAddForPlanCandidateOutput, error) {
 	// TODO: 指定されたプランIDが不正だった場合の対処をする
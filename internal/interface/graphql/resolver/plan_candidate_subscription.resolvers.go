@@ -0,0 +1,52 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/interface/graphql/generated"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// PlanCandidateUpdated is the resolver for the planCandidateUpdated field.
+func (r *subscriptionResolver) PlanCandidateUpdated(ctx context.Context, input model.PlanCandidateUpdatedInput) (<-chan *model.PlanCandidateUpdatedEvent, error) {
+	r.Logger.Info(
+		"PlanCandidateUpdated",
+		zap.String("planCandidateId", input.PlanCandidateID),
+	)
+
+	if r.PlanCandidateEventBroker == nil {
+		r.Logger.Error("plan candidate event broker is not initialized")
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	events, err := r.PlanCandidateEventBroker.Subscribe(ctx, input.PlanCandidateID)
+	if err != nil {
+		r.Logger.Error("error while subscribing plan candidate events", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	ch := make(chan *model.PlanCandidateUpdatedEvent)
+	go func() {
+		defer close(ch)
+		for event := range events {
+			select {
+			case ch <- r.planCandidateUpdatedEventFromDomainModel(ctx, event):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
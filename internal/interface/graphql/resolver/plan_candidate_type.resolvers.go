@@ -0,0 +1,31 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/interface/graphql/factory"
+	"poroto.app/poroto/planner/internal/interface/graphql/generated"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// History is the resolver for the history field.
+func (r *planCandidateResolver) History(ctx context.Context, obj *model.PlanCandidate) ([]*model.PlanCandidateEdit, error) {
+	histories, err := r.PlanCandidateService.FindEditHistories(ctx, obj.ID)
+	if err != nil {
+		r.Logger.Error("error while fetching edit histories of plan candidate", zap.String("planCandidateId", obj.ID), zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	return factory.PlanCandidateEditsFromDomainModel(histories), nil
+}
+
+// PlanCandidate returns generated.PlanCandidateResolver implementation.
+func (r *Resolver) PlanCandidate() generated.PlanCandidateResolver { return &planCandidateResolver{r} }
+
+type planCandidateResolver struct{ *Resolver }
@@ -0,0 +1,43 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/apperrors"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/plan"
+	"poroto.app/poroto/planner/internal/interface/graphql/factory"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// editPlanOutput は保存されたプランを編集した結果を GraphQL のレスポンスに変換する
+// 作者以外による編集や、他の編集との競合はクライアントが判別できるエラーにする
+func (r *Resolver) editPlanOutput(ctx context.Context, planId string, output *plan.EditPlanOutput, err error) (*model.EditPlanOutput, error) {
+	if err != nil {
+		if errors.Is(err, apperrors.ErrUnauthorized) {
+			r.Logger.Warn("user is not author of the plan", zap.String("planId", planId))
+			return nil, fmt.Errorf("not authorized")
+		}
+
+		if errors.Is(err, apperrors.ErrVersionConflict) {
+			r.Logger.Info("plan is already edited by another request", zap.String("planId", planId))
+			return nil, fmt.Errorf("plan is already updated")
+		}
+
+		r.Logger.Error("error while editing plan", zap.String("planId", planId), zap.Error(err))
+		return nil, fmt.Errorf("could not edit plan")
+	}
+
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, output.Plan, nil, nil, nil, models.TravelModeWalking)
+	if err != nil {
+		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	return &model.EditPlanOutput{
+		Plan: graphqlPlan,
+	}, nil
+}
@@ -34,13 +34,18 @@ func (r *mutationResolver) UploadPlacePhotoInPlan(ctx context.Context, planID st
 		return nil, fmt.Errorf("internal resolver error")
 	}
 
-	planDomainModel, err := r.PlanService.FetchPlan(ctx, planID)
+	// 写真の追加に成功した時点で userID のユーザーは認証されている
+	planDomainModel, err := r.PlanService.FetchPlanVisibleTo(ctx, planID, &models.User{Id: userID})
 	if err != nil {
 		r.Logger.Error("error while fetching plan", zap.Error(err))
 		return nil, fmt.Errorf("internal resolver error")
 	}
 
-	planGraphQLModel, err := factory.PlanFromDomainModel(*planDomainModel, nil)
+	if planDomainModel == nil {
+		return nil, fmt.Errorf("plan not found")
+	}
+
+	planGraphQLModel, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *planDomainModel, nil, nil, nil, models.TravelModeWalking)
 	if err != nil {
 		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal resolver error")
@@ -74,7 +79,7 @@ func (r *mutationResolver) LikeToPlaceInPlan(ctx context.Context, input model.Li
 		return nil, fmt.Errorf("internal server error: %v", err)
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(likeToPlaceResult.Plan, nil)
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, likeToPlaceResult.Plan, nil, nil, nil, models.TravelModeWalking)
 	if err != nil {
 		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
@@ -102,7 +107,7 @@ func (r *mutationResolver) UpdatePlanCollageImage(ctx context.Context, input mod
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(output.Plan, nil)
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, output.Plan, nil, nil, nil, models.TravelModeWalking)
 	if err != nil {
 		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
@@ -112,3 +117,69 @@ func (r *mutationResolver) UpdatePlanCollageImage(ctx context.Context, input mod
 		Plan: graphqlPlan,
 	}, nil
 }
+
+// UpdatePlanTitleAndDescription is the resolver for the updatePlanTitleAndDescription field.
+func (r *mutationResolver) UpdatePlanTitleAndDescription(ctx context.Context, input model.UpdatePlanTitleAndDescriptionInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.UpdatePlanTitleAndDescription(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, input.Title, input.Description)
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
+
+// UpdatePlanVisibility is the resolver for the updatePlanVisibility field.
+func (r *mutationResolver) UpdatePlanVisibility(ctx context.Context, input model.UpdatePlanVisibilityInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.UpdatePlanVisibility(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, factory.PlanVisibilityToDomainModel(input.Visibility))
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
+
+// ChangePlacesOrderInPlan is the resolver for the changePlacesOrderInPlan field.
+func (r *mutationResolver) ChangePlacesOrderInPlan(ctx context.Context, input model.ChangePlacesOrderInPlanInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.ChangePlacesOrderInPlan(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, input.PlaceIds)
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
+
+// AddPlaceToPlanAfterPlace is the resolver for the addPlaceToPlanAfterPlace field.
+func (r *mutationResolver) AddPlaceToPlanAfterPlace(ctx context.Context, input model.AddPlaceToPlanAfterPlaceInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.AddPlaceToPlan(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, input.PreviousPlaceID, input.PlaceID)
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
+
+// DeletePlaceFromPlan is the resolver for the deletePlaceFromPlan field.
+func (r *mutationResolver) DeletePlaceFromPlan(ctx context.Context, input model.DeletePlaceFromPlanInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.RemovePlaceFromPlan(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, input.PlaceID)
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
+
+// ReplacePlaceOfPlan is the resolver for the replacePlaceOfPlan field.
+func (r *mutationResolver) ReplacePlaceOfPlan(ctx context.Context, input model.ReplacePlaceOfPlanInput) (*model.EditPlanOutput, error) {
+	output, err := r.PlanService.ReplacePlaceInPlan(ctx, plan.EditPlanInput{
+		PlanId:            input.PlanID,
+		Version:           input.Version,
+		UserId:            input.UserID,
+		FirebaseAuthToken: input.FirebaseAuthToken,
+	}, input.PlaceIDToRemove, input.PlaceIDToReplace)
+	return r.editPlanOutput(ctx, input.PlanID, output, err)
+}
@@ -11,13 +11,15 @@ import (
 	"go.uber.org/zap"
 	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/plan"
+	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
 	"poroto.app/poroto/planner/internal/interface/graphql/factory"
 	"poroto.app/poroto/planner/internal/interface/graphql/model"
 )
 
 // Plan is the resolver for the plan field.
 func (r *queryResolver) Plan(ctx context.Context, input model.PlanInput) (*model.PlanOutput, error) {
-	p, err := r.PlanService.FetchPlan(ctx, input.PlanID)
+	// 非公開のプランは作者以外には存在しないものとして扱う
+	p, err := r.PlanService.FetchPlanVisibleTo(ctx, input.PlanID, gcontext.GetAuthUser(ctx))
 	if err != nil {
 		return nil, fmt.Errorf("error while fetching plan: %v", err)
 	}
@@ -26,7 +28,7 @@ func (r *queryResolver) Plan(ctx context.Context, input model.PlanInput) (*model
 		return nil, nil
 	}
 
-	graphqlPlan, err := factory.PlanFromDomainModel(*p, nil)
+	graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, *p, nil, nil, nil, models.TravelModeWalking)
 	if err != nil {
 		r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
@@ -49,7 +51,7 @@ func (r *queryResolver) Plans(ctx context.Context, input *model.PlansInput) (*mo
 	}
 
 	return &model.PlansOutput{
-		Plans:         factory.PlansFromDomainModel(plans, nil),
+		Plans:         factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, nil, nil, nil, models.TravelModeWalking),
 		NextPageToken: nextPageToken,
 	}, nil
 }
@@ -72,7 +74,7 @@ func (r *queryResolver) PlansByLocation(ctx context.Context, input model.PlansBy
 	}
 
 	return &model.PlansByLocationOutput{
-		Plans:   factory.PlansFromDomainModel(plans, nil),
+		Plans:   factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, nil, nil, nil, models.TravelModeWalking),
 		PageKey: nextPageToken,
 	}, nil
 }
@@ -89,14 +91,14 @@ func (r *queryResolver) PlansByUser(ctx context.Context, input model.PlansByUser
 		return nil, nil
 	}
 
-	plans, err := r.PlanService.PlansByUser(ctx, input.UserID)
+	plans, err := r.PlanService.PlansByUser(ctx, input.UserID, gcontext.GetAuthUser(ctx))
 	if err != nil {
 		r.Logger.Error("error while fetching plans by user", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
 	return &model.PlansByUserOutput{
-		Plans:  factory.PlansFromDomainModel(plans, nil),
+		Plans:  factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, nil, nil, nil, models.TravelModeWalking),
 		Author: factory.UserFromDomainModel(author),
 	}, nil
 }
@@ -58,7 +58,7 @@ func (r *planResolver) NearbyPlans(ctx context.Context, obj *model.Plan) ([]*mod
 
 	graphqlPlans := make([]*model.Plan, 0, len(*plans))
 	for _, p := range *plans {
-		graphqlPlan, err := factory.PlanFromDomainModel(p, nil)
+		graphqlPlan, err := factory.PlanFromDomainModel(ctx, r.Logger, r.RoutingProvider, p, nil, nil, nil, models.TravelModeWalking)
 		if err != nil {
 			r.Logger.Error("error while converting plan domain model to graphql model", zap.Error(err))
 			return nil, nil
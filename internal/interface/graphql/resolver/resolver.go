@@ -3,8 +3,8 @@
 package resolver
 
 import (
-	"database/sql"
 	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
 	"poroto.app/poroto/planner/internal/domain/services/place"
 	"poroto.app/poroto/planner/internal/domain/services/plan"
 	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
@@ -18,10 +18,14 @@ import (
 
 type Resolver struct {
 	Logger               *zap.Logger
-	DB                   *sql.DB
 	UserService          *user.Service
 	PlanService          *plan.Service
 	PlanCandidateService *plancandidate.Service
 	PlanGenService       *plangen.Service
 	PlaceService         *place.Service
+	RoutingProvider      models.RoutingProvider
+	// PlanCandidateEventBroker はリクエスト間で購読者を共有するため、サーバー全体で一つのインスタンスを使う
+	PlanCandidateEventBroker models.PlanCandidateEventBroker
+	// PlanCandidateUpdatedEventPayloads は購読者の間で通知の内容を共有するため、PlanCandidateEventBroker と同様に一つのインスタンスを使う
+	PlanCandidateUpdatedEventPayloads *PlanCandidateUpdatedEventPayloads
 }
@@ -0,0 +1,51 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	"poroto.app/poroto/planner/internal/domain/models"
+	"poroto.app/poroto/planner/internal/domain/services/plangen"
+	"poroto.app/poroto/planner/internal/interface/graphql/factory"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// CreateTripPlan is the resolver for the createTripPlan field.
+func (r *mutationResolver) CreateTripPlan(ctx context.Context, input model.CreateTripPlanInput) (*model.CreateTripPlanOutput, error) {
+	trip, err := r.PlanGenService.CreateTripPlan(ctx, plangen.CreateTripPlanInput{
+		LocationStart: models.GeoLocation{
+			Latitude:  input.Latitude,
+			Longitude: input.Longitude,
+		},
+		StartTime:              input.StartTime,
+		FreeTime:               input.FreeTime,
+		TravelMode:             factory.TravelModeToDomainModel(input.TravelMode),
+		CategoryNamesPreferred: &input.CategoriesPreferred,
+		CategoryNamesDisliked:  &input.CategoriesDisliked,
+	})
+	if err != nil {
+		r.Logger.Error("error while creating trip plan", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	tripSaved, err := r.PlanService.SaveTrip(ctx, *trip, input.FirebaseAuthToken)
+	if err != nil {
+		r.Logger.Error("error while saving trip", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	graphqlTrip, err := factory.TripFromDomainModel(ctx, r.Logger, r.RoutingProvider, *tripSaved)
+	if err != nil {
+		r.Logger.Error("error while converting trip domain model to graphql model", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	return &model.CreateTripPlanOutput{
+		Trip: graphqlTrip,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package resolver
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.34
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
+	"poroto.app/poroto/planner/internal/interface/graphql/factory"
+	"poroto.app/poroto/planner/internal/interface/graphql/model"
+)
+
+// Trip is the resolver for the trip field.
+func (r *queryResolver) Trip(ctx context.Context, input model.TripInput) (*model.TripOutput, error) {
+	// 非公開の日のプランは作者以外には含めない
+	trip, err := r.PlanService.FetchTrip(ctx, input.TripID, gcontext.GetAuthUser(ctx))
+	if err != nil {
+		r.Logger.Error("error while fetching trip", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	if trip == nil {
+		return &model.TripOutput{}, nil
+	}
+
+	graphqlTrip, err := factory.TripFromDomainModel(ctx, r.Logger, r.RoutingProvider, *trip)
+	if err != nil {
+		r.Logger.Error("error while converting trip domain model to graphql model", zap.Error(err))
+		return nil, fmt.Errorf("internal server error")
+	}
+
+	return &model.TripOutput{
+		Trip: graphqlTrip,
+	}, nil
+}
@@ -57,6 +57,8 @@ func (r *mutationResolver) UpdateUserProfile(ctx context.Context, input model.Up
 		UserId:          input.UserID,
 		Name:            utils.FromPointerOrZero(input.Name),
 		ProfileImageUrl: utils.FromPointerOrZero(input.ProfileImageURL),
+
+		PersonalizationDisabled: input.PersonalizationDisabled,
 	})
 	if err != nil {
 		if errors.Is(err, apperrors.ErrUnauthorized) {
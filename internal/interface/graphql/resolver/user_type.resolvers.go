@@ -34,13 +34,13 @@ func (r *userResolver) Plans(ctx context.Context, obj *model.User) ([]*model.Pla
 		return nil, nil
 	}
 
-	plans, err := r.PlanService.PlansByUser(ctx, obj.ID)
+	plans, err := r.PlanService.PlansByUser(ctx, obj.ID, gcontext.GetAuthUser(ctx))
 	if err != nil {
 		r.Logger.Error("error while fetching plans by user", zap.Error(err))
 		return nil, fmt.Errorf("internal server error")
 	}
 
-	return factory.PlansFromDomainModel(plans, nil), nil
+	return factory.PlansFromDomainModel(ctx, r.Logger, r.RoutingProvider, plans, nil, nil, nil, models.TravelModeWalking), nil
 }
 
 // LikedPlaces is the resolver for the likedPlaces field.
@@ -1,22 +1,24 @@
 package rest
 
 import (
-	"database/sql"
+	"context"
 	"fmt"
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
 	"go.uber.org/zap"
-	"log"
-	"poroto.app/poroto/planner/internal/domain/services/place"
-	"poroto.app/poroto/planner/internal/domain/services/plan"
-	"poroto.app/poroto/planner/internal/domain/services/plancandidate"
-	"poroto.app/poroto/planner/internal/domain/services/plangen"
-	"poroto.app/poroto/planner/internal/domain/services/user"
-	"poroto.app/poroto/planner/internal/domain/utils"
+	"net/http"
+	"poroto.app/poroto/planner/internal/application"
+	"poroto.app/poroto/planner/internal/domain/services/placefilter"
 	gcontext "poroto.app/poroto/planner/internal/interface/graphql/context"
 	"poroto.app/poroto/planner/internal/interface/graphql/generated"
 	"poroto.app/poroto/planner/internal/interface/graphql/resolver"
+	"time"
 )
 
 func GraphQlPlayGround(c *gin.Context) {
@@ -24,70 +26,110 @@ func GraphQlPlayGround(c *gin.Context) {
 	h.ServeHTTP(c.Writer, c.Request)
 }
 
-func GraphQlQueryHandler(db *sql.DB) gin.HandlerFunc {
+// GraphQlQueryHandler GraphQL のクエリ・ミューテーションを処理する
+// WebSocket で接続された場合はサブスクリプションを処理する（checkOrigin で接続元を検証する）
+// サービスは起動時に組み立てた container のものをリクエスト間で使い回す
+// 本番環境以外（serverMode が ServerModeProduction 以外）では、場所のフィルタリングの結果をレスポンスに含められるようにする
+func GraphQlQueryHandler(container *application.Container, serverMode string, checkOrigin func(origin string) bool) (gin.HandlerFunc, error) {
+	planCandidateUpdatedEventPayloads, err := resolver.NewPlanCandidateUpdatedEventPayloads()
+	if err != nil {
+		return nil, fmt.Errorf("error while initializing plan candidate updated event payloads: %v", err)
+	}
+
+	schema := generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{
+		Logger:               container.Logger.With(zap.String("tag", "GraphQL")),
+		UserService:          container.UserService,
+		PlanService:          container.PlanService,
+		PlanCandidateService: container.PlanCandidateService,
+		PlanGenService:       container.PlanGenService,
+		PlaceService:         container.PlaceService,
+		RoutingProvider:      container.RoutingProvider,
+
+		PlanCandidateEventBroker:          container.PlanCandidateEventBroker,
+		PlanCandidateUpdatedEventPayloads: planCandidateUpdatedEventPayloads,
+	}})
+
+	h := newGraphQlServer(schema, checkOrigin)
+	if serverMode == ServerModeProduction {
+		return func(c *gin.Context) {
+			h.ServeHTTP(c.Writer, c.Request)
+		}, nil
+	}
+
+	hWithPlaceFilterDebug := newGraphQlServer(schema, checkOrigin)
+	hWithPlaceFilterDebug.AroundResponses(placeFilterDebugExtension)
+
 	return func(c *gin.Context) {
-		logger, err := utils.NewLogger(utils.LoggerOption{
-			Tag: "GraphQL",
-		})
-		if err != nil {
-			log.Println("error while initializing Logger: ", err)
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
+		if isPlaceFilterDebugRequest(c) {
+			hWithPlaceFilterDebug.ServeHTTP(c.Writer, c.Request)
+			return
 		}
+		h.ServeHTTP(c.Writer, c.Request)
+	}, nil
+}
 
-		userService, err := user.NewService(c.Request.Context(), db)
-		if err != nil {
-			logger.Error("error while initializing user service", zap.Error(err))
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
+// GraphQlSubscriptionHandler WebSocket への切り替えを要求する GET リクエストのみを handler に渡す
+// それ以外の GET リクエストでクエリ・ミューテーションが実行されないようにする
+func GraphQlSubscriptionHandler(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !websocket.IsWebSocketUpgrade(c.Request) {
+			c.AbortWithStatus(http.StatusMethodNotAllowed)
+			return
 		}
+		handler(c)
+	}
+}
 
-		planService, err := plan.NewService(c.Request.Context(), db)
-		if err != nil {
-			logger.Error("error while initializing plan service", zap.Error(err))
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
-		}
+// newGraphQlServer handler.NewDefaultServer と同じ設定に加えて
+// WebSocket の接続元を checkOrigin で検証する
+// クエリ・ミューテーションは POST でのみ受け付け、GET は WebSocket の接続にのみ用いる
+func newGraphQlServer(schema graphql.ExecutableSchema, checkOrigin func(origin string) bool) *handler.Server {
+	h := handler.New(schema)
 
-		planGenService, err := plangen.NewService(db)
-		if err != nil {
-			logger.Error("error while initializing plan gen service", zap.Error(err))
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
-		}
+	h.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return checkOrigin(r.Header.Get("Origin"))
+			},
+		},
+	})
+	h.AddTransport(transport.Options{})
+	h.AddTransport(transport.POST{})
+	h.AddTransport(transport.MultipartForm{})
 
-		planCandidateService, err := plancandidate.NewService(c.Request.Context(), db)
-		if err != nil {
-			logger.Error("error while initializing plan candidate service", zap.Error(err))
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
-		}
+	h.SetQueryCache(lru.New(1000))
 
-		placeService, err := place.NewService(c.Request.Context(), db)
-		if err != nil {
-			logger.Error("error while initializing place service", zap.Error(err))
-			c.JSON(500, gin.H{
-				"error": "internal server error",
-			})
-		}
+	h.Use(extension.Introspection{})
+	h.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(100),
+	})
 
-		schema := generated.NewExecutableSchema(generated.Config{Resolvers: &resolver.Resolver{
-			Logger:               logger,
-			DB:                   db,
-			UserService:          userService,
-			PlanService:          planService,
-			PlanCandidateService: planCandidateService,
-			PlanGenService:       planGenService,
-			PlaceService:         placeService,
-		}})
-		h := handler.NewDefaultServer(schema)
-		h.ServeHTTP(c.Writer, c.Request)
+	return h
+}
+
+// isPlaceFilterDebugRequest X-Debug-Place-Filter ヘッダーが指定されている場合は
+// 場所のフィルタリングの結果をレスポンスに含める
+func isPlaceFilterDebugRequest(c *gin.Context) bool {
+	return c.GetHeader("X-Debug-Place-Filter") == "true"
+}
+
+// placeFilterDebugExtension 各パイプラインのステージごとに取り除かれた場所の数を extensions.placeFilter に含める
+func placeFilterDebugExtension(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	ctx, recorder := placefilter.WithStageResultRecorder(ctx)
+	response := next(ctx)
+	if response == nil {
+		return nil
 	}
+
+	if results := recorder.Results(); len(results) > 0 {
+		if response.Extensions == nil {
+			response.Extensions = map[string]interface{}{}
+		}
+		response.Extensions["placeFilter"] = results
+	}
+
+	return response
 }
 
 // GraphqlAuthMiddleware Authorization Header が設定されている場合のみ
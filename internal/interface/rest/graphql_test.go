@@ -0,0 +1,60 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGraphQlSubscriptionHandler(t *testing.T) {
+	cases := []struct {
+		name             string
+		header           map[string]string
+		expectedStatus   int
+		expectedDelegate bool
+	}{
+		{
+			name: "websocket upgrade request is passed to handler",
+			header: map[string]string{
+				"Connection": "Upgrade",
+				"Upgrade":    "websocket",
+			},
+			expectedStatus:   http.StatusOK,
+			expectedDelegate: true,
+		},
+		{
+			name:             "query over GET is rejected",
+			expectedStatus:   http.StatusMethodNotAllowed,
+			expectedDelegate: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			delegated := false
+			handler := GraphQlSubscriptionHandler(func(c *gin.Context) {
+				delegated = true
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(recorder)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/graphql?query={version}", nil)
+			for key, value := range c.header {
+				ctx.Request.Header.Set(key, value)
+			}
+
+			handler(ctx)
+
+			if diff := cmp.Diff(c.expectedDelegate, delegated); diff != "" {
+				t.Errorf("delegated mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(c.expectedStatus, recorder.Code); diff != "" {
+				t.Errorf("status mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
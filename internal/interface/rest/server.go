@@ -1,16 +1,12 @@
 package rest
 
 import (
-	"context"
-	"database/sql"
-	"fmt"
 	"go.uber.org/zap"
 	"net/url"
 	"os"
+	"poroto.app/poroto/planner/internal/application"
 	"poroto.app/poroto/planner/internal/domain/repository"
-	"poroto.app/poroto/planner/internal/domain/utils"
 	"poroto.app/poroto/planner/internal/infrastructure/auth"
-	"poroto.app/poroto/planner/internal/infrastructure/rdb"
 	"time"
 
 	"github.com/gin-contrib/cors"
@@ -20,9 +16,10 @@ import (
 type Server struct {
 	port           string
 	mode           string
-	firebaseAuth   auth.FirebaseAuth
+	container      *application.Container
+	firebaseAuth   *auth.FirebaseAuth
 	userRepository repository.UserRepository
-	logger         zap.Logger
+	logger         *zap.Logger
 }
 
 const (
@@ -31,39 +28,23 @@ const (
 	ServerModeProduction  = "production"
 )
 
-func NewRestServer(ctx context.Context, db *sql.DB, env string) (*Server, error) {
+func NewRestServer(container *application.Container, env string) *Server {
 	port := os.Getenv("PORT")
 	if port == "" {
 		port = "8080"
 	}
 
-	logger, err := utils.NewLogger(utils.LoggerOption{
-		Tag: "RestServer",
-	})
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing Logger: %w", err)
-	}
-
-	firebaseAuth, err := auth.NewFirebaseAuth(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing firebase auth: %w", err)
-	}
-
-	userRepository, err := rdb.NewUserRepository(db)
-	if err != nil {
-		return nil, fmt.Errorf("error while initializing user repository: %w", err)
-	}
-
 	return &Server{
 		port:           port,
 		mode:           serverModeFromEnv(env),
-		firebaseAuth:   *firebaseAuth,
-		userRepository: userRepository,
-		logger:         *logger,
-	}, nil
+		container:      container,
+		firebaseAuth:   container.FirebaseAuth,
+		userRepository: container.UserRepository,
+		logger:         container.Logger.With(zap.String("tag", "RestServer")),
+	}
 }
 
-func (s Server) ServeHTTP(db *sql.DB) error {
+func (s Server) ServeHTTP() error {
 	if s.isStaging() || s.isProduction() {
 		gin.SetMode(gin.ReleaseMode)
 	}
@@ -77,21 +58,8 @@ func (s Server) ServeHTTP(db *sql.DB) error {
 			"Content-Type",
 			"Authorization",
 		},
-		AllowOriginFunc: func(origin string) bool {
-			if s.isDevelopment() {
-				return true
-			}
-
-			u, err := url.Parse(origin)
-			if err != nil {
-				return false
-			}
-
-			protocol := os.Getenv("WEB_PROTOCOL")
-			host := os.Getenv("WEB_HOST")
-			return u.Scheme == protocol && u.Host == host
-		},
-		MaxAge: 12 * time.Hour,
+		AllowOriginFunc: s.isAllowedOrigin,
+		MaxAge:          12 * time.Hour,
 	}))
 
 	r.GET("/", func(c *gin.Context) {
@@ -103,7 +71,13 @@ func (s Server) ServeHTTP(db *sql.DB) error {
 	groupGraphql := r.Group("/graphql")
 	{
 		groupGraphql.Use(s.GraphqlAuthMiddleware())
-		groupGraphql.POST("", GraphQlQueryHandler(db))
+		graphqlQueryHandler, err := GraphQlQueryHandler(s.container, s.mode, s.isAllowedOrigin)
+		if err != nil {
+			return err
+		}
+		groupGraphql.POST("", graphqlQueryHandler)
+		// サブスクリプションは WebSocket で接続する
+		groupGraphql.GET("", GraphQlSubscriptionHandler(graphqlQueryHandler))
 		if s.isDevelopment() || s.isStaging() {
 			groupGraphql.GET("/playground", GraphQlPlayGround)
 		}
@@ -116,6 +90,22 @@ func (s Server) ServeHTTP(db *sql.DB) error {
 	return nil
 }
 
+// isAllowedOrigin Web アプリからのリクエストのみを許可する（開発環境ではすべて許可する）
+func (s Server) isAllowedOrigin(origin string) bool {
+	if s.isDevelopment() {
+		return true
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+
+	protocol := os.Getenv("WEB_PROTOCOL")
+	host := os.Getenv("WEB_HOST")
+	return u.Scheme == protocol && u.Host == host
+}
+
 func serverModeFromEnv(env string) string {
 	serverMode := ServerModeDevelopment
 	if env == "production" {